@@ -0,0 +1,226 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+const (
+	linkCodeLifeTime = 15 * time.Minute
+	// Link-codes are typed in by users, so ambiguous characters like 0/O and 1/I are left out
+	linkCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	linkCodeLength   = 8
+)
+
+var errInvalidCredentials = errors.New("invalid username or password")
+
+func (s *TallyServer) RegisterUser(
+	ctx context.Context,
+	req *connect.Request[model.RegisterUserRequest],
+) (*connect.Response[model.RegisterUserResponse], error) {
+	session := ContextGetUserState(ctx)
+	if session.Registered {
+		cerr := createError(connect.CodeFailedPrecondition, types.ErrAlreadyRegistered)
+		return nil, cerr.ToConnectError()
+	}
+	if err := validatePassword(req.Msg.Password); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	hash, err := hashPassword(req.Msg.Password)
+	if err != nil {
+		cerr := createError(connect.CodeInternal, err)
+		return nil, cerr.ToConnectError()
+	}
+	payload := types.RegisterUserPayload{
+		UserID:       session.UserID,
+		Username:     req.Msg.Username,
+		PasswordHash: hash,
+	}
+	if err := payload.Validate(); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	_, err = s.storage.RegisterUser(ctx, payload)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrUsernameTaken):
+			cerr := createError(connect.CodeAlreadyExists, err)
+			return nil, cerr.ToConnectError()
+		case errors.Is(err, types.ErrAlreadyRegistered):
+			cerr := createError(connect.CodeFailedPrecondition, err)
+			return nil, cerr.ToConnectError()
+		}
+		s.l.Error().Err(err).Str("username", payload.Username).Msg("failed to issue storage.RegisterUser in api.RegisterUser")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to register user: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	modelSession, err := s.reloadSession(ctx, session.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&model.RegisterUserResponse{Session: modelSession}), nil
+}
+
+func (s *TallyServer) Login(
+	ctx context.Context,
+	req *connect.Request[model.LoginRequest],
+) (*connect.Response[model.LoginResponse], error) {
+	session := ContextGetUserState(ctx)
+	if req.Msg.Username == "" || req.Msg.Password == "" {
+		cerr := createError(connect.CodeInvalidArgument, errInvalidCredentials)
+		return nil, cerr.ToConnectError()
+	}
+	user, err := s.storage.GetRegisteredUser(ctx, types.GetRegisteredUserPayload{Username: req.Msg.Username})
+	if err != nil {
+		s.l.Error().Err(err).Str("username", req.Msg.Username).Msg("failed to issue storage.GetRegisteredUser in api.Login")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to login: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	// Unknown usernames are verified against a dummy-hash, so that they take as long to reject as wrong passwords
+	passwordHash := dummyPasswordHash
+	if user != nil {
+		passwordHash = user.PasswordHash
+	}
+	ok, err := verifyPassword(req.Msg.Password, passwordHash)
+	if err != nil {
+		s.l.Error().Err(err).Str("username", req.Msg.Username).Msg("failed to verify password in api.Login")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to login"))
+		return nil, cerr.ToConnectError()
+	}
+	if !ok || user == nil {
+		cerr := createError(connect.CodeUnauthenticated, errInvalidCredentials)
+		return nil, cerr.ToConnectError()
+	}
+	// The user gets a new session, instead of attaching the user to the current session-id,
+	// which may have been planted by someone else (session-fixation)
+	sess, err := s.storage.CreateSession(ctx, types.CreateSessionPayload{
+		SessionID:    gonanoid.Must(),
+		UserID:       user.ID,
+		InvalidAfter: time.Now().Add(time.Duration(sessionMaxTime) * time.Second),
+	})
+	if err != nil {
+		s.l.Error().Err(err).Str("userID", user.ID).Msg("failed to issue storage.CreateSession in api.Login")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to login: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	s.expireSession(ctx, session.SessionID)
+	modelSession, err := s.reloadSession(ctx, sess.ID)
+	if err != nil {
+		return nil, err
+	}
+	res := connect.NewResponse(&model.LoginResponse{Session: modelSession})
+	setSessionHeaders(s.l, res.Header(), req.Header(), sess.ID)
+	return res, nil
+}
+
+func (s *TallyServer) CreateLinkCode(
+	ctx context.Context,
+	req *connect.Request[model.CreateLinkCodeRequest],
+) (*connect.Response[model.CreateLinkCodeResponse], error) {
+	session := ContextGetUserState(ctx)
+	code, err := gonanoid.Generate(linkCodeAlphabet, linkCodeLength)
+	if err != nil {
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to generate link-code: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	payload := types.CreateLinkCodePayload{
+		Code:         code,
+		UserID:       session.UserID,
+		InvalidAfter: time.Now().Add(linkCodeLifeTime),
+	}
+	linkCode, err := s.storage.CreateLinkCode(ctx, payload)
+	if err != nil {
+		s.l.Error().Err(err).Str("userID", session.UserID).Msg("failed to issue storage.CreateLinkCode in api.CreateLinkCode")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to create link-code: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	response := &model.CreateLinkCodeResponse{
+		Code:             linkCode.Code,
+		ExpiresInSeconds: uint32(time.Until(linkCode.InvalidAfter).Seconds()),
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (s *TallyServer) UseLinkCode(
+	ctx context.Context,
+	req *connect.Request[model.UseLinkCodeRequest],
+) (*connect.Response[model.UseLinkCodeResponse], error) {
+	session := ContextGetUserState(ctx)
+	// As with Login, the user gets a new session, instead of attaching the user to the current session-id
+	payload := types.UseLinkCodePayload{
+		Code:         req.Msg.Code,
+		SessionID:    gonanoid.Must(),
+		InvalidAfter: time.Now().Add(time.Duration(sessionMaxTime) * time.Second),
+	}
+	if err := payload.Validate(); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	_, err := s.storage.UseLinkCode(ctx, payload)
+	if err != nil {
+		if errors.Is(err, types.ErrLinkCodeInvalid) {
+			cerr := createError(connect.CodeNotFound, err)
+			return nil, cerr.ToConnectError()
+		}
+		s.l.Error().Err(err).Msg("failed to issue storage.UseLinkCode in api.UseLinkCode")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to use link-code: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	s.expireSession(ctx, session.SessionID)
+	modelSession, err := s.reloadSession(ctx, payload.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	res := connect.NewResponse(&model.UseLinkCodeResponse{Session: modelSession})
+	setSessionHeaders(s.l, res.Header(), req.Header(), payload.SessionID)
+	return res, nil
+}
+
+// Expires the session that was replaced by a login.
+// Anyone still using it is moved to a new session for its previous user, by the Authorization-middleware.
+func (s *TallyServer) expireSession(ctx context.Context, sessionID string) {
+	err := s.storage.RefreshSession(ctx, types.RefreshSessionPayload{
+		SessionID:    sessionID,
+		InvalidAfter: time.Now(),
+	})
+	if err != nil {
+		// The user is already logged in with the new session, so the request can continue
+		s.l.Error().Err(err).Str("sessionID", sessionID).Msg("failed to expire the replaced session")
+	}
+}
+
+// Reads the session from storage, after its user has changed.
+func (s *TallyServer) reloadSession(ctx context.Context, sessionID string) (*model.Session, error) {
+	us, err := s.storage.GetUserBySessionID(ctx, types.GetUserPayload{ID: sessionID})
+	if err != nil {
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to lookup session: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	if us == nil || us.ActiveGame == nil {
+		cerr := createError(connect.CodeInternal, fmt.Errorf("session or active game not found after update"))
+		return nil, cerr.ToConnectError()
+	}
+	game, err := tallylogic.RestoreGame(us.ActiveGame)
+	if err != nil {
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to restore game: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	state := &UserState{
+		SessionID:  us.Session.ID,
+		UserName:   us.UserName,
+		UserID:     us.UserID,
+		Registered: us.User.IsRegistered(),
+		Game:       game,
+	}
+	Store.SetUserState(state)
+	return toModalSession(state), nil
+}
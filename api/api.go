@@ -73,9 +73,13 @@ func c(condition bool, iftrue, iffalse string) string {
 }
 
 func isSecureRequest(r *http.Request) (bool, string) {
-	proto := r.Header.Get("X-Forwarded-Proto")
+	return isSecureHeader(r.Header)
+}
+
+func isSecureHeader(header http.Header) (bool, string) {
+	proto := header.Get("X-Forwarded-Proto")
 	if proto == "" {
-		origin := r.Header.Get("Origin")
+		origin := header.Get("Origin")
 		proto = strings.Split(origin, "://")[0]
 	}
 
@@ -190,8 +194,13 @@ type TallyServer struct {
 
 type TallyOptions struct {
 	// Connection-string for the database
-	DatabaseDSN           string
-	SkipStatsCollection   *bool
+	DatabaseDSN         string
+	SkipStatsCollection *bool
+	// If set, expired sessions and orphaned guest-users are not deleted
+	SkipSessionReaper *bool
+	// Background-jobs, like the session-reaper, stop when the context is done.
+	// Defaults to context.Background
+	Context               context.Context
 	FeatureGameGeneration *bool
 	// If set will allow the client to set some options on each request that normally is not allowed.
 	// Mostly used for e2e-testing, where the client wants to be in control over the randomization and such
@@ -207,6 +216,12 @@ func NewTallyServer(l logger.AppLogger, options ...TallyOptions) TallyServer {
 		if o.SkipStatsCollection != nil {
 			opt.SkipStatsCollection = o.SkipStatsCollection
 		}
+		if o.SkipSessionReaper != nil {
+			opt.SkipSessionReaper = o.SkipSessionReaper
+		}
+		if o.Context != nil {
+			opt.Context = o.Context
+		}
 		if o.FeatureGameGeneration != nil {
 			opt.FeatureGameGeneration = o.FeatureGameGeneration
 		}
@@ -214,7 +229,7 @@ func NewTallyServer(l logger.AppLogger, options ...TallyOptions) TallyServer {
 			opt.AllowDevelopmentFlags = o.AllowDevelopmentFlags
 		}
 	}
-	db, err := storage.NewSqliteStorage(logger.GetLogger("database"), opt.DatabaseDSN)
+	db, err := storage.NewStorage(logger.GetLogger("database"), opt.DatabaseDSN)
 	// db, err := database.NewDatabase(logger.GetLoggerWithLevel("db", "info"), "")
 	if err != nil {
 		baseLogger.Fatal().Err(err).Msg("failed to initialize database")
@@ -229,6 +244,13 @@ func NewTallyServer(l logger.AppLogger, options ...TallyOptions) TallyServer {
 	if opt.SkipStatsCollection != nil && !*opt.SkipStatsCollection {
 		go ts.collectStatsAtInterval(time.Second * 15)
 	}
+	if !isTrue(opt.SkipSessionReaper) {
+		ctx := opt.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		go ts.reapSessionsAtInterval(ctx, sessionReapInterval)
+	}
 	if ts.AllowDevelopmentFlags || ts.FeatureGameGeneration {
 
 		l.Warn().
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+)
+
+func TestApi_Account(t *testing.T) {
+	t.Run("Should login to a registered user from another device", func(t *testing.T) {
+		ts := newTestApi(t)
+
+		// ------------------------------------------------------------
+		ts.LogMark("Registering the current user")
+		// ------------------------------------------------------------
+		ts.SwipeUp()
+		registered := ts.RegisterUser("player_one", "correct horse battery")
+		testza.AssertTrue(t, registered.Msg.Session.Registered)
+		testza.AssertEqual(t, "player_one", registered.Msg.Session.Username)
+
+		// ------------------------------------------------------------
+		ts.LogMark("Logging in from a different device")
+		// ------------------------------------------------------------
+		ts.SwitchUser("GO_TESTER_2")
+		_, err := ts.client.Login(ts.context, connect.NewRequest(&tallyv1.LoginRequest{
+			Username: "player_one",
+			Password: "wrong password",
+		}))
+		testza.AssertEqual(t, connect.CodeUnauthenticated, connect.CodeOf(err), "Expected the wrong password to be rejected")
+
+		res, err := ts.client.Login(ts.context, connect.NewRequest(&tallyv1.LoginRequest{
+			Username: "player_one",
+			Password: "correct horse battery",
+		}))
+		ts.FatatErr("Login failed", err)
+		testza.AssertTrue(t, res.Msg.Session.Registered)
+		testza.AssertEqual(t, "player_one", res.Msg.Session.Username)
+		testza.AssertEqual(t, registered.Msg.Session.Game.Moves, res.Msg.Session.Game.Moves, "Expected the active game of the registered user")
+		testza.AssertEqual(t, int64(1), res.Msg.Session.Game.Moves)
+		ts.expectNewSession(res.Header(), res.Msg.Session.SessionId)
+
+		session, err := ts.client.GetSession(ts.context, connect.NewRequest(&tallyv1.GetSessionRequest{}))
+		ts.FatatErr("GetSession failed", err)
+		testza.AssertEqual(t, "player_one", session.Msg.Session.Username, "Expected the session to stay attached to the user")
+	})
+	t.Run("Should reject unknown usernames", func(t *testing.T) {
+		ts := newTestApi(t)
+		_, err := ts.client.Login(ts.context, connect.NewRequest(&tallyv1.LoginRequest{
+			Username: "nobody",
+			Password: "correct horse battery",
+		}))
+		testza.AssertEqual(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+	t.Run("Should require unique usernames", func(t *testing.T) {
+		ts := newTestApi(t)
+		ts.RegisterUser("player_one", "correct horse battery")
+
+		ts.SwitchUser("GO_TESTER_2")
+		_, err := ts.client.RegisterUser(ts.context, connect.NewRequest(&tallyv1.RegisterUserRequest{
+			Username: "player_one",
+			Password: "another passphrase",
+		}))
+		testza.AssertEqual(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	})
+	t.Run("Should validate the registration", func(t *testing.T) {
+		ts := newTestApi(t)
+		for _, req := range []*tallyv1.RegisterUserRequest{
+			{Username: "player_one", Password: "short"},
+			{Username: "no", Password: "correct horse battery"},
+			{Username: "has spaces", Password: "correct horse battery"},
+		} {
+			_, err := ts.client.RegisterUser(ts.context, connect.NewRequest(req))
+			testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err), req)
+		}
+		ts.RegisterUser("player_one", "correct horse battery")
+		_, err := ts.client.RegisterUser(ts.context, connect.NewRequest(&tallyv1.RegisterUserRequest{
+			Username: "player_two",
+			Password: "correct horse battery",
+		}))
+		testza.AssertEqual(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "Expected registering twice to fail")
+	})
+	t.Run("Should attach a session with a one-time link-code", func(t *testing.T) {
+		ts := newTestApi(t)
+
+		res, err := ts.client.CreateLinkCode(ts.context, connect.NewRequest(&tallyv1.CreateLinkCodeRequest{}))
+		ts.FatatErr("CreateLinkCode failed", err)
+		testza.AssertLen(t, res.Msg.Code, linkCodeLength)
+		testza.AssertGreater(t, res.Msg.ExpiresInSeconds, uint32(0))
+
+		// ------------------------------------------------------------
+		ts.LogMark("Using the link-code from a different device")
+		// ------------------------------------------------------------
+		ts.SwitchUser("GO_TESTER_2")
+		used, err := ts.client.UseLinkCode(ts.context, connect.NewRequest(&tallyv1.UseLinkCodeRequest{Code: res.Msg.Code}))
+		ts.FatatErr("UseLinkCode failed", err)
+		testza.AssertEqual(t, "GO_TESTER", used.Msg.Session.Username)
+		ts.expectNewSession(used.Header(), used.Msg.Session.SessionId)
+
+		// ------------------------------------------------------------
+		ts.LogMark("The link-code can only be used once")
+		// ------------------------------------------------------------
+		ts.SwitchUser("GO_TESTER_3")
+		_, err = ts.client.UseLinkCode(ts.context, connect.NewRequest(&tallyv1.UseLinkCodeRequest{Code: res.Msg.Code}))
+		testza.AssertEqual(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
+
+func Test_verifyPassword(t *testing.T) {
+	hash, err := hashPassword("correct horse battery")
+	testza.AssertNoError(t, err)
+	other, err := hashPassword("correct horse battery")
+	testza.AssertNoError(t, err)
+	testza.AssertNotEqual(t, hash, other, "Expected the hashes to be salted")
+
+	ok, err := verifyPassword("correct horse battery", hash)
+	testza.AssertNoError(t, err)
+	testza.AssertTrue(t, ok)
+	ok, err = verifyPassword("correct horse batteries", hash)
+	testza.AssertNoError(t, err)
+	testza.AssertFalse(t, ok)
+	_, err = verifyPassword("correct horse battery", "not-a-hash")
+	testza.AssertErrorIs(t, err, ErrPasswordHashInvalid)
+}
+
+func Test_verifyPassword_format(t *testing.T) {
+	// Test-vector from RFC 7914, section 11, which stored hashes must keep verifying against
+	key, _ := hex.DecodeString("55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783")
+	hash := "pbkdf2-sha256$1$" + base64.RawStdEncoding.EncodeToString([]byte("salt")) + "$" + base64.RawStdEncoding.EncodeToString(key)
+	ok, err := verifyPassword("passwd", hash)
+	testza.AssertNoError(t, err)
+	testza.AssertTrue(t, ok)
+
+	ok, err = verifyPassword("correct horse battery", dummyPasswordHash)
+	testza.AssertNoError(t, err, "Expected the dummy-hash to be valid")
+	testza.AssertFalse(t, ok)
+}
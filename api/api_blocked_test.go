@@ -0,0 +1,78 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+)
+
+func TestApi_BlockedCells(t *testing.T) {
+	t.Run("Should play challenges with blocked cells", func(t *testing.T) {
+		ts := newTestApi(t)
+		cells := toModalCells(cellCreator(
+			2, 2, 0,
+			tallylogic.BlockedCell, 0, 0,
+			4, tallylogic.BlockedCell, 0,
+		))
+		challenge := ts.CreateGameChallenge(&tallyv1.CreateGameChallengeRequest{
+			ChallengeNumber: 100,
+			IdealMoves:      2,
+			TargetCellValue: 16,
+			Columns:         3,
+			Rows:            3,
+			Name:            "L-shaped challenge",
+			Cells:           cells,
+		})
+		game := ts.NewGameChallenge(challenge.Msg.Id)
+		testza.AssertTrue(t, game.Msg.Board.Cells[3].Blocked)
+		testza.AssertTrue(t, game.Msg.Board.Cells[7].Blocked)
+		testza.AssertFalse(t, game.Msg.Board.Cells[0].Blocked)
+
+		// ------------------------------------------------------------
+		ts.LogMark("Swipes should not slide through the blocked cells")
+		// ------------------------------------------------------------
+		ts.SwipeRight()
+		testza.AssertEqual(t, int64(2), ts.Game().Cells()[2].Value())
+		testza.AssertEqual(t, int64(4), ts.Game().Cells()[6].Value())
+		testza.AssertTrue(t, ts.Game().Cells()[7].IsBlocked())
+
+		// ------------------------------------------------------------
+		ts.LogMark("Paths should not cross the blocked cells")
+		// ------------------------------------------------------------
+		_, err := ts.client.CombineCells(ts.context, connect.NewRequest(&tallyv1.CombineCellsRequest{
+			Selection: &tallyv1.CombineCellsRequest_Indexes{
+				Indexes: &tallyv1.Indexes{Index: []uint32{6, 3, 0}},
+			},
+		}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+	t.Run("Should reject base-values below the blocked cells", func(t *testing.T) {
+		ts := newTestApi(t)
+		cells := toModalCells(cellCreator(
+			2, 2, 0,
+			-2, 0, 0,
+			4, 0, 0,
+		))
+		_, err := ts.client.CreateGameChallenge(ts.context, connect.NewRequest(&tallyv1.CreateGameChallengeRequest{
+			ChallengeNumber: 100,
+			IdealMoves:      2,
+			TargetCellValue: 16,
+			Columns:         3,
+			Rows:            3,
+			Name:            "Invalid challenge",
+			Cells:           cells,
+		}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		_, err = ts.client.NewGameFromTemplate(ts.context, connect.NewRequest(&tallyv1.NewGameFromTemplateRequest{
+			TargetCellValue: 16,
+			Columns:         3,
+			Rows:            3,
+			Cells:           cells,
+		}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
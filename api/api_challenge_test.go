@@ -101,6 +101,44 @@ func TestApi_Challange_Restart(t *testing.T) {
 		checkHistoryLength()
 	})
 }
+func TestApi_Challange_Vote(t *testing.T) {
+	t.Run("Should register one vote per user for a challenge", func(t *testing.T) {
+		ts := newTestApi(t)
+
+		challenge := ts.CreateDefaultChallenge()
+		ts.NewGameChallenge(challenge.Msg.Id)
+
+		// ------------------------------------------------------------
+		ts.LogMark("Voting for the challenge")
+		// ------------------------------------------------------------
+		first := ts.VoteBoard(tallyv1.Vote_VOTE_OK_3)
+
+		// ------------------------------------------------------------
+		ts.LogMark("Voting again should update the existing vote")
+		// ------------------------------------------------------------
+		second := ts.VoteBoard(tallyv1.Vote_VOTE_GREAT_5)
+		testza.AssertEqual(t, first.Msg.Id, second.Msg.Id, "Expected the vote to be updated, not recreated")
+
+		dump := ts.GetDBDump()
+		testza.AssertLen(t, dump.Votes, 1, "Expected a single vote in the database")
+		testza.AssertEqual(t, challenge.Msg.Id, dump.Votes[0].TemplateID.String, "Expected the vote to be registered for the template")
+
+		// ------------------------------------------------------------
+		ts.LogMark("Vote totals should be returned with the challenges")
+		// ------------------------------------------------------------
+		res, err := ts.client.GetGameChallenges(ts.context, connect.NewRequest(&tallyv1.GetGameChallengesRequest{}))
+		ts.FatatErr("GetGameChallenges failed", err)
+		testza.AssertLen(t, res.Msg.Challenges, 1)
+		testza.AssertEqual(t, uint64(1), res.Msg.Challenges[0].VoteCount)
+		testza.AssertEqual(t, uint64(5), res.Msg.Challenges[0].FunVoteTotal)
+	})
+	t.Run("Should require a fun-vote", func(t *testing.T) {
+		ts := newTestApi(t)
+		_, err := ts.client.VoteBoard(ts.context, connect.NewRequest(&tallyv1.VoteBoardRequest{}))
+		testza.AssertNotNil(t, err, "Expected VoteBoard to fail without a fun-vote")
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
 func getTemplate(s string) *tallylogic.GameTemplate {
 	for i := 0; i < len(generated.GeneratedTemplates); i++ {
 		if generated.GeneratedTemplates[i].Name == s {
@@ -110,3 +148,80 @@ func getTemplate(s string) *tallylogic.GameTemplate {
 	}
 	return nil
 }
+func TestApi_Challange_Leaderboard(t *testing.T) {
+	t.Run("Should rank the best attempt of each user that won", func(t *testing.T) {
+		ts := newTestApi(t)
+
+		challenge := ts.CreateDefaultChallenge()
+		getLeaderboard := func(limit, offset uint32) *connect.Response[tallyv1.GetChallengeLeaderboardResponse] {
+			t.Helper()
+			res, err := ts.client.GetChallengeLeaderboard(ts.context, connect.NewRequest(&tallyv1.GetChallengeLeaderboardRequest{
+				ChallengeId: challenge.Msg.Id,
+				Limit:       limit,
+				Offset:      offset,
+			}))
+			ts.FatatErr("GetChallengeLeaderboard failed", err)
+			return res
+		}
+
+		// ------------------------------------------------------------
+		ts.LogMark("Solving the challenge with the first user")
+		// ------------------------------------------------------------
+		ts.NewGameChallenge(challenge.Msg.Id)
+		first := ts.SolveGameWithHints(3)
+		testza.AssertTrue(t, first.Msg.DidWin, "expected game to be won (solved)")
+
+		// ------------------------------------------------------------
+		ts.LogMark("Solving the challenge with a second user")
+		// ------------------------------------------------------------
+		ts.SwitchUser("GO_TESTER_2")
+		ts.NewGameChallenge(challenge.Msg.Id)
+		second := ts.SolveGameWithHints(3)
+		testza.AssertTrue(t, second.Msg.DidWin, "expected game to be won (solved)")
+
+		// ------------------------------------------------------------
+		ts.LogMark("A third user plays, but does not win")
+		// ------------------------------------------------------------
+		ts.SwitchUser("GO_TESTER_3")
+		ts.NewGameChallenge(challenge.Msg.Id)
+
+		res := getLeaderboard(0, 0)
+		testza.AssertEqual(t, uint32(2), res.Msg.TotalPlayers)
+		testza.AssertLen(t, res.Msg.Entries, 2)
+		testza.AssertNil(t, res.Msg.CurrentUser, "Expected no entry for a user that has not won")
+		testza.AssertEqual(t, "GO_TESTER", res.Msg.Entries[0].Username, "Expected whoever won first to be ranked first")
+		testza.AssertEqual(t, uint32(1), res.Msg.Entries[0].Rank)
+		testza.AssertEqual(t, "GO_TESTER_2", res.Msg.Entries[1].Username)
+		testza.AssertEqual(t, uint32(2), res.Msg.Entries[1].Rank)
+
+		// ------------------------------------------------------------
+		ts.LogMark("The leaderboard should be paginated")
+		// ------------------------------------------------------------
+		ts.SwitchUser("GO_TESTER_2")
+		page := getLeaderboard(1, 1)
+		testza.AssertEqual(t, uint32(2), page.Msg.TotalPlayers)
+		testza.AssertLen(t, page.Msg.Entries, 1)
+		testza.AssertEqual(t, "GO_TESTER_2", page.Msg.Entries[0].Username)
+		testza.AssertEqual(t, uint32(2), page.Msg.Entries[0].Rank)
+		testza.AssertLen(t, getLeaderboard(10, 2).Msg.Entries, 0)
+	})
+	t.Run("Should include the current users entry", func(t *testing.T) {
+		ts := newTestApi(t)
+
+		challenge := ts.CreateDefaultChallenge()
+		ts.NewGameChallenge(challenge.Msg.Id)
+		ts.SolveGameWithHints(3)
+		res, err := ts.client.GetChallengeLeaderboard(ts.context, connect.NewRequest(&tallyv1.GetChallengeLeaderboardRequest{
+			ChallengeId: challenge.Msg.Id,
+		}))
+		ts.FatatErr("GetChallengeLeaderboard failed", err)
+		testza.AssertNotNil(t, res.Msg.CurrentUser)
+		testza.AssertEqual(t, "GO_TESTER", res.Msg.CurrentUser.Username)
+		testza.AssertEqual(t, uint32(1), res.Msg.CurrentUser.Rank)
+	})
+	t.Run("Should require a challenge-id", func(t *testing.T) {
+		ts := newTestApi(t)
+		_, err := ts.client.GetChallengeLeaderboard(ts.context, connect.NewRequest(&tallyv1.GetChallengeLeaderboardRequest{}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
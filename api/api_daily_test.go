@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func TestApi_Daily(t *testing.T) {
+	today := types.DailyDate(time.Now())
+	yesterday := types.DailyDate(time.Now().AddDate(0, 0, -1))
+	t.Run("Should return the same board for every request on the day", func(t *testing.T) {
+		ts := newTestApi(t)
+		daily := ts.GetDailyChallenge("").Msg.DailyChallenge
+		testza.AssertEqual(t, today, daily.Date)
+		testza.AssertNil(t, daily.RankedAttempt)
+		testza.AssertLen(t, daily.Challenge.Cells, 25)
+
+		ts.SwitchUser("GO_TESTER_2")
+		again := ts.GetDailyChallenge(today).Msg.DailyChallenge
+		testza.AssertEqual(t, daily.Challenge.Id, again.Challenge.Id)
+		testza.AssertEqual(t, fromModalCells(daily.Challenge.Cells), fromModalCells(again.Challenge.Cells))
+
+		for _, date := range []string{types.DailyDate(time.Now().AddDate(0, 0, 2)), "yesterday"} {
+			_, err := ts.client.GetDailyChallenge(ts.context, connect.NewRequest(&tallyv1.GetDailyChallengeRequest{Date: date}))
+			testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err), date)
+		}
+	})
+	t.Run("Should only generate the challenge for today", func(t *testing.T) {
+		ts := newTestApi(t)
+		for _, date := range []string{yesterday, "0001-01-01"} {
+			_, err := ts.client.GetDailyChallenge(ts.context, connect.NewRequest(&tallyv1.GetDailyChallengeRequest{Date: date}))
+			testza.AssertEqual(t, connect.CodeNotFound, connect.CodeOf(err), date)
+		}
+		testza.AssertLen(t, ts.GetDBDump().Templates, 0, "Expected no challenges to be generated for the previous days")
+
+		ts.CreateDailyChallenge(yesterday)
+		previous := ts.GetDailyChallenge(yesterday).Msg.DailyChallenge
+		testza.AssertEqual(t, yesterday, previous.Date)
+		testza.AssertNotEqual(t, ts.GetDailyChallenge(today).Msg.DailyChallenge.Challenge.Id, previous.Challenge.Id)
+	})
+	t.Run("Should return the challenge stored by another instance of the server", func(t *testing.T) {
+		ts := newTestApi(t)
+		competing, err := generateDailyChallenge(ts.context, today, ts.tally.UidGenerator())
+		ts.FatatErr("generateDailyChallenge failed", err)
+		server := ts.tally
+		server.storage = racingDailyStorage{PersistantStorage: ts.tally.storage, competing: competing}
+
+		daily, err := server.getOrCreateDailyChallenge(ts.context, today, ts.Session().UserID)
+		ts.FatatErr("getOrCreateDailyChallenge failed", err)
+		testza.AssertEqual(t, competing.ID, daily.ID)
+	})
+	t.Run("Should only rank the first attempt of each user", func(t *testing.T) {
+		ts := newTestApi(t)
+		daily := ts.GetDailyChallenge("").Msg.DailyChallenge
+		ts.NewGameChallenge(daily.Challenge.Id)
+		res := ts.SolveGameWithHints(30)
+		testza.AssertTrue(t, res.Msg.DidWin)
+		rankedGame := ts.Game().ID
+
+		ts.NewGameChallenge(daily.Challenge.Id)
+		ts.SolveGameWithHints(30)
+		attempt := ts.GetDailyChallenge("").Msg.DailyChallenge.RankedAttempt
+		testza.AssertNotNil(t, attempt)
+		testza.AssertEqual(t, rankedGame, attempt.GameId, "Expected the first attempt to be ranked")
+		testza.AssertEqual(t, tallyv1.PlayState_PLAY_STATE_WON, attempt.PlayState)
+
+		ts.SwitchUser("GO_TESTER_2")
+		ts.NewGameChallenge(daily.Challenge.Id)
+		firstAttempt := ts.Game().ID
+		ts.NewGameChallenge(daily.Challenge.Id)
+		ts.SolveGameWithHints(30)
+		attempt = ts.GetDailyChallenge("").Msg.DailyChallenge.RankedAttempt
+		testza.AssertEqual(t, firstAttempt, attempt.GameId)
+		testza.AssertEqual(t, tallyv1.PlayState_PLAY_STATE_ABANDONED, attempt.PlayState)
+
+		leaderboard := ts.GetDailyLeaderboard(today).Msg
+		testza.AssertEqual(t, uint32(1), leaderboard.TotalPlayers)
+		testza.AssertLen(t, leaderboard.Entries, 1)
+		testza.AssertEqual(t, rankedGame, leaderboard.Entries[0].GameId)
+		testza.AssertNil(t, leaderboard.CurrentUser, "Expected a later win to not be ranked")
+
+		_, err := ts.client.GetDailyLeaderboard(ts.context, connect.NewRequest(&tallyv1.GetDailyLeaderboardRequest{Date: yesterday}))
+		testza.AssertEqual(t, connect.CodeNotFound, connect.CodeOf(err), "Expected no leaderboard for a day without a challenge")
+	})
+	t.Run("Should list the previous days, the most recent first", func(t *testing.T) {
+		ts := newTestApi(t)
+		dates := []string{yesterday, today, types.DailyDate(time.Now().AddDate(0, 0, -2))}
+		for _, date := range dates {
+			ts.CreateDailyChallenge(date)
+		}
+		res, err := ts.client.ListDailyChallenges(ts.context, connect.NewRequest(&tallyv1.ListDailyChallengesRequest{}))
+		ts.FatatErr("ListDailyChallenges failed", err)
+		testza.AssertEqual(t, uint32(3), res.Msg.Total)
+		testza.AssertLen(t, res.Msg.DailyChallenges, 3)
+		testza.AssertEqual(t, today, res.Msg.DailyChallenges[0].Date)
+		testza.AssertEqual(t, yesterday, res.Msg.DailyChallenges[1].Date)
+		testza.AssertEqual(t, dates[2], res.Msg.DailyChallenges[2].Date)
+
+		page, err := ts.client.ListDailyChallenges(ts.context, connect.NewRequest(&tallyv1.ListDailyChallengesRequest{Limit: 1, Offset: 1}))
+		ts.FatatErr("ListDailyChallenges failed", err)
+		testza.AssertLen(t, page.Msg.DailyChallenges, 1)
+		testza.AssertEqual(t, yesterday, page.Msg.DailyChallenges[0].Date)
+	})
+}
+
+// Stores a competing daily challenge right before the challenge is stored,
+// like another instance of the server would.
+type racingDailyStorage struct {
+	PersistantStorage
+	competing types.CreateGameTemplatePayload
+}
+
+func (r racingDailyStorage) CreateGameTemplate(ctx context.Context, payload types.CreateGameTemplatePayload) (*types.GameTemplate, error) {
+	if _, err := r.PersistantStorage.CreateGameTemplate(ctx, r.competing); err != nil {
+		return nil, err
+	}
+	return r.PersistantStorage.CreateGameTemplate(ctx, payload)
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+)
+
+func TestApi_Games(t *testing.T) {
+	t.Run("Should list and resume previous games", func(t *testing.T) {
+		ts := newTestApi(t)
+		ts.SwipeUp()
+		firstGame := ts.Game()
+		challenge := ts.CreateDefaultChallenge()
+		challengeGame := ts.NewGameChallenge(challenge.Msg.Id)
+
+		list := ts.ListGames(&tallyv1.ListGamesRequest{})
+		testza.AssertEqual(t, uint32(2), list.Msg.Total)
+		testza.AssertLen(t, list.Msg.Games, 2)
+		latest, previous := list.Msg.Games[0], list.Msg.Games[1]
+		testza.AssertEqual(t, challengeGame.Msg.Board.Id, latest.Id, "Expected the most recent game first")
+		testza.AssertTrue(t, latest.Active)
+		testza.AssertEqual(t, tallyv1.PlayState_PLAY_STATE_CURRENT, latest.PlayState)
+		testza.AssertEqual(t, tallyv1.GameMode_GAME_MODE_RANDOM_CHALLENGE, latest.Mode)
+		testza.AssertEqual(t, challenge.Msg.Id, latest.ChallengeId)
+		testza.AssertEqual(t, firstGame.ID, previous.Id)
+		testza.AssertFalse(t, previous.Active)
+		testza.AssertEqual(t, tallyv1.PlayState_PLAY_STATE_ABANDONED, previous.PlayState)
+		testza.AssertEqual(t, int64(1), previous.Moves)
+		testza.AssertEqual(t, firstGame.Cells(), fromModalCells(previous.Board.Cells), "Expected the board as it was left")
+
+		resumed := ts.ResumeGame(firstGame.ID)
+		testza.AssertEqual(t, firstGame.ID, resumed.Msg.Game.Board.Id)
+		testza.AssertEqual(t, int64(1), resumed.Msg.Game.Moves)
+		testza.AssertEqual(t, firstGame.ID, ts.Game().ID, "Expected the resumed game to be active")
+		ts.SwipeDown()
+		testza.AssertEqual(t, 2, ts.Game().Moves(), "Expected to continue where the game was left")
+
+		list = ts.ListGames(&tallyv1.ListGamesRequest{
+			PlayStates: []tallyv1.PlayState{tallyv1.PlayState_PLAY_STATE_ABANDONED},
+		})
+		testza.AssertEqual(t, uint32(1), list.Msg.Total)
+		testza.AssertEqual(t, challengeGame.Msg.Board.Id, list.Msg.Games[0].Id, "Expected the challenge to be abandoned")
+	})
+	t.Run("Should filter and page games", func(t *testing.T) {
+		ts := newTestApi(t)
+		challenge := ts.CreateDefaultChallenge()
+		ts.NewGameChallenge(challenge.Msg.Id)
+		ts.NewGame(tallyv1.GameMode_GAME_MODE_RANDOM)
+		ts.NewGameChallenge(challenge.Msg.Id)
+
+		list := ts.ListGames(&tallyv1.ListGamesRequest{ChallengeId: challenge.Msg.Id})
+		testza.AssertEqual(t, uint32(2), list.Msg.Total)
+		list = ts.ListGames(&tallyv1.ListGamesRequest{Modes: []tallyv1.GameMode{tallyv1.GameMode_GAME_MODE_RANDOM}})
+		for _, g := range list.Msg.Games {
+			testza.AssertEqual(t, tallyv1.GameMode_GAME_MODE_RANDOM, g.Mode)
+		}
+		all := ts.ListGames(&tallyv1.ListGamesRequest{})
+		page := ts.ListGames(&tallyv1.ListGamesRequest{Limit: 1, Offset: 1})
+		testza.AssertEqual(t, all.Msg.Total, page.Msg.Total)
+		testza.AssertLen(t, page.Msg.Games, 1)
+		testza.AssertEqual(t, all.Msg.Games[1].Id, page.Msg.Games[0].Id)
+	})
+	t.Run("Should not resume finished games, or games of other users", func(t *testing.T) {
+		ts := newTestApi(t)
+		otherUsersGame := ts.Game().ID
+		ts.SwitchUser("OTHER_TESTER")
+		_, err := ts.client.ResumeGame(ts.context, connect.NewRequest(&tallyv1.ResumeGameRequest{GameId: otherUsersGame}))
+		testza.AssertEqual(t, connect.CodeNotFound, connect.CodeOf(err))
+		_, err = ts.client.ResumeGame(ts.context, connect.NewRequest(&tallyv1.ResumeGameRequest{GameId: "does-not-exist"}))
+		testza.AssertEqual(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		challenge := ts.CreateDefaultChallenge()
+		ts.NewGameChallenge(challenge.Msg.Id)
+		res := ts.SolveGameWithHints(3)
+		testza.AssertTrue(t, res.Msg.DidWin)
+		wonGame := ts.Game().ID
+		ts.NewGame(tallyv1.GameMode_GAME_MODE_RANDOM)
+		_, err = ts.client.ResumeGame(ts.context, connect.NewRequest(&tallyv1.ResumeGameRequest{GameId: wonGame}))
+		testza.AssertEqual(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+)
+
+func TestApi_Hint(t *testing.T) {
+	t.Run("Should pick the hint by the hint-preference", func(t *testing.T) {
+		ts := newTestApi(t)
+		challenge := ts.CreateGameChallenge(&tallyv1.CreateGameChallengeRequest{
+			ChallengeNumber: 100,
+			IdealMoves:      1,
+			TargetCellValue: 16,
+			Columns:         3,
+			Rows:            3,
+			Name:            "Hint challenge",
+			Cells: toModalCells(cellCreator(
+				2, 2, 0,
+				8, 8, 8,
+				0, 1, 2,
+			)),
+		})
+		ts.NewGameChallenge(challenge.Msg.Id)
+		hint := func(preference tallyv1.HintPreference) []uint32 {
+			t.Helper()
+			res, err := ts.client.GetHint(ts.context, connect.NewRequest(&tallyv1.GetHintRequest{HintPreference: preference}))
+			ts.FatatErr("GetHint failed", err)
+			testza.AssertGreater(t, len(res.Msg.Instructions), 0, "Expected a hint for %s", preference)
+			combine, ok := res.Msg.Instructions[0].InstructionOneof.(*tallyv1.Instruction_Combine)
+			if !ok {
+				t.Fatalf("Expected the hint for %s to start with a combine, got %v", preference, res.Msg.Instructions[0])
+			}
+			return combine.Combine.Index
+		}
+
+		// 8+8 wins right away
+		testza.AssertEqual(t, []uint32{3, 4}, hint(tallyv1.HintPreference_HINT_PREFERENCE_SHORT))
+		// 2*2 first gives a higher score
+		testza.AssertEqual(t, []uint32{0, 1}, hint(tallyv1.HintPreference_HINT_PREFERENCE_HIGHEST_SCORE))
+	})
+}
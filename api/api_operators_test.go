@@ -0,0 +1,51 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+)
+
+func operatorsChallengeForTest(withSubtraction bool) *tallyv1.CreateGameChallengeRequest {
+	return &tallyv1.CreateGameChallengeRequest{
+		ChallengeNumber: 100,
+		IdealMoves:      2,
+		TargetCellValue: 16,
+		Columns:         3,
+		Rows:            3,
+		Name:            "Subtraction challenge",
+		Cells: toModalCells(cellCreator(
+			5, 3, 2,
+			0, 0, 0,
+			0, 0, 0,
+		)),
+		WithSubtraction: withSubtraction,
+	}
+}
+
+func TestApi_EvalMethods(t *testing.T) {
+	t.Run("Should combine cells with the evaluation-methods from the rules", func(t *testing.T) {
+		ts := newTestApi(t)
+		challenge := ts.CreateGameChallenge(operatorsChallengeForTest(true))
+		ts.NewGameChallenge(challenge.Msg.Id)
+		testza.AssertTrue(t, ts.Game().Rules.Options.WithSubtraction, "Expected the rule to be stored")
+
+		res := ts.CombineCellsByIndexPath(0, 1, 2)
+		testza.AssertEqual(t, tallyv1.EvalMethod_EVAL_METHOD_SUBTRACTION, res.Msg.EvalMethod)
+		testza.AssertEqual(t, int64(4), ts.Game().Cells()[2].Value())
+	})
+	t.Run("Should not allow evaluation-methods that are not in the rules", func(t *testing.T) {
+		ts := newTestApi(t)
+		challenge := ts.CreateGameChallenge(operatorsChallengeForTest(false))
+		ts.NewGameChallenge(challenge.Msg.Id)
+
+		_, err := ts.client.CombineCells(ts.context, connect.NewRequest(&tallyv1.CombineCellsRequest{
+			Selection: &tallyv1.CombineCellsRequest_Indexes{
+				Indexes: &tallyv1.Indexes{Index: []uint32{0, 1, 2}},
+			},
+		}))
+		testza.AssertEqual(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+)
+
+// Returns the index of a cell that is not empty
+func findFilledCell(t *testing.T, game tallylogic.Game) uint32 {
+	t.Helper()
+	for i, c := range game.Cells() {
+		if !c.IsEmpty() {
+			return uint32(i)
+		}
+	}
+	t.Fatalf("no filled cells in board:\n%s", game.Print())
+	return 0
+}
+
+func TestApi_UsePower(t *testing.T) {
+	t.Run("Should use super-powers until there are none left", func(t *testing.T) {
+		ts := newTestApi(t)
+		game := ts.NewGame(tallyv1.GameMode_GAME_MODE_RANDOM)
+		testza.AssertEqual(t, uint32(tallylogic.DefaultMaxPowers), game.Msg.PowersLeft)
+
+		index := findFilledCell(t, ts.Game())
+		value := ts.Game().Cells()[index].Value()
+		res := ts.UsePower(tallyv1.Power_POWER_DOUBLE_CELL, index)
+		testza.AssertEqual(t, uint32(tallylogic.DefaultMaxPowers-1), res.Msg.PowersLeft)
+		testza.AssertEqual(t, int64(1), res.Msg.Moves)
+		testza.AssertEqual(t, value*2, ts.Game().Cells()[index].Value())
+
+		res = ts.UsePower(tallyv1.Power_POWER_REMOVE_CELL, index)
+		testza.AssertTrue(t, ts.Game().Cells()[index].IsEmpty())
+		res = ts.UsePower(tallyv1.Power_POWER_SHUFFLE, 0)
+		testza.AssertEqual(t, uint32(0), res.Msg.PowersLeft)
+
+		_, err := ts.client.UsePower(ts.context, connect.NewRequest(&tallyv1.UsePowerRequest{Power: tallyv1.Power_POWER_REMOVE_CELL, Index: findFilledCell(t, ts.Game())}))
+		testza.AssertEqual(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		undo := ts.Undo()
+		testza.AssertEqual(t, uint32(1), undo.Msg.PowersLeft, "Expected undo to give back the super-power")
+		testza.AssertEqual(t, 1, ts.Game().PowersLeft())
+		g := ts.Game()
+		history, err := g.History.All()
+		testza.AssertNoError(t, err)
+		testza.AssertTrue(t, history[0].Equal(tallylogic.NewPowerInstruction_(tallylogic.PowerDoubleCell, int(index))))
+	})
+	t.Run("Should reject invalid super-powers", func(t *testing.T) {
+		ts := newTestApi(t)
+		_, err := ts.client.UsePower(ts.context, connect.NewRequest(&tallyv1.UsePowerRequest{Power: tallyv1.Power_POWER_REMOVE_CELL, Index: findFilledCell(t, ts.Game())}))
+		testza.AssertEqual(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "Expected the tutorial to have no super-powers")
+
+		ts.NewGame(tallyv1.GameMode_GAME_MODE_RANDOM)
+		_, err = ts.client.UsePower(ts.context, connect.NewRequest(&tallyv1.UsePowerRequest{Power: tallyv1.Power_POWER_UNSPECIFIED}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		_, err = ts.client.UsePower(ts.context, connect.NewRequest(&tallyv1.UsePowerRequest{Power: tallyv1.Power_POWER_DOUBLE_CELL, Index: 1000}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		testza.AssertEqual(t, 0, ts.Game().Moves())
+	})
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/sqlite"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func TestApi_Session_Expiry(t *testing.T) {
+	expireSession := func(ts testApi, sessionID string, invalidAfter time.Time) {
+		t.Helper()
+		err := ts.tally.storage.RefreshSession(ts.context, types.RefreshSessionPayload{
+			SessionID:    sessionID,
+			InvalidAfter: invalidAfter,
+		})
+		ts.FatatErr("RefreshSession failed", err)
+	}
+	findSession := func(ts testApi, sessionID string) *sqlite.Session {
+		return find(ts.GetDBDump().Sessions, func(s sqlite.Session) bool { return s.ID == sessionID })
+	}
+	t.Run("Should move the user to a new session when the session has expired", func(t *testing.T) {
+		ts := newTestApi(t)
+		oldSessionID := ts.initialSession.Msg.Session.SessionId
+		ts.SwipeUp()
+		expireSession(ts, oldSessionID, time.Now().Add(-time.Minute))
+
+		res, err := ts.client.GetSession(ts.context, connect.NewRequest(&tallyv1.GetSessionRequest{}))
+		ts.FatatErr("GetSession failed for an expired session", err)
+		newSessionID := res.Header().Get(tokenHeader)
+		testza.AssertNotEqual(t, "", newSessionID, "Expected a new session-id to be returned")
+		testza.AssertNotEqual(t, oldSessionID, newSessionID)
+		testza.AssertEqual(t, newSessionID, res.Msg.Session.SessionId)
+		testza.AssertEqual(t, "GO_TESTER", res.Msg.Session.Username, "Expected the same user")
+		testza.AssertEqual(t, int64(1), res.Msg.Session.Game.Moves, "Expected the same active game")
+
+		newSession := findSession(ts, newSessionID)
+		testza.AssertNotNil(t, newSession)
+		testza.AssertEqual(t, ts.initialSession.Msg.Session.Username, res.Msg.Session.Username)
+		testza.AssertTrue(t, newSession.InvalidAfter.After(time.Now()))
+	})
+	t.Run("Should move repeated requests with the same expired session to the same new session", func(t *testing.T) {
+		ts := newTestApi(t)
+		oldSessionID := ts.initialSession.Msg.Session.SessionId
+		expireSession(ts, oldSessionID, time.Now().Add(-time.Minute))
+
+		// The client keeps sending the expired session, as with concurrent requests
+		first, err := ts.client.GetSession(ts.context, connect.NewRequest(&tallyv1.GetSessionRequest{}))
+		ts.FatatErr("GetSession failed for an expired session", err)
+		second, err := ts.client.GetSession(ts.context, connect.NewRequest(&tallyv1.GetSessionRequest{}))
+		ts.FatatErr("GetSession failed for an expired session that was already renewed", err)
+		newSessionID := first.Header().Get(tokenHeader)
+		testza.AssertNotEqual(t, oldSessionID, newSessionID)
+		testza.AssertEqual(t, newSessionID, second.Header().Get(tokenHeader), "Expected the same new session for both requests")
+		testza.AssertEqual(t, newSessionID, second.Msg.Session.SessionId)
+
+		testza.AssertLen(t, ts.GetDBDump().Sessions, 2, "Expected only one new session, besides the expired one")
+		oldSession := findSession(ts, oldSessionID)
+		testza.AssertNotNil(t, oldSession)
+		testza.AssertEqual(t, newSessionID, oldSession.ReplacedBy.String)
+	})
+	t.Run("Should extend active sessions", func(t *testing.T) {
+		ts := newTestApi(t)
+		sessionID := ts.initialSession.Msg.Session.SessionId
+		soon := time.Now().Add(time.Hour)
+		expireSession(ts, sessionID, soon)
+
+		res, err := ts.client.GetSession(ts.context, connect.NewRequest(&tallyv1.GetSessionRequest{}))
+		ts.FatatErr("GetSession failed", err)
+		testza.AssertEqual(t, sessionID, res.Msg.Session.SessionId, "Expected the session to be kept")
+
+		session := findSession(ts, sessionID)
+		testza.AssertNotNil(t, session)
+		testza.AssertTrue(t, session.InvalidAfter.After(soon.Add(24*time.Hour)), "Expected the session to be extended")
+	})
+	t.Run("Should reap expired sessions and orphaned guest-users", func(t *testing.T) {
+		ts := newTestApi(t)
+		playerSessionID := ts.initialSession.Msg.Session.SessionId
+		ts.SwipeUp()
+
+		ts.SwitchUser("GO_TESTER_2")
+		guestSessionID := ts.initialSession.Msg.Session.SessionId
+		ts.SwitchUser("GO_TESTER_3")
+
+		stats, err := ts.tally.storage.Stats(ts.context)
+		ts.FatatErr("Stats failed", err)
+		testza.AssertEqual(t, int64(3), stats.Session)
+
+		expireSession(ts, playerSessionID, time.Now().Add(-time.Hour))
+		expireSession(ts, guestSessionID, time.Now().Add(-time.Hour))
+		stats, err = ts.tally.storage.Stats(ts.context)
+		ts.FatatErr("Stats failed", err)
+		testza.AssertEqual(t, int64(1), stats.Session, "Expected only live sessions to be counted")
+
+		// ------------------------------------------------------------
+		ts.LogMark("Reaping the expired sessions")
+		// ------------------------------------------------------------
+		result, err := ts.tally.reapSessions(ts.context, time.Now())
+		ts.FatatErr("reapSessions failed", err)
+		testza.AssertEqual(t, int64(2), result.Sessions)
+		testza.AssertEqual(t, int64(1), result.Users, "Expected only the guest that never played to be deleted")
+		testza.AssertEqual(t, int64(1), result.Games)
+
+		dump := ts.GetDBDump()
+		testza.AssertLen(t, dump.Sessions, 1)
+		testza.AssertLen(t, dump.Users, 2)
+		testza.AssertNil(t, find(dump.Users, func(u sqlite.User) bool { return u.Username == "GO_TESTER_2" }))
+		testza.AssertNotNil(t, find(dump.Users, func(u sqlite.User) bool { return u.Username == "GO_TESTER" }))
+	})
+	t.Run("Should stop reaping when the context is done", func(t *testing.T) {
+		ts := newTestApi(t)
+		ctx, cancel := context.WithCancel(ts.context)
+		cancel()
+		done := make(chan struct{})
+		go func() {
+			ts.tally.reapSessionsAtInterval(ctx, time.Millisecond)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected the reaper to stop when the context is done")
+		}
+	})
+}
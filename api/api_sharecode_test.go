@@ -0,0 +1,108 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/storage"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+)
+
+func TestApi_ShareCode(t *testing.T) {
+	t.Run("Should start the shared game from a share-code, with its history", func(t *testing.T) {
+		ts := newTestApi(t)
+		challenge := ts.CreateGameChallenge(topologyChallengeForTest(tallyv1.Topology_TOPOLOGY_EIGHT_WAY))
+		game := ts.NewGameChallenge(challenge.Msg.Id)
+		ts.CombineCellsByIndexPath(0, 4, 8)
+		ts.SwipeLeft()
+		code := ts.CreateShareCode(true).Msg.Code
+
+		// ------------------------------------------------------------
+		ts.LogMark("Another user should get the game as it was at the start")
+		// ------------------------------------------------------------
+		ts.SwitchUser("bob")
+		shared := ts.NewGameFromShareCode(code)
+		testza.AssertLen(t, shared.Msg.Board.Cells, len(game.Msg.Board.Cells))
+		ts.expectSimpleBoardEquality(
+			2, 0, 0,
+			0, 2, 0,
+			0, 0, 4,
+		)
+		testza.AssertEqual(t, tallyv1.Topology_TOPOLOGY_EIGHT_WAY, shared.Msg.Board.Topology)
+		testza.AssertEqual(t, tallyv1.GameMode_GAME_MODE_RANDOM_CHALLENGE, shared.Msg.Mode)
+		testza.AssertEqual(t, game.Msg.Description, shared.Msg.Description)
+		testza.AssertEqual(t, int64(0), shared.Msg.Moves)
+		testza.AssertLen(t, shared.Msg.History, 2)
+
+		// ------------------------------------------------------------
+		ts.LogMark("The shared game should be playable with the same rules")
+		// ------------------------------------------------------------
+		ts.CombineCellsByIndexPath(0, 4, 8)
+		testza.AssertEqual(t, int64(8), ts.Game().Cells()[8].Value())
+		testza.AssertEqual(t, 1, ts.Game().Moves())
+	})
+	t.Run("Should create a shorter share-code without history", func(t *testing.T) {
+		ts := newTestApi(t)
+		challenge := ts.CreateGameChallenge(topologyChallengeForTest(tallyv1.Topology_TOPOLOGY_EIGHT_WAY))
+		ts.NewGameChallenge(challenge.Msg.Id)
+		ts.CombineCellsByIndexPath(0, 4, 8)
+		ts.SwipeLeft()
+		withHistory := ts.CreateShareCode(true).Msg.Code
+		code := ts.CreateShareCode(false).Msg.Code
+		testza.AssertLess(t, len(code), len(withHistory))
+
+		shared := ts.NewGameFromShareCode(code)
+		testza.AssertLen(t, shared.Msg.History, 0)
+		testza.AssertEqual(t, int64(4), ts.Game().Cells()[8].Value(), "Expected the game to be at the start")
+	})
+	t.Run("Should reject invalid share-codes", func(t *testing.T) {
+		ts := newTestApi(t)
+		ts.CreateDefaultChallenge()
+		code := ts.CreateShareCode(false).Msg.Code
+		for _, invalid := range []string{"", "not a code!", code[:len(code)-4], code[:8] + "AAAA" + code[12:]} {
+			_, err := ts.client.NewGameFromShareCode(ts.context, connect.NewRequest(&tallyv1.NewGameFromShareCodeRequest{Code: invalid}))
+			testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err), invalid)
+		}
+	})
+	t.Run("Should reject share-codes with rules that cannot be played", func(t *testing.T) {
+		ts := newTestApi(t)
+		ts.CreateDefaultChallenge()
+		g, err := storage.DecodeShareCode(ts.CreateShareCode(false).Msg.Code)
+		ts.FatatErr("DecodeShareCode failed", err)
+		g.Rules.NoMultiply, g.Rules.NoAddition = true, true
+		g.Rules.WithSubtraction, g.Rules.WithDivision = false, false
+		code, err := storage.EncodeShareCode(g)
+		ts.FatatErr("EncodeShareCode failed", err)
+		_, err = ts.client.NewGameFromShareCode(ts.context, connect.NewRequest(&tallyv1.NewGameFromShareCodeRequest{Code: code}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+	t.Run("Should reject share-codes with a history that is too long to replay", func(t *testing.T) {
+		ts := newTestApi(t)
+		ts.CreateDefaultChallenge()
+		g, err := storage.DecodeShareCode(ts.CreateShareCode(false).Msg.Code)
+		ts.FatatErr("DecodeShareCode failed", err)
+		newGameWithHistory := func(history []byte) error {
+			t.Helper()
+			g.History = history
+			code, err := storage.EncodeShareCode(g)
+			ts.FatatErr("EncodeShareCode failed", err)
+			_, err = ts.client.NewGameFromShareCode(ts.context, connect.NewRequest(&tallyv1.NewGameFromShareCodeRequest{Code: code}))
+			return err
+		}
+
+		h := tallylogic.NewCompactHistory(int(g.Rules.Columns), int(g.Rules.Rows))
+		for i := 0; i <= shareCodeMaxHistoryInstructions; i++ {
+			h.AddSwipe(tallylogic.SwipeDirectionUp)
+		}
+		testza.AssertLess(t, len(h.Bytes()), shareCodeMaxHistoryBytes)
+		err = newGameWithHistory(h.Bytes())
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err), "Expected too many instructions to be rejected")
+		testza.AssertContains(t, err.Error(), "instructions")
+
+		err = newGameWithHistory(make([]byte, shareCodeMaxHistoryBytes+1))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err), "Expected too many bytes to be rejected")
+		testza.AssertContains(t, err.Error(), "bytes")
+	})
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+)
+
+// The other api-tests use the in-memory storage, so this plays through the api with sqlite,
+// which is what the server uses by default.
+func TestApi_Sqlite(t *testing.T) {
+	t.Run("Should play, undo, restart and login with sqlite", func(t *testing.T) {
+		ts := newTestApiWithDSN(t, testSqliteDSN())
+		dbGame := ts.DbGame()
+		testza.AssertNotEqual(t, "", dbGame.TemplateID.String, "TemplateID should be set for a new session")
+		testza.AssertEqual(t, ts.initialGame.Name, dbGame.Name.String)
+
+		// ------------------------------------------------------------
+		ts.LogMark("Moves should be stored")
+		// ------------------------------------------------------------
+		ts.SwipeUp()
+		testza.AssertEqual(t, int64(1), ts.DbGame().Moves)
+		ts.Undo()
+		testza.AssertEqual(t, ts.initialGame.Cells(), ts.Game().Cells(), "Expected the undo to be stored")
+
+		// ------------------------------------------------------------
+		ts.LogMark("Restarting should store a new game")
+		// ------------------------------------------------------------
+		ts.SwipeUp()
+		restarted := ts.RestartGame()
+		testza.AssertEqual(t, int64(0), restarted.Msg.Moves)
+		active := ts.DbGameById(ts.Game().ID)
+		testza.AssertNotEqual(t, dbGame.ID, active.ID)
+		testza.AssertEqual(t, int64(0), active.Moves)
+
+		// ------------------------------------------------------------
+		ts.LogMark("Logging in from a different device")
+		// ------------------------------------------------------------
+		ts.SwipeUp()
+		ts.RegisterUser("player_one", "correct horse battery")
+		ts.SwitchUser("GO_TESTER_2")
+		res, err := ts.client.Login(ts.context, connect.NewRequest(&tallyv1.LoginRequest{
+			Username: "player_one",
+			Password: "correct horse battery",
+		}))
+		ts.FatatErr("Login failed", err)
+		testza.AssertEqual(t, "player_one", res.Msg.Session.Username)
+		testza.AssertEqual(t, int64(1), res.Msg.Session.Game.Moves)
+		ts.expectNewSession(res.Header(), res.Msg.Session.SessionId)
+	})
+}
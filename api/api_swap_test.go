@@ -0,0 +1,59 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+)
+
+// Returns two cells that may be swapped
+func findSwappableCells(t *testing.T, game tallylogic.Game) (uint32, uint32) {
+	t.Helper()
+	cells := game.Cells()
+	for a := range cells {
+		for b := a + 1; b < len(cells); b++ {
+			if !cells[a].IsEmpty() && !cells[b].IsEmpty() && cells[a].Value() != cells[b].Value() {
+				return uint32(a), uint32(b)
+			}
+		}
+	}
+	t.Fatalf("no swappable cells in board:\n%s", game.Print())
+	return 0, 0
+}
+
+func TestApi_SwapCells(t *testing.T) {
+	t.Run("Should swap cells until there are no swaps left", func(t *testing.T) {
+		ts := newTestApi(t)
+		game := ts.NewGame(tallyv1.GameMode_GAME_MODE_RANDOM)
+		testza.AssertEqual(t, uint32(tallylogic.DefaultMaxSwaps), game.Msg.SwapsLeft)
+		for i := tallylogic.DefaultMaxSwaps; i > 0; i-- {
+			before := ts.Game()
+			a, b := findSwappableCells(t, before)
+			res := ts.SwapCells(a, b)
+			testza.AssertEqual(t, uint32(i-1), res.Msg.SwapsLeft)
+			testza.AssertEqual(t, int64(before.Moves()+1), res.Msg.Moves)
+			after := ts.Game()
+			testza.AssertEqual(t, before.Cells()[a].Value(), after.Cells()[b].Value())
+			testza.AssertEqual(t, before.Cells()[b].Value(), after.Cells()[a].Value())
+		}
+		a, b := findSwappableCells(t, ts.Game())
+		_, err := ts.client.SwapCells(ts.context, connect.NewRequest(&tallyv1.SwapCellsRequest{IndexA: a, IndexB: b}))
+		testza.AssertEqual(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		undo := ts.Undo()
+		testza.AssertEqual(t, uint32(1), undo.Msg.SwapsLeft, "Expected undo to give back the swap")
+		testza.AssertEqual(t, 1, ts.Game().SwapsLeft())
+	})
+	t.Run("Should reject invalid swaps", func(t *testing.T) {
+		ts := newTestApi(t)
+		ts.NewGame(tallyv1.GameMode_GAME_MODE_RANDOM)
+		_, err := ts.client.SwapCells(ts.context, connect.NewRequest(&tallyv1.SwapCellsRequest{IndexA: 0, IndexB: 0}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		_, err = ts.client.SwapCells(ts.context, connect.NewRequest(&tallyv1.SwapCellsRequest{IndexA: 0, IndexB: 1000}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		testza.AssertEqual(t, 0, ts.Game().Moves())
+	})
+}
@@ -585,3 +585,44 @@ func TestApi_Challenges(t *testing.T) {
 
 	})
 }
+func TestApi_GenerateGameStream(t *testing.T) {
+	ts := newTestApi(t)
+	req := connect.NewRequest(&model.GenerateGameStreamRequest{
+		Options: &model.GenerateGameRequest{
+			Rows:            4,
+			Columns:         4,
+			TargetCellValue: 96,
+			MaxMoves:        120,
+			Algorithm:       model.GeneratorAlgorithm_GENERATOR_ALGORITHM_REVERSE,
+		},
+		MaxCandidates: 2,
+	})
+	// The interceptor for the test-client only handles unary requests
+	for k, v := range ts.defaultHeaders {
+		req.Header().Set(k, v)
+	}
+	stream, err := ts.client.GenerateGameStream(context.TODO(), req)
+	if err != nil {
+		t.Fatalf("GenerateGameStream failed %s", strErr(err))
+	}
+	defer stream.Close()
+	var candidates []*model.GenerateGameResponse
+	var last *model.GeneratorProgress
+	for stream.Receive() {
+		msg := stream.Msg()
+		testza.AssertNotNil(t, msg.Progress)
+		last = msg.Progress
+		if msg.Candidate != nil {
+			candidates = append(candidates, msg.Candidate)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatalf("GenerateGameStream failed while streaming %s", strErr(err))
+	}
+	testza.AssertLen(t, candidates, 2)
+	testza.AssertEqual(t, uint64(2), last.Candidates)
+	for _, c := range candidates {
+		testza.AssertNotNil(t, c.Game)
+		testza.AssertEqual(t, uint32(4), c.Game.Board.Rows)
+	}
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+)
+
+func topologyChallengeForTest(topology tallyv1.Topology) *tallyv1.CreateGameChallengeRequest {
+	return &tallyv1.CreateGameChallengeRequest{
+		ChallengeNumber: 100,
+		IdealMoves:      2,
+		TargetCellValue: 16,
+		Columns:         3,
+		Rows:            3,
+		Name:            "Diagonal challenge",
+		Cells: toModalCells(cellCreator(
+			2, 0, 0,
+			0, 2, 0,
+			0, 0, 4,
+		)),
+		Topology: topology,
+	}
+}
+
+func TestApi_Topology(t *testing.T) {
+	t.Run("Should combine diagonal paths with the eight-way topology", func(t *testing.T) {
+		ts := newTestApi(t)
+		challenge := ts.CreateGameChallenge(topologyChallengeForTest(tallyv1.Topology_TOPOLOGY_EIGHT_WAY))
+		game := ts.NewGameChallenge(challenge.Msg.Id)
+		testza.AssertEqual(t, tallyv1.Topology_TOPOLOGY_EIGHT_WAY, game.Msg.Board.Topology)
+
+		ts.CombineCellsByIndexPath(0, 4, 8)
+		testza.AssertEqual(t, int64(8), ts.Game().Cells()[8].Value())
+
+		// ------------------------------------------------------------
+		ts.LogMark("The diagonal path should be read back from the history")
+		// ------------------------------------------------------------
+		ts.SwipeLeft()
+		ts.Undo()
+		testza.AssertEqual(t, int64(8), ts.Game().Cells()[8].Value())
+		history := ts.Game().History
+		testza.AssertEqual(t, "0,4,8;L;Z;", history.Describe())
+	})
+	t.Run("Should not combine diagonal paths with the default topology", func(t *testing.T) {
+		ts := newTestApi(t)
+		challenge := ts.CreateGameChallenge(topologyChallengeForTest(tallyv1.Topology_TOPOLOGY_UNSPECIFIED))
+		game := ts.NewGameChallenge(challenge.Msg.Id)
+		testza.AssertEqual(t, tallyv1.Topology_TOPOLOGY_ORTHOGONAL, game.Msg.Board.Topology)
+
+		_, err := ts.client.CombineCells(ts.context, connect.NewRequest(&tallyv1.CombineCellsRequest{
+			Selection: &tallyv1.CombineCellsRequest_Indexes{
+				Indexes: &tallyv1.Indexes{Index: []uint32{0, 4, 8}},
+			},
+		}))
+		testza.AssertEqual(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/bufbuild/connect-go"
+	tallyv1 "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+// Plays the game with hints and swipes until it has made the number of moves,
+// and returns the board after each move, starting with the board before any moves.
+func (ts *testApi) playMoves(moves int) []string {
+	ts.t.Helper()
+	boards := []string{ts.Game().Print()}
+outer:
+	for len(boards) <= moves {
+		game := ts.Game()
+		if hints := game.GetHintConsistantly(context.Background(), 1); len(hints) > 0 {
+			path := make([]uint32, len(hints[0].Path))
+			for i, v := range hints[0].Path {
+				path[i] = uint32(v)
+			}
+			ts.CombineCellsByIndexPath(path...)
+			boards = append(boards, ts.Game().Print())
+			continue
+		}
+		for _, dir := range []tallyv1.SwipeDirection{tallyv1.SwipeDirection_SWIPE_DIRECTION_UP, tallyv1.SwipeDirection_SWIPE_DIRECTION_RIGHT, tallyv1.SwipeDirection_SWIPE_DIRECTION_DOWN, tallyv1.SwipeDirection_SWIPE_DIRECTION_LEFT} {
+			res, err := ts.client.SwipeBoard(ts.context, connect.NewRequest(&tallyv1.SwipeBoardRequest{Direction: dir}))
+			ts.FatatErr("SwipeBoard failed", err)
+			if res.Msg.DidChange {
+				boards = append(boards, ts.Game().Print())
+				continue outer
+			}
+		}
+		ts.t.Fatalf("the game ran out of moves after %d moves: %s", len(boards)-1, game.Print())
+	}
+	return boards
+}
+
+func TestApi_UndoRedo(t *testing.T) {
+	t.Run("Should undo several steps, and redo them again", func(t *testing.T) {
+		ts := newTestApi(t)
+		ts.NewGame(tallyv1.GameMode_GAME_MODE_RANDOM)
+		boards := ts.playMoves(6)
+
+		undo := ts.UndoSteps(3)
+		testza.AssertEqual(t, boards[3], ts.Game().Print())
+		testza.AssertTrue(t, undo.Msg.CanUndo)
+		testza.AssertTrue(t, undo.Msg.CanRedo)
+		testza.AssertEqual(t, int64(9), undo.Msg.Moves)
+
+		redo := ts.Redo()
+		testza.AssertEqual(t, boards[4], ts.Game().Print())
+		testza.AssertTrue(t, redo.Msg.CanRedo)
+		testza.AssertEqual(t, int64(10), redo.Msg.Moves)
+		ts.Redo()
+		redo = ts.Redo()
+		testza.AssertEqual(t, boards[6], ts.Game().Print())
+		testza.AssertFalse(t, redo.Msg.CanRedo)
+
+		_, err := ts.client.Redo(ts.context, connect.NewRequest(&tallyv1.RedoRequest{}))
+		testza.AssertEqual(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		_, err = ts.client.Undo(ts.context, connect.NewRequest(&tallyv1.UndoRequest{Steps: 7}))
+		testza.AssertEqual(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		testza.AssertEqual(t, boards[6], ts.Game().Print())
+	})
+	t.Run("Should undo from the stored checkpoints", func(t *testing.T) {
+		ts := newTestApi(t)
+		ts.NewGame(tallyv1.GameMode_GAME_MODE_RANDOM)
+		moves := tallylogic.CheckpointInterval + 4
+		boards := ts.playMoves(moves)
+		gameID := ts.Game().ID
+		checkpoint, err := ts.tally.storage.GetGameCheckpoint(ts.context, types.GetGameCheckpointPayload{GameID: gameID, MaxDepth: moves})
+		testza.AssertNoError(t, err)
+		testza.AssertNotNil(t, checkpoint)
+		testza.AssertEqual(t, tallylogic.CheckpointInterval, checkpoint.Depth)
+
+		ts.UndoSteps(2)
+		testza.AssertEqual(t, boards[moves-2], ts.Game().Print())
+		ts.UndoSteps(3)
+		testza.AssertEqual(t, boards[moves-5], ts.Game().Print())
+		checkpoint, err = ts.tally.storage.GetGameCheckpoint(ts.context, types.GetGameCheckpointPayload{GameID: gameID, MaxDepth: moves})
+		testza.AssertNoError(t, err)
+		testza.AssertNil(t, checkpoint, "Expected the checkpoint of the undone moves to be deleted")
+
+		ts.Undo()
+		testza.AssertEqual(t, boards[moves-6], ts.Game().Print())
+		ts.Redo()
+		testza.AssertEqual(t, boards[moves-5], ts.Game().Print())
+	})
+}
@@ -40,6 +40,8 @@ type UserState struct {
 	SessionID string
 	UserName  string
 	UserID    string
+	// Set when the user has registered with a username and password
+	Registered bool
 	// Current game being played
 	tallylogic.Game
 }
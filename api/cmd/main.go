@@ -12,7 +12,7 @@ import (
 
 func main() {
 	isDev := flag.Bool("development", false, "Set to true to enable development-mode")
-	DSN := flag.String("dsn", "sqlite:./data/db.sqlite", "Set to override the database connection-string (DSN) to use. ")
+	DSN := flag.String("dsn", "sqlite:./data/db.sqlite", "Set to override the database connection-string (DSN) to use. Both sqlite and postgres are supported")
 
 	flag.Parse()
 
@@ -49,6 +49,7 @@ func (s *TallyServer) CombineCells(
 		return nil, cerr.ToConnectError()
 	}
 	gameCopy := session.Game.Copy()
+	_, method, _ := session.Game.SoftEvaluatesForPath(path)
 	ok := session.Game.EvaluateForPath(path)
 	if !ok {
 		cerr := connect.NewError(connect.CodeNotFound, fmt.Errorf("path does evaluate to the final item the selection"))
@@ -61,15 +62,22 @@ func (s *TallyServer) CombineCells(
 		return nil, cerr
 	}
 	seed, state := session.Game.Seed()
+	depth, checkpoints, err := toTypeCheckpoints(&session.Game)
+	if err != nil {
+		session.Game = gameCopy
+		return nil, fmt.Errorf("internal failure while creating checkpoints during CombinePath-operation: %w", err)
+	}
 	p := types.UpdateGamePayload{
-		GameID:    session.Game.ID,
-		Moves:     session.Game.Moves(),
-		Score:     uint64(session.Game.Score()),
-		State:     state,
-		Seed:      seed,
-		Cells:     session.Game.Cells(),
-		History:   session.Game.History.Bytes(),
-		PlayState: types.PlayStateCurrent,
+		GameID:      session.Game.ID,
+		Moves:       session.Game.Moves(),
+		Score:       uint64(session.Game.Score()),
+		State:       state,
+		Seed:        seed,
+		Cells:       session.Game.Cells(),
+		History:     session.Game.History.Bytes(),
+		PlayState:   types.PlayStateCurrent,
+		Depth:       depth,
+		Checkpoints: checkpoints,
 	}
 	didWin := session.Game.IsGameWon()
 	didLose := session.Game.IsGameOver()
@@ -78,7 +86,7 @@ func (s *TallyServer) CombineCells(
 	} else if didLose {
 		p.PlayState = types.PlayStateLost
 	}
-	err := s.storage.UpdateGame(ctx, p)
+	err = s.storage.UpdateGame(ctx, p)
 	if err != nil {
 		s.l.Error().Err(err).Msg("internal failure during CombinePath-operation")
 
@@ -86,11 +94,12 @@ func (s *TallyServer) CombineCells(
 		return nil, fmt.Errorf("internal failure during CombinePath-operation: %w", err)
 	}
 	response := model.CombineCellsResponse{
-		Board:   toModalBoard(&session.Game),
-		Score:   session.Game.Score(),
-		Moves:   int64(session.Game.Moves()),
-		DidWin:  didWin,
-		DidLose: didLose,
+		Board:      toModalBoard(&session.Game),
+		Score:      session.Game.Score(),
+		Moves:      int64(session.Game.Moves()),
+		DidWin:     didWin,
+		DidLose:    didLose,
+		EvalMethod: toModelEvalMethod(method),
 	}
 	res := connect.NewResponse(&response)
 	return res, nil
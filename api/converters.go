@@ -37,14 +37,95 @@ func toModalDirection(dir logic.SwipeDirection) model.SwipeDirection {
 	}
 	return model.SwipeDirection_SWIPE_DIRECTION_UNSPECIFIED
 }
+func toGamePower(power model.Power) logic.Power {
+	switch power {
+	case model.Power_POWER_REMOVE_CELL:
+		return logic.PowerRemoveCell
+	case model.Power_POWER_DOUBLE_CELL:
+		return logic.PowerDoubleCell
+	case model.Power_POWER_SPLIT_CELL:
+		return logic.PowerSplitCell
+	case model.Power_POWER_SHUFFLE:
+		return logic.PowerShuffle
+	}
+	return 0
+}
+func toModelPower(power logic.Power) model.Power {
+	switch power {
+	case logic.PowerRemoveCell:
+		return model.Power_POWER_REMOVE_CELL
+	case logic.PowerDoubleCell:
+		return model.Power_POWER_DOUBLE_CELL
+	case logic.PowerSplitCell:
+		return model.Power_POWER_SPLIT_CELL
+	case logic.PowerShuffle:
+		return model.Power_POWER_SHUFFLE
+	}
+	return model.Power_POWER_UNSPECIFIED
+}
+func toModelEvalMethod(method logic.EvalMethod) model.EvalMethod {
+	switch method {
+	case logic.EvalMethodSum:
+		return model.EvalMethod_EVAL_METHOD_SUM
+	case logic.EvalMethodProduct:
+		return model.EvalMethod_EVAL_METHOD_PRODUCT
+	case logic.EvalMethodSubtraction:
+		return model.EvalMethod_EVAL_METHOD_SUBTRACTION
+	case logic.EvalMethodDivision:
+		return model.EvalMethod_EVAL_METHOD_DIVISION
+	case logic.EvalMethodMixed:
+		return model.EvalMethod_EVAL_METHOD_MIXED
+	}
+	return model.EvalMethod_EVAL_METHOD_UNSPECIFIED
+}
+
+func toModelTopology(topology logic.Topology) model.Topology {
+	switch topology {
+	case logic.TopologyOrthogonal:
+		return model.Topology_TOPOLOGY_ORTHOGONAL
+	case logic.TopologyEightWay:
+		return model.Topology_TOPOLOGY_EIGHT_WAY
+	case logic.TopologyToroidal:
+		return model.Topology_TOPOLOGY_TOROIDAL
+	}
+	return model.Topology_TOPOLOGY_UNSPECIFIED
+}
+func fromModelTopology(topology model.Topology) (types.Topology, error) {
+	switch topology {
+	case model.Topology_TOPOLOGY_UNSPECIFIED, model.Topology_TOPOLOGY_ORTHOGONAL:
+		return types.TopologyOrthogonal, nil
+	case model.Topology_TOPOLOGY_EIGHT_WAY:
+		return types.TopologyEightWay, nil
+	case model.Topology_TOPOLOGY_TOROIDAL:
+		return types.TopologyToroidal, nil
+	}
+	return "", fmt.Errorf("unsupported topology: %v", topology)
+}
 
+func toModalSession(session *UserState) *model.Session {
+	return &model.Session{
+		SessionId:  session.SessionID,
+		Username:   session.UserName,
+		Registered: session.Registered,
+		Game: &model.Game{
+			Board:       toModalBoard(&session.Game),
+			Score:       session.Game.Score(),
+			Moves:       int64(session.Game.Moves()),
+			Description: session.Game.Description,
+			Mode:        toModelGameMode(session.Rules.GameMode),
+			SwapsLeft:   uint32(session.Game.SwapsLeft()),
+			PowersLeft:  uint32(session.Game.PowersLeft()),
+		},
+	}
+}
 func toModalBoard(game *logic.Game) *model.Board {
 	return &model.Board{
-		Id:      game.ID,
-		Cells:   toModalCells(game.Cells()),
-		Columns: uint32(game.Rules.SizeX),
-		Rows:    uint32(game.Rules.SizeX),
-		Name:    game.Name,
+		Id:       game.ID,
+		Cells:    toModalCells(game.Cells()),
+		Columns:  uint32(game.Rules.SizeX),
+		Rows:     uint32(game.Rules.SizeY),
+		Name:     game.Name,
+		Topology: toModelTopology(game.Rules.Options.Topology),
 	}
 }
 
@@ -53,8 +134,9 @@ func toModalCells(cells []cell.Cell) []*model.Cell {
 	for i := 0; i < len(cells); i++ {
 		base, twopow := cells[i].Raw()
 		c[i] = &model.Cell{
-			Base:   base,
-			Twopow: twopow,
+			Base:    base,
+			Twopow:  twopow,
+			Blocked: cells[i].IsBlocked(),
 		}
 
 	}
@@ -63,10 +145,16 @@ func toModalCells(cells []cell.Cell) []*model.Cell {
 func fromModalCells(cells []*model.Cell) []cell.Cell {
 	c := make([]cell.Cell, len(cells))
 	for i := 0; i < len(cells); i++ {
-		c[i] = cell.NewCell(cells[i].Base, int(cells[i].Twopow))
+		c[i] = fromModalCell(cells[i])
 	}
 	return c
 }
+func fromModalCell(c *model.Cell) cell.Cell {
+	if c.Blocked {
+		return cell.NewBlockedCell()
+	}
+	return cell.NewCell(c.Base, int(c.Twopow))
+}
 
 func toModelGameMode(mode tallylogic.GameMode) model.GameMode {
 	switch mode {
@@ -79,6 +167,56 @@ func toModelGameMode(mode tallylogic.GameMode) model.GameMode {
 	}
 	panic(fmt.Sprintf("Invalid game-mode %d", mode))
 }
+func toModelGameModeFromRuleMode(mode types.RuleMode) model.GameMode {
+	switch mode {
+	case types.RuleModeInfiniteEasy, types.RuleModeInfiniteNormal, types.RuleModeInfiniteHard:
+		return model.GameMode_GAME_MODE_RANDOM
+	case types.RuleModeChallenge:
+		return model.GameMode_GAME_MODE_RANDOM_CHALLENGE
+	case types.RuleModeTutorial:
+		return model.GameMode_GAME_MODE_TUTORIAL
+	}
+	return model.GameMode_GAME_MODE_UNSPECIFIED
+}
+
+// Returns the rule-modes that are played as the game-mode
+func fromModelGameMode(mode model.GameMode) []types.RuleMode {
+	switch mode {
+	case model.GameMode_GAME_MODE_RANDOM:
+		return []types.RuleMode{types.RuleModeInfiniteEasy, types.RuleModeInfiniteNormal, types.RuleModeInfiniteHard}
+	case model.GameMode_GAME_MODE_RANDOM_CHALLENGE:
+		return []types.RuleMode{types.RuleModeChallenge}
+	case model.GameMode_GAME_MODE_TUTORIAL:
+		return []types.RuleMode{types.RuleModeTutorial}
+	}
+	return nil
+}
+func toModelPlayState(p types.PlayState) model.PlayState {
+	switch p {
+	case types.PlayStateCurrent:
+		return model.PlayState_PLAY_STATE_CURRENT
+	case types.PlayStateWon:
+		return model.PlayState_PLAY_STATE_WON
+	case types.PlayStateLost:
+		return model.PlayState_PLAY_STATE_LOST
+	case types.PlayStateAbandoned:
+		return model.PlayState_PLAY_STATE_ABANDONED
+	}
+	return model.PlayState_PLAY_STATE_UNSPECIFIED
+}
+func fromModelPlayState(p model.PlayState) types.PlayState {
+	switch p {
+	case model.PlayState_PLAY_STATE_CURRENT:
+		return types.PlayStateCurrent
+	case model.PlayState_PLAY_STATE_WON:
+		return types.PlayStateWon
+	case model.PlayState_PLAY_STATE_LOST:
+		return types.PlayStateLost
+	case model.PlayState_PLAY_STATE_ABANDONED:
+		return types.PlayStateAbandoned
+	}
+	return ""
+}
 func toTypeGame(Game tallylogic.Game, userId string) types.Game {
 
 	seed, state := Game.Seed()
@@ -97,19 +235,27 @@ func toTypeGame(Game tallylogic.Game, userId string) types.Game {
 		Cells:     Game.Cells(),
 		PlayState: types.PlayStateCurrent,
 		Rules: types.Rules{
-			ID:              Game.Rules.ID,
-			CreatedAt:       time.Now(),
-			Description:     "",
-			Rows:            uint8(Game.Rules.SizeY),
-			Columns:         uint8(Game.Rules.SizeX),
-			RecreateOnSwipe: Game.Rules.RecreateOnSwipe,
-			NoReSwipe:       Game.Rules.NoReswipe,
-			NoMultiply:      Game.Rules.Options.NoMultiply,
-			NoAddition:      Game.Rules.Options.NoAddition,
-			TargetCellValue: Game.Rules.TargetCellValue,
-			TargetScore:     Game.Rules.TargetScore,
-			MaxMoves:        Game.Rules.MaxMoves,
-			Mode:            toTypeMode(Game.Rules.GameMode),
+			ID:                 Game.Rules.ID,
+			CreatedAt:          time.Now(),
+			Description:        "",
+			Rows:               uint8(Game.Rules.SizeY),
+			Columns:            uint8(Game.Rules.SizeX),
+			RecreateOnSwipe:    Game.Rules.RecreateOnSwipe,
+			NoReSwipe:          Game.Rules.NoReswipe,
+			NoMultiply:         Game.Rules.Options.NoMultiply,
+			NoAddition:         Game.Rules.Options.NoAddition,
+			NoSuperPowers:      !Game.Rules.WithSuperPowers,
+			MaxSwaps:           Game.Rules.MaxSwaps,
+			TargetCellValue:    Game.Rules.TargetCellValue,
+			TargetScore:        Game.Rules.TargetScore,
+			MaxMoves:           Game.Rules.MaxMoves,
+			Mode:               toTypeMode(Game.Rules.GameMode),
+			SwapNeighboursOnly: Game.Rules.SwapNeighboursOnly,
+			MaxPowers:          Game.Rules.MaxPowers,
+			WithSubtraction:    Game.Rules.Options.WithSubtraction,
+			WithDivision:       Game.Rules.Options.WithDivision,
+			WithMixedOperators: Game.Rules.Options.WithMixedOperators,
+			Topology:           Game.Rules.Options.Topology.RuleTopology(),
 		},
 	}
 	return g
@@ -149,6 +295,26 @@ func toModelInstruction(instructions logic.CompactHistory) ([]*model.Instruction
 					},
 				},
 			}
+		case h.IsHelperSwap():
+			ins[i] = &model.Instruction{
+				InstructionOneof: &model.Instruction_Swap{
+					Swap: &model.Indexes{
+						Index: intsTouInt32s(h.Path),
+					},
+				},
+			}
+		case h.IsHelperPower():
+			power := &model.PowerInstruction{
+				Power: toModelPower(h.Power),
+			}
+			if len(h.Path) > 0 {
+				power.Index = uint32(h.Path[0])
+			}
+			ins[i] = &model.Instruction{
+				InstructionOneof: &model.Instruction_Power{
+					Power: power,
+				},
+			}
 		default:
 			return ins, fmt.Errorf("failed to resolve instruction %s %#v", instructions.Describe(), instructions)
 		}
@@ -168,3 +334,50 @@ func toModelHint(hints map[string]tallylogic.Hint) []*model.Instruction {
 	}
 	return ins
 }
+
+// Returns the number of moves in effect, and the checkpoints that should be
+// stored for the game. A checkpoint is stored for every
+// tallylogic.CheckpointInterval moves in effect, and for the ones created by
+// undo.
+func toTypeCheckpoints(game *logic.Game) (int, []types.GameCheckpoint, error) {
+	depth, err := game.Depth()
+	if err != nil {
+		return 0, nil, err
+	}
+	var checkpoints []types.GameCheckpoint
+	for _, c := range game.Checkpoints() {
+		if c.Depth <= 0 || c.Depth > depth {
+			continue
+		}
+		checkpoints = append(checkpoints, toTypeCheckpoint(c))
+	}
+	if depth > 0 && depth%logic.CheckpointInterval == 0 {
+		c, err := game.Checkpoint()
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(checkpoints) == 0 || checkpoints[len(checkpoints)-1].Depth != depth {
+			checkpoints = append(checkpoints, toTypeCheckpoint(c))
+		}
+	}
+	return depth, checkpoints, nil
+}
+func toTypeCheckpoint(c logic.Checkpoint) types.GameCheckpoint {
+	return types.GameCheckpoint{
+		Depth:     c.Depth,
+		CreatedAt: time.Now(),
+		Score:     uint64(c.Score),
+		Cells:     c.Cells,
+		Seed:      c.Seed,
+		State:     c.State,
+	}
+}
+func fromTypeCheckpoint(c types.GameCheckpoint) logic.Checkpoint {
+	return logic.Checkpoint{
+		Depth: c.Depth,
+		Score: int64(c.Score),
+		Cells: c.Cells,
+		Seed:  c.Seed,
+		State: c.State,
+	}
+}
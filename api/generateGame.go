@@ -10,11 +10,11 @@ import (
 	"github.com/runar-rkmedia/gotally/randomizer"
 	"github.com/runar-rkmedia/gotally/tallylogic"
 	gamegenerator_target_cell "github.com/runar-rkmedia/gotally/tallylogic/gameGeneratorTargetCell"
-	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
 type gamegenerator interface {
 	GenerateGame(ctx context.Context) (tallylogic.Game, []tallylogic.Game, error)
+	GenerateGames(ctx context.Context, onEvent tallylogic.GeneratorEventHandler) error
 }
 
 func (s *TallyServer) GenerateGame(
@@ -29,62 +29,68 @@ func (s *TallyServer) GenerateGame(
 	defer cancel()
 	// session := ContextGetUserState(ctx)
 	// TODO: Check that user is registered /admin etc.
-	var generator gamegenerator
-	var err error
-	if req.Msg.Rows != req.Msg.Columns {
-
-		err := connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Rows must be equal to Columns. See issue #21"))
-		detail := errdetails.ErrorInfo{
-			Reason: "An unresolved issue with underlying implementation",
-			Domain: "generator",
-			Metadata: map[string]string{
-				"issue-url": "https://github.com/runar-rkmedia/gotally/issues/21",
-			},
-		}
-		if detail, detailErr := connect.NewErrorDetail(&detail); detailErr == nil {
-			err.AddDetail(detail)
+	// The solvable games are also returned from GenerateGame, so these are only logged
+	gameCh := make(chan tallylogic.SolvableGame)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case sg := <-gameCh:
+				s.l.Debug().Str("game", sg.Game.Print()).Msg("generator found a solvable game")
+			}
 		}
-		return nil, err
+	}()
+	generator, err := newGameGenerator(req.Msg, gameCh)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to initialize game-generator: %w", err))
 	}
-	if req.Msg.Algorithm == model.GeneratorAlgorithm_GENERATOR_ALGORITHM_REVERSE {
+	game, solutions, err := generator.GenerateGame(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate game: %w", err))
+	}
+	response, err := toGenerateGameResponse(game, solutions, req.Msg.WithSolutions)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := connect.NewResponse(response)
+	return res, nil
+}
+
+// Creates the generator from the request.
+// The legacy generator sends every solvable game it finds to the gameCh, which must be read from.
+func newGameGenerator(msg *model.GenerateGameRequest, gameCh chan tallylogic.SolvableGame) (gamegenerator, error) {
+	var generator gamegenerator
+	var err error
+	if msg.Algorithm == model.GeneratorAlgorithm_GENERATOR_ALGORITHM_REVERSE {
 		options := gamegenerator_target_cell.GameGeneratorTargetCellOptions{
-			TargetCell:         req.Msg.TargetCellValue,
+			TargetCell:         msg.TargetCellValue,
 			MinCellValue:       0,
 			MaxCellValue:       12,
 			RandomCellChance:   -1,
-			MaxCells:           int(req.Msg.MaxBricks),
-			MaxAdditionalCells: int(req.Msg.MaxAdditionalCells),
-			Rows:               int(req.Msg.Rows),
-			Columns:            int(req.Msg.Columns),
-			MaxMoves:           int(req.Msg.MaxMoves),
-			MinMoves:           int(req.Msg.MinMoves),
-			// Seed:               req.Msg.Seed,
-			Randomizer: randomizer.NewRandomizerFromSeed(req.Msg.Seed, req.Msg.Salt),
+			MaxCells:           int(msg.MaxBricks),
+			MaxAdditionalCells: int(msg.MaxAdditionalCells),
+			Rows:               int(msg.Rows),
+			Columns:            int(msg.Columns),
+			MaxMoves:           int(msg.MaxMoves),
+			MinMoves:           int(msg.MinMoves),
+			// Seed:               msg.Seed,
+			Randomizer: randomizer.NewRandomizerFromSeed(msg.Seed, msg.Salt),
 		}
 		generator, err = gamegenerator_target_cell.NewGameGeneratorForTargetCell(options)
 	} else {
-		gameCh := make(chan tallylogic.SolvableGame)
-		go func() {
-			for {
-				select {
-				case sg := <-gameCh:
-					fmt.Println("got a game", sg.Game.Print())
-					return
-				}
-			}
-		}()
 		legacy_options := tallylogic.GameGeneratorOptions{
-			Rows:    int(req.Msg.Rows),
-			Columns: int(req.Msg.Columns),
+			Rows:    int(msg.Rows),
+			Columns: int(msg.Columns),
 			GoalChecker: tallylogic.GoalCheckLargestCell{
 				GoalCheck:       tallylogic.GoalCheck{},
-				TargetCellValue: req.Msg.TargetCellValue,
+				TargetCellValue: msg.TargetCellValue,
 			},
-			TargetCellValue:     req.Msg.TargetCellValue,
-			MaxBricks:           int(req.Msg.MaxBricks),
+			TargetCellValue:     msg.TargetCellValue,
+			MaxBricks:           int(msg.MaxBricks),
 			MinBricks:           0,
-			MinMoves:            int(req.Msg.MinMoves),
-			MaxMoves:            int(req.Msg.MaxMoves),
+			MinMoves:            int(msg.MinMoves),
+			MaxMoves:            int(msg.MaxMoves),
 			MaxIterations:       100_000_000,
 			Concurrency:         0,
 			CellGenerator:       nil,
@@ -95,13 +101,10 @@ func (s *TallyServer) GenerateGame(
 		}
 		generator, err = tallylogic.NewGameGenerator(legacy_options)
 	}
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to initialize game-generator: %w", err))
-	}
-	game, solutions, err := generator.GenerateGame(ctx)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate game: %w", err))
-	}
+	return generator, err
+}
+
+func toGenerateGameResponse(game tallylogic.Game, solutions []tallylogic.Game, withSolutions bool) (*model.GenerateGameResponse, error) {
 	var ideal int
 	var score int
 	var maxScore int64
@@ -116,7 +119,7 @@ func (s *TallyServer) GenerateGame(
 	}
 	gameStats, solutionStats, err := calculateStats(game, solutions)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 	response := &model.GenerateGameResponse{
 		Game: &model.Game{
@@ -157,13 +160,16 @@ func (s *TallyServer) GenerateGame(
 				Ok:               t.Ok,
 				IsMultiplication: t.IsMultiplication,
 				IsAddition:       t.IsAddition,
+				IsSubtraction:    t.IsSubtraction,
+				IsDivision:       t.IsDivision,
+				IsMixed:          t.IsMixed,
 				IsSwipe:          t.IsSwipe,
 				TwoPow:           uint64(t.TwoPow),
 			}
 		}
 	}
 
-	if req.Msg.WithSolutions {
+	if withSolutions {
 		max := len(solutions)
 		response.Solutions = make([]*model.Game, max)
 		for i, s := range solutions {
@@ -179,8 +185,7 @@ func (s *TallyServer) GenerateGame(
 			}
 		}
 	}
-	res := connect.NewResponse(response)
-	return res, nil
+	return response, nil
 }
 
 func calculateStats(game tallylogic.Game, solutions []tallylogic.Game) (tallylogic.GameStats, tallylogic.SolutionStats, error) {
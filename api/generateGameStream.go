@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+)
+
+// Returned from the event-handler to stop the generator once enough candidates have been sent
+var errEnoughCandidates = errors.New("enough candidates")
+
+func (s *TallyServer) GenerateGameStream(
+	ctx context.Context,
+	req *connect.Request[model.GenerateGameStreamRequest],
+	stream *connect.ServerStream[model.GenerateGameStreamResponse],
+) error {
+	if !s.FeatureGameGeneration {
+		return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("generating games has been disabled"))
+	}
+	if req.Msg.Options == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("options are required"))
+	}
+	maxCandidates := int(req.Msg.MaxCandidates)
+	if maxCandidates <= 0 {
+		maxCandidates = 10
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+	// The streaming generators report their games through the event-handler,
+	// so nothing is sent on this channel.
+	generator, err := newGameGenerator(req.Msg.Options, make(chan tallylogic.SolvableGame))
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to initialize game-generator: %w", err))
+	}
+	err = generator.GenerateGames(ctx, func(event tallylogic.GeneratorEvent) error {
+		res := &model.GenerateGameStreamResponse{
+			Progress: toModelGeneratorProgress(event.Progress),
+		}
+		if event.Candidate != nil {
+			candidate, err := toGenerateGameResponse(event.Candidate.Game, event.Candidate.Solutions, req.Msg.Options.WithSolutions)
+			if err != nil {
+				return err
+			}
+			res.Candidate = candidate
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+		if event.Progress.Candidates >= maxCandidates {
+			return errEnoughCandidates
+		}
+		return nil
+	})
+	switch {
+	case err == nil,
+		errors.Is(err, errEnoughCandidates),
+		errors.Is(err, context.Canceled),
+		errors.Is(err, context.DeadlineExceeded):
+		return nil
+	}
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate games: %w", err))
+}
+
+func toModelGeneratorProgress(p tallylogic.GeneratorProgress) *model.GeneratorProgress {
+	progress := &model.GeneratorProgress{
+		Iterations:   uint64(p.Iterations),
+		SolverVisits: uint64(p.SolverVisits),
+		Candidates:   uint64(p.Candidates),
+		Rejections:   make(map[string]uint64, len(p.Rejections)),
+	}
+	for k, v := range p.Rejections {
+		progress.Rejections[k] = uint64(v)
+	}
+	return progress
+}
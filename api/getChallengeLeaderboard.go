@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+const (
+	leaderboardDefaultLimit = 20
+	leaderboardMaxLimit     = 100
+)
+
+func (s *TallyServer) GetChallengeLeaderboard(
+	ctx context.Context,
+	req *connect.Request[model.GetChallengeLeaderboardRequest],
+) (*connect.Response[model.GetChallengeLeaderboardResponse], error) {
+	session := ContextGetUserState(ctx)
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = leaderboardDefaultLimit
+	}
+	if limit > leaderboardMaxLimit {
+		limit = leaderboardMaxLimit
+	}
+	payload := types.GetChallengeLeaderboardPayload{
+		TemplateID:    req.Msg.ChallengeId,
+		CurrentUserID: session.UserID,
+		Limit:         limit,
+		Offset:        int(req.Msg.Offset),
+	}
+	if err := payload.Validate(); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	leaderboard, err := s.storage.GetChallengeLeaderboard(ctx, payload)
+	if err != nil {
+		if errors.Is(err, types.ErrArgumentInvalid) || errors.Is(err, types.ErrArgumentMissing) {
+			cerr := createError(connect.CodeInvalidArgument, err)
+			return nil, cerr.ToConnectError()
+		}
+		s.l.Error().Err(err).Interface("payload", payload).Msg("failed to issue storage.GetChallengeLeaderboard in api.GetChallengeLeaderboard")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to get leaderboard: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	response := &model.GetChallengeLeaderboardResponse{
+		Entries:      make([]*model.LeaderboardEntry, len(leaderboard.Entries)),
+		TotalPlayers: uint32(leaderboard.TotalPlayers),
+	}
+	for i, e := range leaderboard.Entries {
+		response.Entries[i] = toModalLeaderboardEntry(e)
+	}
+	if leaderboard.CurrentUser != nil {
+		response.CurrentUser = toModalLeaderboardEntry(*leaderboard.CurrentUser)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func toModalLeaderboardEntry(e types.LeaderboardEntry) *model.LeaderboardEntry {
+	return &model.LeaderboardEntry{
+		Rank:     uint32(e.Rank),
+		UserId:   e.UserID,
+		Username: e.Username,
+		Score:    e.Score,
+		Moves:    uint32(e.Moves),
+		GameId:   e.GameID,
+	}
+}
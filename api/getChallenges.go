@@ -38,6 +38,10 @@ func (s *TallyServer) CreateGameChallenge(
 	if req.Msg.IdealMoves == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("IdealMoves must be set"))
 	}
+	topology, err := fromModelTopology(req.Msg.Topology)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	expectedCells := int(req.Msg.Columns * req.Msg.Rows)
 	if expectedCells != len(req.Msg.Cells) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The number of cells must match Rows*Columns"))
@@ -53,18 +57,25 @@ func (s *TallyServer) CreateGameChallenge(
 		Name:        req.Msg.Name,
 		Cells:       make([]cell.Cell, req.Msg.Rows*req.Msg.Columns),
 		Rules: types.Rules{
-			ID:              "",
-			CreatedAt:       time.Now(),
-			Mode:            types.RuleModeChallenge,
-			TargetCellValue: req.Msg.TargetCellValue,
-			TargetScore:     0,
-			MaxMoves:        0,
-			Rows:            uint8(req.Msg.Rows),
-			Columns:         uint8(req.Msg.Columns),
-			RecreateOnSwipe: false,
-			NoReSwipe:       false,
-			NoMultiply:      false,
-			NoAddition:      false,
+			ID:                 "",
+			CreatedAt:          time.Now(),
+			Mode:               types.RuleModeChallenge,
+			TargetCellValue:    req.Msg.TargetCellValue,
+			TargetScore:        0,
+			MaxMoves:           0,
+			Rows:               uint8(req.Msg.Rows),
+			Columns:            uint8(req.Msg.Columns),
+			RecreateOnSwipe:    false,
+			NoReSwipe:          false,
+			NoMultiply:         false,
+			NoAddition:         false,
+			MaxSwaps:           uint64(req.Msg.MaxSwaps),
+			SwapNeighboursOnly: req.Msg.SwapNeighboursOnly,
+			MaxPowers:          uint64(req.Msg.MaxPowers),
+			WithSubtraction:    req.Msg.WithSubtraction,
+			WithDivision:       req.Msg.WithDivision,
+			WithMixedOperators: req.Msg.WithMixedOperators,
+			Topology:           topology,
 		},
 	}
 	if req.Msg.ChallengeNumber != 0 {
@@ -72,7 +83,7 @@ func (s *TallyServer) CreateGameChallenge(
 		payload.ChallengeNumber = &i
 	}
 	for i, v := range req.Msg.Cells {
-		payload.Cells[i] = cell.NewCell(v.Base, int(v.Twopow))
+		payload.Cells[i] = fromModalCell(v)
 	}
 
 	if err := payload.Validate(); err != nil {
@@ -109,17 +120,7 @@ func (s *TallyServer) GetGameChallenges(
 	response.Challenges = make([]*model.GameChallenge, len(c))
 
 	for i := 0; i < len(c); i++ {
-		response.Challenges[i] = &model.GameChallenge{
-			Id:              c[i].ID,
-			ChallengeNumber: intPointerUint32(c[i].ChallengeNumber),
-			IdealMoves:      intPointerUint32(c[i].IdealMoves),
-			TargetCellValue: c[i].TargetCellValue,
-			Columns:         uint32(c[i].Columns),
-			Rows:            uint32(c[i].Rows),
-			Name:            c[i].Name,
-			Description:     c[i].Description,
-			Cells:           toModalCells(c[i].Cells),
-		}
+		response.Challenges[i] = toModalGameChallenge(c[i])
 		for _, s := range c[i].Stats {
 			if s.Score > 0 {
 				if response.Challenges[i].CurrentUsersBestScore < s.Score {
@@ -147,6 +148,22 @@ func (s *TallyServer) GetGameChallenges(
 
 }
 
+func toModalGameChallenge(t types.GameTemplate) *model.GameChallenge {
+	return &model.GameChallenge{
+		Id:              t.ID,
+		ChallengeNumber: intPointerUint32(t.ChallengeNumber),
+		IdealMoves:      intPointerUint32(t.IdealMoves),
+		TargetCellValue: t.TargetCellValue,
+		Columns:         uint32(t.Columns),
+		Rows:            uint32(t.Rows),
+		Name:            t.Name,
+		Description:     t.Description,
+		Cells:           toModalCells(t.Cells),
+		VoteCount:       t.Votes.Count,
+		FunVoteTotal:    t.Votes.FunVoteTotal,
+	}
+}
+
 func calculateRating(score, idealScore uint64, moves, idealMoves uint32) model.Rating {
 	if moves == 0 || score == 0 {
 		return model.Rating_RATING_UNPLAYED
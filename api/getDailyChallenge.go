@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/randomizer"
+	gamegenerator_target_cell "github.com/runar-rkmedia/gotally/tallylogic/gameGeneratorTargetCell"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+const (
+	dailyChallengeSize     = 5
+	dailyChallengeMinMoves = 6
+	dailyChallengeMaxMoves = 20
+	// Mixed into the seed from the date, so that the boards are not the same as
+	// for other games generated with a seed that looks like a date
+	dailyChallengeSalt = 0x7a11
+	// Set as the creator of the daily challenges, like for imported games
+	dailyChallengeCreatedBy = "generator"
+)
+
+// The target of the daily challenge varies by the day
+var dailyChallengeTargetCellValues = []uint64{256, 384, 512, 768}
+
+// The daily challenge is generated on the first request for the day,
+// and concurrent requests should not generate it more than once.
+var dailyChallengeMutex sync.Mutex
+
+func (s *TallyServer) GetDailyChallenge(
+	ctx context.Context,
+	req *connect.Request[model.GetDailyChallengeRequest],
+) (*connect.Response[model.GetDailyChallengeResponse], error) {
+	session := ContextGetUserState(ctx)
+	date, err := dailyDateFromRequest(req.Msg.Date)
+	if err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	daily, err := s.getOrCreateDailyChallenge(ctx, date, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	response := &model.GetDailyChallengeResponse{
+		DailyChallenge: toModalDailyChallenge(*daily),
+	}
+	return connect.NewResponse(response), nil
+}
+
+// Returns the date in the request, or today if it is not set.
+// The challenges for the coming days are not available.
+func dailyDateFromRequest(date string) (string, error) {
+	today := types.DailyDate(time.Now())
+	if date == "" {
+		return today, nil
+	}
+	if _, err := types.ParseDailyDate(date); err != nil {
+		return "", err
+	}
+	// The dates sort lexically
+	if date > today {
+		return "", fmt.Errorf("%w: the daily challenge for %s is not available yet", types.ErrArgumentInvalid, date)
+	}
+	return date, nil
+}
+
+// Returns the daily challenge for the date, and generates it if it does not exist yet.
+// Only the challenge for today is generated. The previous days are only available
+// if they were generated on the day.
+func (s *TallyServer) getOrCreateDailyChallenge(ctx context.Context, date string, userID string) (*types.DailyChallenge, error) {
+	payload := types.GetDailyChallengePayload{Date: date, UserID: userID}
+	daily, err := s.storage.GetDailyChallenge(ctx, payload)
+	if err == nil {
+		return daily, nil
+	}
+	if !errors.Is(err, types.ErrTemplateNotFound) {
+		s.l.Error().Err(err).Interface("payload", payload).Msg("failed to issue storage.GetDailyChallenge in api.getOrCreateDailyChallenge")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to get daily challenge: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	if date != types.DailyDate(time.Now()) {
+		cerr := createError(connect.CodeNotFound, fmt.Errorf("there is no daily challenge for %s", date))
+		return nil, cerr.ToConnectError()
+	}
+	dailyChallengeMutex.Lock()
+	defer dailyChallengeMutex.Unlock()
+	// It may have been created while waiting for the lock
+	daily, err = s.storage.GetDailyChallenge(ctx, payload)
+	if err == nil {
+		return daily, nil
+	}
+	if !errors.Is(err, types.ErrTemplateNotFound) {
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to get daily challenge: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	templatePayload, err := generateDailyChallenge(ctx, date, s.UidGenerator())
+	if err != nil {
+		s.l.Error().Err(err).Str("date", date).Msg("failed to generate daily challenge")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to generate daily challenge: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	if _, err := s.storage.CreateGameTemplate(ctx, templatePayload); err != nil {
+		// Another instance of the server may have stored the challenge for the day in the meantime
+		if daily, err := s.storage.GetDailyChallenge(ctx, payload); err == nil {
+			return daily, nil
+		}
+		s.l.Error().Err(err).Str("date", date).Msg("failed to issue storage.CreateGameTemplate in api.getOrCreateDailyChallenge")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to create daily challenge: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	daily, err = s.storage.GetDailyChallenge(ctx, payload)
+	if err != nil {
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to get daily challenge: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	return daily, nil
+}
+
+// Generates the board for the daily challenge, with a seed derived from the date.
+// The solver in the generator is limited by time, so the same date may not always
+// produce the same board. The board is therefore stored on the first request for
+// the day, and is never generated again.
+func generateDailyChallenge(ctx context.Context, date string, id string) (types.CreateGameTemplatePayload, error) {
+	var payload types.CreateGameTemplatePayload
+	day, err := types.ParseDailyDate(date)
+	if err != nil {
+		return payload, err
+	}
+	seed := uint64(day.Year()*10000 + int(day.Month())*100 + day.Day())
+	target := dailyChallengeTargetCellValues[seed%uint64(len(dailyChallengeTargetCellValues))]
+	generator, err := gamegenerator_target_cell.NewGameGeneratorForTargetCell(gamegenerator_target_cell.GameGeneratorTargetCellOptions{
+		TargetCell: target,
+		Rows:       dailyChallengeSize,
+		Columns:    dailyChallengeSize,
+		MinMoves:   dailyChallengeMinMoves,
+		MaxMoves:   dailyChallengeMaxMoves,
+		Randomizer: randomizer.NewRandomizerFromSeed(seed, dailyChallengeSalt),
+	})
+	if err != nil {
+		return payload, fmt.Errorf("failed to initialize game-generator: %w", err)
+	}
+	game, solutions, err := generator.GenerateGame(ctx)
+	if err != nil {
+		return payload, fmt.Errorf("failed to generate game: %w", err)
+	}
+	payload = types.CreateGameTemplatePayload{
+		ID:          id,
+		CreatedAt:   time.Now(),
+		CreatedByID: dailyChallengeCreatedBy,
+		Name:        "Daily challenge " + date,
+		Description: fmt.Sprintf("Get a brick to %d. Only your first attempt counts on the leaderboard.", target),
+		IdealMoves:  solutions[0].Moves(),
+		IdealScore:  int(solutions[0].Score()),
+		Cells:       game.Cells(),
+		DailyDate:   date,
+		Rules: types.Rules{
+			CreatedAt:       time.Now(),
+			Mode:            types.RuleModeChallenge,
+			TargetCellValue: target,
+			Rows:            dailyChallengeSize,
+			Columns:         dailyChallengeSize,
+		},
+	}
+	return payload, payload.Validate()
+}
+
+func toModalDailyChallenge(d types.DailyChallenge) *model.DailyChallenge {
+	daily := &model.DailyChallenge{
+		Date:      d.DailyDate,
+		Challenge: toModalGameChallenge(d.GameTemplate),
+	}
+	if d.RankedAttempt != nil {
+		daily.RankedAttempt = &model.DailyAttempt{
+			GameId:    d.RankedAttempt.GameID,
+			Score:     d.RankedAttempt.Score,
+			Moves:     uint32(d.RankedAttempt.Moves),
+			PlayState: toModelPlayState(d.RankedAttempt.PlayState),
+		}
+	}
+	return daily
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func (s *TallyServer) GetDailyLeaderboard(
+	ctx context.Context,
+	req *connect.Request[model.GetDailyLeaderboardRequest],
+) (*connect.Response[model.GetDailyLeaderboardResponse], error) {
+	session := ContextGetUserState(ctx)
+	date, err := dailyDateFromRequest(req.Msg.Date)
+	if err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	daily, err := s.storage.GetDailyChallenge(ctx, types.GetDailyChallengePayload{Date: date})
+	if err != nil {
+		if errors.Is(err, types.ErrTemplateNotFound) {
+			cerr := createError(connect.CodeNotFound, fmt.Errorf("there is no daily challenge for %s", date))
+			return nil, cerr.ToConnectError()
+		}
+		s.l.Error().Err(err).Str("date", date).Msg("failed to issue storage.GetDailyChallenge in api.GetDailyLeaderboard")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to get daily challenge: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = leaderboardDefaultLimit
+	}
+	if limit > leaderboardMaxLimit {
+		limit = leaderboardMaxLimit
+	}
+	payload := types.GetChallengeLeaderboardPayload{
+		TemplateID:       daily.ID,
+		CurrentUserID:    session.UserID,
+		FirstAttemptOnly: true,
+		Limit:            limit,
+		Offset:           int(req.Msg.Offset),
+	}
+	if err := payload.Validate(); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	leaderboard, err := s.storage.GetChallengeLeaderboard(ctx, payload)
+	if err != nil {
+		s.l.Error().Err(err).Interface("payload", payload).Msg("failed to issue storage.GetChallengeLeaderboard in api.GetDailyLeaderboard")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to get leaderboard: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	response := &model.GetDailyLeaderboardResponse{
+		Entries:      make([]*model.LeaderboardEntry, len(leaderboard.Entries)),
+		TotalPlayers: uint32(leaderboard.TotalPlayers),
+	}
+	for i, e := range leaderboard.Entries {
+		response.Entries[i] = toModalLeaderboardEntry(e)
+	}
+	if leaderboard.CurrentUser != nil {
+		response.CurrentUser = toModalLeaderboardEntry(*leaderboard.CurrentUser)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -3,7 +3,6 @@ package api
 import (
 	"context"
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/bufbuild/connect-go"
@@ -19,6 +18,10 @@ func intsTouInt32s(ints []int) []uint32 {
 	return out
 }
 
+// The number of solutions the hint is chosen from. The solver returns the shortest
+// solutions first, so with fewer solutions, the hint-preference would make little difference.
+const hintMaxSolutions = 16
+
 const MaxUint = ^uint(0)
 const MaxInt = int(MaxUint >> 1)
 
@@ -32,7 +35,7 @@ func (s *TallyServer) GetHint(
 	// and swiping is a bit dull.
 	// Long hints seem like magic, so we prefer shorter hints.
 	// However, too short hints are also boring
-	// TODO: introduce a weighted hint and solution-sorter
+	// The solutions are ranked by tallylogic.SolutionRanker
 	session := ContextGetUserState(ctx)
 
 	response := &model.GetHintResponse{
@@ -67,16 +70,27 @@ func (s *TallyServer) GetHint(
 	response.Instructions = make([]*model.Instruction, 1)
 	// Deeper hint, looking ahead to find better hints, attempting to solve the game if possible.
 	// h := tallylogic.NewHintCalculator(session.Game, session.Game, session.Game)
-	games, err := tallylogic.SolveGame(tallylogic.SolveOptions{
-		MaxDepth:     10,
-		MaxVisits:    6000,
-		MinMoves:     0,
-		MaxMoves:     10,
-		MaxSolutions: 1,
-		MaxTime:      time.Second * 10,
-	}, session.Game, nil)
+	// The best-first solver finds the shortest solutions first, and visits far fewer boards
+	// than the brute solvers. Infinite games are still solved with the depth-first solver.
+	solver := tallylogic.GameSolverFactory(tallylogic.GameSolverFactoryOptions{
+		SolveOptions: tallylogic.SolveOptions{
+			MaxDepth:     10,
+			MaxVisits:    6000,
+			MinMoves:     0,
+			MaxMoves:     10,
+			MaxSolutions: hintMaxSolutions,
+			MaxTime:      time.Second * 10,
+		},
+		BestFirst: true,
+	})
+	games, err := solver.SolveGame(ctx, session.Game)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Failed to generate hint"))
+		if !tallylogic.IsTruncated(err) {
+			s.l.Error().Err(err).Msg("failed to solve game for hint")
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Failed to generate hint"))
+		}
+		// The solutions found before the solver stopped are still valid
+		s.l.Debug().Err(err).Msg("Solver stopped early while generating hint")
 	}
 	s.l.Debug().
 		Bool("deep", true).
@@ -95,77 +109,10 @@ func (s *TallyServer) GetHint(
 			req.Msg.HintPreference = model.HintPreference_HINT_PREFERENCE_SHORT
 		}
 	}
-	switch req.Msg.HintPreference {
-	case model.HintPreference_HINT_PREFERENCE_HIGHEST_SCORE:
-		sort.Slice(games, func(i, j int) bool {
-			return games[i].Score() < games[j].Score()
-		})
-	case model.HintPreference_HINT_PREFERENCE_SHORT:
-		sort.Slice(games, func(i, j int) bool {
-			return games[i].History.Length() < games[j].History.Length()
-		})
-	case model.HintPreference_HINT_PREFERENCE_MINIMUM_SWIPES:
-		sort.Slice(games, func(i, j int) bool {
-			var swipeI int
-			var swipeJ int
-			games[i].History.Iterate(
-				func(dir tallylogic.SwipeDirection, i int) error { swipeI++; return nil },
-				func(path []int, i int) error { return nil },
-				func(helper tallylogic.Helper, i int) error { return nil },
-			)
-			games[j].History.Iterate(
-				func(dir tallylogic.SwipeDirection, i int) error { swipeJ++; return nil },
-				func(path []int, i int) error { return nil },
-				func(helper tallylogic.Helper, i int) error { return nil },
-			)
-			return swipeI < swipeJ
-		})
-	case model.HintPreference_HINT_PREFERENCE_FIRST_COMBINE:
-		sort.Slice(games, func(i, j int) bool {
-			var combineIndexI int
-			var combineIndexJ int
-			// TODO
-			games[i].History.Iterate(
-				func(dir tallylogic.SwipeDirection, i int) error { return nil },
-				func(path []int, i int) error {
-					combineIndexI = i
-					return fmt.Errorf("stop")
-				},
-				func(helper tallylogic.Helper, i int) error { return nil },
-			)
-			games[j].History.Iterate(
-				func(dir tallylogic.SwipeDirection, i int) error { return nil },
-				func(path []int, i int) error {
-					combineIndexJ = i
-					return fmt.Errorf("stop")
-				},
-				func(helper tallylogic.Helper, i int) error { return nil },
-			)
-			if combineIndexI == combineIndexJ {
-				return games[i].History.Length() < games[j].History.Length()
-			}
-			return combineIndexI < combineIndexJ
-		})
-	case model.HintPreference_HINT_PREFERENCE_MINIMUM_SWIPES_TO_COMBINE_RATIO:
-		sort.Slice(games, func(i, j int) bool {
-			var swipeI float32
-			var swipeJ float32
-			var combineI float32
-			var combineJ float32
-			games[i].History.Iterate(
-				func(dir tallylogic.SwipeDirection, i int) error { swipeI++; return nil },
-				func(path []int, i int) error { combineI++; return nil },
-				func(helper tallylogic.Helper, i int) error { return nil },
-			)
-			games[j].History.Iterate(
-				func(dir tallylogic.SwipeDirection, i int) error { swipeJ++; return nil },
-				func(path []int, i int) error { combineJ++; return nil },
-				func(helper tallylogic.Helper, i int) error { return nil },
-			)
-			ratioI := swipeI / combineI
-			ratioJ := swipeJ / combineI
-			return ratioI < ratioJ
-		})
+	ranker := tallylogic.SolutionRankerForPreference(toLogicHintPreference(req.Msg.HintPreference))
+	if err := ranker.Rank(session.Game, games); err != nil {
+		s.l.Error().Err(err).Str("ranker", ranker.Name).Msg("failed to rank solutions")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Failed to generate hint"))
 	}
 	bestInstructions := games[0].History
 	var length int = bestInstructions.Length()
@@ -181,3 +128,19 @@ func (s *TallyServer) GetHint(
 	res := connect.NewResponse(response)
 	return res, nil
 }
+
+func toLogicHintPreference(p model.HintPreference) tallylogic.HintPreference {
+	switch p {
+	case model.HintPreference_HINT_PREFERENCE_SHORT:
+		return tallylogic.HintPreferenceShort
+	case model.HintPreference_HINT_PREFERENCE_HIGHEST_SCORE:
+		return tallylogic.HintPreferenceHighestScore
+	case model.HintPreference_HINT_PREFERENCE_MINIMUM_SWIPES:
+		return tallylogic.HintPreferenceMinimumSwipes
+	case model.HintPreference_HINT_PREFERENCE_MINIMUM_SWIPES_TO_COMBINE_RATIO:
+		return tallylogic.HintPreferenceMinimumSwipesToCombineRatio
+	case model.HintPreference_HINT_PREFERENCE_FIRST_COMBINE:
+		return tallylogic.HintPreferenceFirstCombine
+	}
+	return tallylogic.HintPreferenceShort
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+const (
+	listDailyChallengesDefaultLimit = 20
+	listDailyChallengesMaxLimit     = 100
+)
+
+func (s *TallyServer) ListDailyChallenges(
+	ctx context.Context,
+	req *connect.Request[model.ListDailyChallengesRequest],
+) (*connect.Response[model.ListDailyChallengesResponse], error) {
+	session := ContextGetUserState(ctx)
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = listDailyChallengesDefaultLimit
+	}
+	if limit > listDailyChallengesMaxLimit {
+		limit = listDailyChallengesMaxLimit
+	}
+	payload := types.ListDailyChallengesPayload{
+		UserID: session.UserID,
+		Limit:  limit,
+		Offset: int(req.Msg.Offset),
+	}
+	if err := payload.Validate(); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	list, err := s.storage.ListDailyChallenges(ctx, payload)
+	if err != nil {
+		s.l.Error().Err(err).Interface("payload", payload).Msg("failed to issue storage.ListDailyChallenges in api.ListDailyChallenges")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to list daily challenges: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	response := &model.ListDailyChallengesResponse{
+		DailyChallenges: make([]*model.DailyChallenge, len(list.Challenges)),
+		Total:           uint32(list.Total),
+	}
+	for i, d := range list.Challenges {
+		response.DailyChallenges[i] = toModalDailyChallenge(d)
+	}
+	return connect.NewResponse(response), nil
+}
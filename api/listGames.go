@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+const (
+	listGamesDefaultLimit = 20
+	listGamesMaxLimit     = 100
+)
+
+func (s *TallyServer) ListGames(
+	ctx context.Context,
+	req *connect.Request[model.ListGamesRequest],
+) (*connect.Response[model.ListGamesResponse], error) {
+	session := ContextGetUserState(ctx)
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = listGamesDefaultLimit
+	}
+	if limit > listGamesMaxLimit {
+		limit = listGamesMaxLimit
+	}
+	payload := types.ListGamesPayload{
+		UserID:     session.UserID,
+		TemplateID: req.Msg.ChallengeId,
+		Limit:      limit,
+		Offset:     int(req.Msg.Offset),
+	}
+	for _, p := range req.Msg.PlayStates {
+		playState := fromModelPlayState(p)
+		if playState == "" {
+			cerr := createError(connect.CodeInvalidArgument, fmt.Errorf("%w: unsupported play-state %s", types.ErrArgumentInvalid, p))
+			return nil, cerr.ToConnectError()
+		}
+		payload.PlayStates = append(payload.PlayStates, playState)
+	}
+	for _, m := range req.Msg.Modes {
+		modes := fromModelGameMode(m)
+		if len(modes) == 0 {
+			cerr := createError(connect.CodeInvalidArgument, fmt.Errorf("%w: unsupported mode %s", types.ErrArgumentInvalid, m))
+			return nil, cerr.ToConnectError()
+		}
+		payload.Modes = append(payload.Modes, modes...)
+	}
+	if err := payload.Validate(); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	list, err := s.storage.ListGames(ctx, payload)
+	if err != nil {
+		if errors.Is(err, types.ErrArgumentInvalid) || errors.Is(err, types.ErrArgumentMissing) {
+			cerr := createError(connect.CodeInvalidArgument, err)
+			return nil, cerr.ToConnectError()
+		}
+		s.l.Error().Err(err).Interface("payload", payload).Msg("failed to issue storage.ListGames in api.ListGames")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to list games: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	response := &model.ListGamesResponse{
+		Games: make([]*model.ListedGame, len(list.Games)),
+		Total: uint32(list.Total),
+	}
+	for i, g := range list.Games {
+		response.Games[i] = toModalListedGame(g)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func toModalListedGame(g types.ListedGame) *model.ListedGame {
+	return &model.ListedGame{
+		Id: g.ID,
+		Board: &model.Board{
+			Id:      g.ID,
+			Cells:   toModalCells(g.Cells),
+			Columns: uint32(g.Rules.Columns),
+			Rows:    uint32(g.Rules.Rows),
+			Name:    g.Name,
+		},
+		Score:       int64(g.Score),
+		Moves:       int64(g.Moves),
+		Mode:        toModelGameModeFromRuleMode(g.Rules.Mode),
+		PlayState:   toModelPlayState(g.PlayState),
+		ChallengeId: g.TemplateID,
+		Active:      g.Active,
+		Description: g.Description,
+	}
+}
@@ -356,6 +356,8 @@ func Recovery(withStackTrace bool, l logger.AppLogger) MiddleWare {
 type AuthorizationOptions struct {
 	Debug           bool
 	SessionLifeTime time.Duration
+	// Active sessions are extended to SessionLifeTime, at most once per SessionRefreshInterval
+	SessionRefreshInterval time.Duration
 	// If set will allow the client to set some options on each request that normally is not allowed.
 	// Mostly used for e2e-testing, where the client wants to be in control over the randomization and such
 	AllowDevelopmentFlags bool
@@ -375,6 +377,9 @@ func Authorization(store SessionStore, options AuthorizationOptions) MiddleWare
 		options.SessionLifeTime = time.Duration(sessionMaxTime) * time.Second
 
 	}
+	if options.SessionRefreshInterval == 0 {
+		options.SessionRefreshInterval = 24 * time.Hour
+	}
 	return func(next http.Handler) http.HandlerFunc {
 		// idgenerator := mustCreateUUidgenerator()
 		return func(w http.ResponseWriter, r *http.Request) {
@@ -403,23 +408,53 @@ func Authorization(store SessionStore, options AuthorizationOptions) MiddleWare
 					return
 				}
 				if us != nil {
-					if time.Now().After(us.InvalidAfter) {
-						l.Warn().
+					if now.After(us.InvalidAfter) {
+						// The user is moved over to a new session, instead of being locked out.
+						// Requests that still use the expired session are moved to the same session.
+						// The expired session is later deleted by the reaper.
+						l.Info().
 							Time("InvalidAfter", us.InvalidAfter).
-							Time("now", time.Now()).
-							Msg("session is invalid")
-						us = nil
-						// TODO: handle this case
-						w.WriteHeader(http.StatusUnauthorized)
-						return
+							Str("userID", us.UserID).
+							Msg("session has expired, moving the user to a new session")
+						renewed, err := store.RenewSession(ctx, types.RenewSessionPayload{
+							ExpiredSessionID: us.Session.ID,
+							SessionID:        gonanoid.Must(),
+							InvalidAfter:     now.Add(options.SessionLifeTime),
+						})
+						if err != nil {
+							l.Error().Err(err).Str("userID", us.UserID).Msg("failed to create a new session for user with expired session")
+							w.WriteHeader(500)
+							return
+						}
+						us, err = store.GetUserBySessionID(ctx, types.GetUserPayload{ID: renewed.ID})
+						if err != nil || us == nil {
+							l.Error().Err(err).Str("sessionID", renewed.ID).Msg("failed to lookup the renewed session")
+							w.WriteHeader(500)
+							return
+						}
+						sessionID = renewed.ID
+						setSessionCookie(l, w, r, sessionID)
+					} else if now.Add(options.SessionLifeTime).Sub(us.InvalidAfter) > options.SessionRefreshInterval {
+						// Sliding expiry. Active sessions are extended, at most once per SessionRefreshInterval
+						err := store.RefreshSession(ctx, types.RefreshSessionPayload{
+							SessionID:    us.Session.ID,
+							InvalidAfter: now.Add(options.SessionLifeTime),
+						})
+						if err != nil {
+							// The session is still valid, so the request can continue
+							l.Error().Err(err).Str("sessionID", us.Session.ID).Msg("failed to refresh session")
+						} else {
+							setSessionCookie(l, w, r, sessionID)
+						}
 					}
 				}
 				if us != nil {
 
 					userState = &UserState{
-						SessionID: us.Session.ID,
-						UserName:  us.UserName,
-						UserID:    us.UserID,
+						SessionID:  us.Session.ID,
+						UserName:   us.UserName,
+						UserID:     us.UserID,
+						Registered: us.User.IsRegistered(),
 					}
 					if us.ActiveGame != nil {
 						l.Debug().Msg("Restoring game")
@@ -553,33 +588,7 @@ func Authorization(store SessionStore, options AuthorizationOptions) MiddleWare
 					sessionID = createdUserSession.Session.ID
 					Store.SetUserState(userState)
 				}
-				// Set the cookie /user-session
-				// TODO: also recreate a session with a new cookie if it expires soon (like in the next 200 days)
-
-				cookie := &http.Cookie{
-					Name: tokenHeader,
-					// TODO: when the server is behind a subpath (e.g.
-					// exmaple.com/skiver/), the reverse-proxy in front may not return our
-					// path, and we probably need to get it from the config
-					Path:   "/",
-					Value:  sessionID,
-					MaxAge: sessionMaxTime,
-					Secure: r.TLS != nil,
-					// SameSite: http.SameSiteNoneMode,
-					HttpOnly: true,
-				}
-				isSecure, _ := isSecureRequest(r)
-				if isSecure {
-					cookie.Secure = true
-					cookie.SameSite = http.SameSiteNoneMode
-				} else {
-					cookie.Secure = false
-					if setHttpAuthHeader {
-						w.Header().Set(tokenHeader, sessionID)
-						l.Warn().Msg("using authorization-header")
-					}
-				}
-				http.SetCookie(w, cookie)
+				setSessionCookie(l, w, r, sessionID)
 			}
 			span.SetAttributes(
 				semconv.EnduserIDKey.String(userState.UserID),
@@ -590,6 +599,41 @@ func Authorization(store SessionStore, options AuthorizationOptions) MiddleWare
 	}
 }
 
+// Sets the cookie for the user-session, and the header for insecure requests
+func setSessionCookie(l logger.AppLogger, w http.ResponseWriter, r *http.Request, sessionID string) {
+	setSessionHeaders(l, w.Header(), r.Header, sessionID)
+}
+
+// Same as setSessionCookie, for handlers that only have access to the headers,
+// like connect-handlers.
+func setSessionHeaders(l logger.AppLogger, header http.Header, requestHeader http.Header, sessionID string) {
+	cookie := &http.Cookie{
+		Name: tokenHeader,
+		// TODO: when the server is behind a subpath (e.g.
+		// exmaple.com/skiver/), the reverse-proxy in front may not return our
+		// path, and we probably need to get it from the config
+		Path:   "/",
+		Value:  sessionID,
+		MaxAge: sessionMaxTime,
+		// SameSite: http.SameSiteNoneMode,
+		HttpOnly: true,
+	}
+	isSecure, _ := isSecureHeader(requestHeader)
+	if isSecure {
+		cookie.Secure = true
+		cookie.SameSite = http.SameSiteNoneMode
+	} else {
+		cookie.Secure = false
+		if setHttpAuthHeader {
+			header.Set(tokenHeader, sessionID)
+			l.Warn().Msg("using authorization-header")
+		}
+	}
+	if v := cookie.String(); v != "" {
+		header.Add("Set-Cookie", v)
+	}
+}
+
 func pipeline(handler http.Handler, middlewares ...MiddleWare) http.Handler {
 	for i := len(middlewares) - 1; i >= 0; i-- {
 		handler = middlewares[i](handler)
@@ -44,20 +44,27 @@ func (s *TallyServer) NewGameFromTemplate(
 		Name:            req.Msg.Name,
 		Cells:           fromModalCells(req.Msg.Cells),
 		Rules: types.Rules{
-			ID:              rule.ID,
-			CreatedAt:       time.Now(),
-			UpdatedAt:       &time.Time{},
-			Description:     "",
-			Mode:            types.RuleModeChallenge,
-			TargetCellValue: req.Msg.TargetCellValue,
-			Rows:            uint8(req.Msg.Rows),
-			Columns:         uint8(req.Msg.Columns),
-			RecreateOnSwipe: rule.RecreateOnSwipe,
-			NoReSwipe:       rule.NoReswipe,
-			NoMultiply:      rule.Options.NoMultiply,
-			NoAddition:      rule.Options.NoAddition,
+			ID:                 rule.ID,
+			CreatedAt:          time.Now(),
+			UpdatedAt:          &time.Time{},
+			Description:        "",
+			Mode:               types.RuleModeChallenge,
+			TargetCellValue:    req.Msg.TargetCellValue,
+			Rows:               uint8(req.Msg.Rows),
+			Columns:            uint8(req.Msg.Columns),
+			RecreateOnSwipe:    rule.RecreateOnSwipe,
+			NoReSwipe:          rule.NoReswipe,
+			NoMultiply:         rule.Options.NoMultiply,
+			NoAddition:         rule.Options.NoAddition,
+			WithSubtraction:    rule.Options.WithSubtraction,
+			WithDivision:       rule.Options.WithDivision,
+			WithMixedOperators: rule.Options.WithMixedOperators,
+			Topology:           rule.Options.Topology.RuleTopology(),
 		},
 	}
+	if err := types.ValidateCells(challenge.Cells); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	t, err := challengeToTemplate(challenge)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to map challenge from input: %w", err))
@@ -75,27 +82,16 @@ func (s *TallyServer) NewGameFromTemplate(
 	return res, err
 }
 
-func (s *TallyServer) getChallenge(ctx context.Context, id string, userID string) (*tallylogic.GameTemplate, error) {
-	// TODO: Get by id
-	challenges, err := s.storage.GetGameChallenges(ctx, types.GetGameChallengePayload{
-		StatsForUserID: userID,
-	})
+func (s *TallyServer) getChallenge(ctx context.Context, id string) (*tallylogic.GameTemplate, error) {
+	challenge, err := s.storage.GetGameTemplate(ctx, types.GetGameTemplatePayload{ID: id})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to retrieve game-challenges %w", err))
-	}
-	var challenge types.GameTemplate
-	for _, c := range challenges {
-		if c.ID == id {
-			challenge = c
-			break
+		if errors.Is(err, types.ErrTemplateNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("challenge not found: '%s'", id))
 		}
-	}
-	if challenge.ID != id {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("challenge not found: '%s'", id))
-
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to retrieve game-challenge %w", err))
 	}
 	// TODO: this is tedious, fix it
-	template, err := challengeToTemplate(challenge)
+	template, err := challengeToTemplate(*challenge)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to map challenge: %w", err))
 	}
@@ -123,8 +119,7 @@ func (s *TallyServer) NewGame(
 			if id == "" {
 				return nil, fmt.Errorf("ID is required for Variatn with id %s", id)
 			}
-			// TODO: Get by id
-			template, err = s.getChallenge(ctx, id, session.UserID)
+			template, err = s.getChallenge(ctx, id)
 			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to map challenge: %w", err))
 			}
@@ -199,20 +194,23 @@ func (s TallyServer) newGame(ctx context.Context, session *UserState, mode logic
 	if template != nil {
 		payload.TemplateID = template.ID
 	}
+	response, err := s.startGame(ctx, session, payload)
+	if err != nil {
+		return nil, err
+	}
+	res := connect.NewResponse(response)
+	return res, nil
+}
+
+// startGame saves the game for the user, and sets it as the active game in the session.
+func (s TallyServer) startGame(ctx context.Context, session *UserState, payload types.NewGamePayload) (*model.NewGameResponse, error) {
+	l := s.logForUser(session)
 	tg, err := s.storage.NewGameForUser(ctx, payload)
 	if err != nil {
 		l.Error().Err(err).Msg("failed to create new game")
 		return nil, fmt.Errorf("internal error while creating new game")
 	}
-	if template != nil {
-		// TODO: why are we overriding this here? shouldn't the db have set these values already?
-		// tg.Rules.TargetCellValue = template.Rules.TargetCellValue
-		// tg.Rules.TargetScore = template.Rules.TargetScore
-		// tg.Rules.TargetScore = template.Rules.TargetScore
-		// tg.Rules.MaxMoves = template.Rules.MaxMoves
-	}
-	game.ID = tg.ID
-	game, err = tallylogic.RestoreGame(&tg)
+	game, err := tallylogic.RestoreGame(&tg)
 	if err != nil {
 
 		l.Error().Err(err).Msg("failed to restore game during call to newgame")
@@ -227,12 +225,13 @@ func (s TallyServer) newGame(ctx context.Context, session *UserState, mode logic
 		Score:       session.Game.Score(),
 		Moves:       int64(session.Game.Moves()),
 		Mode:        toModelGameMode(session.Game.Rules.GameMode),
+		SwapsLeft:   uint32(session.Game.SwapsLeft()),
+		PowersLeft:  uint32(session.Game.PowersLeft()),
 	}
 	if response.Description == "" {
 		response.Description = session.Game.Name
 	}
-	res := connect.NewResponse(response)
-	return res, nil
+	return response, nil
 }
 
 func challengeToTemplate(challenge types.GameTemplate) (*logic.GameTemplate, error) {
@@ -242,6 +241,10 @@ func challengeToTemplate(challenge types.GameTemplate) (*logic.GameTemplate, err
 	}
 	template := logic.NewGameTemplate(logic.GameModeRandomChallenge, challenge.ID, challenge.Name, challenge.Description, int(challenge.Rows), int(challenge.Columns))
 	r := challenge.Rules
+	topology, err := logic.TopologyFromRules(r.Topology)
+	if err != nil {
+		return nil, err
+	}
 	template.Rules = logic.GameRules{
 		ID:              r.ID,
 		GameMode:        logic.GameModeRandomChallenge,
@@ -251,22 +254,33 @@ func challengeToTemplate(challenge types.GameTemplate) (*logic.GameTemplate, err
 		MaxMoves:        r.MaxMoves,
 		TargetScore:     r.TargetScore,
 		RecreateOnSwipe: r.RecreateOnSwipe,
-		// WithSuperPowers: r.WithSuperPowers,
+		WithSuperPowers: !r.NoSuperPowers,
+		MaxSwaps:        r.MaxSwaps,
+		MaxPowers:       r.MaxPowers,
 		// StartingBricks:  r.StartingBricks,
-		NoReswipe: r.NoReSwipe,
+		NoReswipe:          r.NoReSwipe,
+		SwapNeighboursOnly: r.SwapNeighboursOnly,
 		Options: logic.NewGameOptions{
 			TableBoardOptions: logic.TableBoardOptions{
 				EvaluateOptions: logic.EvaluateOptions{
-					NoMultiply: false,
-					NoAddition: false,
+					NoMultiply:         r.NoMultiply,
+					NoAddition:         r.NoAddition,
+					WithSubtraction:    r.WithSubtraction,
+					WithDivision:       r.WithDivision,
+					WithMixedOperators: r.WithMixedOperators,
 				},
-				Cells: challenge.Cells,
+				Topology: topology,
+				Cells:    challenge.Cells,
 			},
 			Seed:  0,
 			State: 0,
 		},
 	}
-	template = template.SetGoalCheckerLargestValue(r.TargetCellValue)
+	template = template.SetGoalCheckerLargestValue(r.TargetCellValue).
+		SetTargetScore(r.TargetScore)
+	if r.MaxMoves > 0 {
+		template = template.SetMaxMoves(int(r.MaxMoves))
+	}
 	template.SetStartingCells(challenge.Cells)
 	return template, nil
 }
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	passwordHashPrefix     = "pbkdf2-sha256"
+	passwordHashIterations = 100_000
+	passwordSaltLength     = 16
+	passwordMinLength      = 8
+	// Hashing is deliberately slow, so very long passwords are rejected
+	passwordMaxLength = 200
+)
+
+var ErrPasswordHashInvalid = errors.New("password-hash is invalid")
+
+// Verified against when the user does not exist, so that unknown usernames
+// take as long to reject as wrong passwords.
+var dummyPasswordHash = strings.Join([]string{
+	passwordHashPrefix,
+	strconv.Itoa(passwordHashIterations),
+	base64.RawStdEncoding.EncodeToString(make([]byte, passwordSaltLength)),
+	base64.RawStdEncoding.EncodeToString(make([]byte, sha256.Size)),
+}, "$")
+
+func validatePassword(password string) error {
+	if len(password) < passwordMinLength {
+		return fmt.Errorf("password must be at least %d characters long", passwordMinLength)
+	}
+	if len(password) > passwordMaxLength {
+		return fmt.Errorf("password cannot be longer than %d characters", passwordMaxLength)
+	}
+	return nil
+}
+
+// Hashes the password with a random salt.
+// The returned string includes the algorithm, iterations and salt,
+// in the form pbkdf2-sha256$iterations$salt$hash
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, passwordSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to create salt: %w", err)
+	}
+	key := pbkdf2.Key([]byte(password), salt, passwordHashIterations, sha256.Size, sha256.New)
+	return strings.Join([]string{
+		passwordHashPrefix,
+		strconv.Itoa(passwordHashIterations),
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	}, "$"), nil
+}
+
+// Reports whether the password matches the hash, as created by hashPassword
+func verifyPassword(password, hash string) (bool, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != passwordHashPrefix {
+		return false, ErrPasswordHashInvalid
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false, fmt.Errorf("%w: iterations", ErrPasswordHashInvalid)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false, fmt.Errorf("%w: salt: %v", ErrPasswordHashInvalid, err)
+	}
+	want, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return false, fmt.Errorf("%w: key: %v", ErrPasswordHashInvalid, err)
+	}
+	got := pbkdf2.Key([]byte(password), salt, iterations, len(want), sha256.New)
+	return subtle.ConstantTimeCompare(got, want) == 1, nil
+}
@@ -9,9 +9,6 @@ import (
 // PersistantStorage ...
 type PersistantStorage interface {
 	// Deploy() error
-	// VoteForBoard(id, user, userName string, funVote int) (*types.Vote, error)
-	// GetAllVotes() (map[string]types.Vote, error)
-	// GetVotesForBoardByUserName(userName string) (map[string]types.Vote, error)
 	SessionStore
 }
 
@@ -37,5 +34,40 @@ type SessionStore interface {
 	// Creates a new template, often used for challenges
 	CreateGameTemplate(ctx context.Context, payload types.CreateGameTemplatePayload) (*types.GameTemplate, error)
 	GetGameChallenges(ctx context.Context, payload types.GetGameChallengePayload) ([]types.GameTemplate, error)
+	// Returns the template, or types.ErrTemplateNotFound
+	GetGameTemplate(ctx context.Context, payload types.GetGameTemplatePayload) (*types.GameTemplate, error)
+	// Returns the daily challenge for the date, or types.ErrTemplateNotFound if it has not been created
+	GetDailyChallenge(ctx context.Context, payload types.GetDailyChallengePayload) (*types.DailyChallenge, error)
+	// Returns the daily challenges that have been created, the most recent day first
+	ListDailyChallenges(ctx context.Context, payload types.ListDailyChallengesPayload) (types.DailyChallengeList, error)
+	// Returns the best attempt of each user that has won the challenge, ranked.
+	// With FirstAttemptOnly, only the first attempt of each user is considered.
+	GetChallengeLeaderboard(ctx context.Context, payload types.GetChallengeLeaderboardPayload) (types.ChallengeLeaderboard, error)
 	GetOriginalGame(ctx context.Context, payload types.GetOriginalGamePayload) (types.Game, error)
+	// Returns the latest checkpoint of the game at or before the depth, or nil if there is none
+	GetGameCheckpoint(ctx context.Context, payload types.GetGameCheckpointPayload) (*types.GameCheckpoint, error)
+	// Returns the users games, the most recently created first
+	ListGames(ctx context.Context, payload types.ListGamesPayload) (types.GameList, error)
+	// Makes an unfinished game the users active game, abandoning the current one
+	ResumeGame(ctx context.Context, payload types.ResumeGamePayload) (types.Game, error)
+	// Registers a vote for the game, or the template it is based on.
+	// Voting again for the same board updates the existing vote.
+	VoteForBoard(ctx context.Context, payload types.VoteForBoardPayload) (*types.Vote, error)
+	// Sets a username and password for the user, so that it can be reached from other devices
+	RegisterUser(ctx context.Context, payload types.RegisterUserPayload) (*types.User, error)
+	// Returns a registered User by their username, or nil if not found
+	GetRegisteredUser(ctx context.Context, payload types.GetRegisteredUserPayload) (*types.User, error)
+	// Creates a new session for an existing user
+	CreateSession(ctx context.Context, payload types.CreateSessionPayload) (*types.Session, error)
+	// Moves the user of an expired session to a new session, or returns the
+	// session that already replaced it
+	RenewSession(ctx context.Context, payload types.RenewSessionPayload) (*types.Session, error)
+	// Sets a new expiry for the session
+	RefreshSession(ctx context.Context, payload types.RefreshSessionPayload) error
+	// Deletes expired sessions, and guest-users that are left without sessions and never played
+	ReapExpiredSessions(ctx context.Context, payload types.ReapSessionsPayload) (types.ReapResult, error)
+	// Creates a one-time code, for attaching other sessions to the user
+	CreateLinkCode(ctx context.Context, payload types.CreateLinkCodePayload) (*types.LinkCode, error)
+	// Creates a new session for the user that created the link-code, and invalidates the code
+	UseLinkCode(ctx context.Context, payload types.UseLinkCodePayload) (*types.User, error)
 }
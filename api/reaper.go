@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+const (
+	sessionReapInterval = time.Hour
+	// Expired sessions are kept for a while, so that returning users are moved
+	// over to a new session on the same user, instead of becoming a new guest.
+	sessionReapGracePeriod = 30 * 24 * time.Hour
+)
+
+// Reaps sessions at every interval, until the context is done
+func (t TallyServer) reapSessionsAtInterval(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		t.reapSessions(ctx, time.Now().Add(-sessionReapGracePeriod))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Deletes sessions that expired before the given time, and the guest-users left without any sessions
+func (t TallyServer) reapSessions(ctx context.Context, expiredBefore time.Time) (types.ReapResult, error) {
+	result, err := t.storage.ReapExpiredSessions(ctx, types.ReapSessionsPayload{ExpiredBefore: expiredBefore})
+	if err != nil {
+		t.l.Error().Err(err).Msg("failed to reap expired sessions")
+		return result, err
+	}
+	t.l.Info().
+		Int64("sessions", result.Sessions).
+		Int64("linkCodes", result.LinkCodes).
+		Int64("games", result.Games).
+		Int64("users", result.Users).
+		Msg("reaped expired sessions")
+	return result, nil
+}
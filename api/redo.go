@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func (s *TallyServer) Redo(
+	ctx context.Context,
+	req *connect.Request[model.RedoRequest],
+) (*connect.Response[model.RedoResponse], error) {
+	session := ContextGetUserState(ctx)
+	// We copy the game, to rollback the in-memory cache if anything goes wrong
+	gameCopy := session.Game.Copy()
+	err := session.Game.Redo()
+	if err != nil {
+		if errors.Is(err, tallylogic.ErrNoMoreHistoryToRedo) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("cannot redo: %w", err))
+		}
+		s.l.Error().
+			Err(err).
+			Msg("Failed to redo board")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	seed, state := session.Game.Seed()
+	depth, checkpoints, err := toTypeCheckpoints(&session.Game)
+	if err != nil {
+		session.Game = gameCopy
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	payload := types.UpdateGamePayload{
+		GameID:      session.Game.ID,
+		Moves:       session.Game.Moves(),
+		Score:       uint64(session.Game.Score()),
+		State:       state,
+		Seed:        seed,
+		Cells:       session.Game.Cells(),
+		History:     session.Game.History.Bytes(),
+		PlayState:   types.PlayStateCurrent,
+		Depth:       depth,
+		Checkpoints: checkpoints,
+	}
+	didWin := session.Game.IsGameWon()
+	didLose := session.Game.IsGameOver()
+	if didWin {
+		payload.PlayState = types.PlayStateWon
+	} else if didLose {
+		payload.PlayState = types.PlayStateLost
+	}
+	err = s.storage.UpdateGame(ctx, payload)
+	if err != nil {
+		s.l.Error().
+			Err(err).
+			Interface("payload", payload).
+			Msg("failed to save the board to storage during redo-operation")
+		// rollback the game in memory
+		session.Game = gameCopy
+		return nil, fmt.Errorf("internal failure while saving the board during redo: %w", err)
+	}
+	response := &model.RedoResponse{
+		Board:      toModalBoard(&session.Game),
+		Score:      session.Game.Score(),
+		Moves:      int64(session.Game.Moves()),
+		DidWin:     didWin,
+		DidLose:    didLose,
+		SwapsLeft:  uint32(session.Game.SwapsLeft()),
+		PowersLeft: uint32(session.Game.PowersLeft()),
+		CanUndo:    session.Game.CanUndo(),
+		CanRedo:    session.Game.CanRedo(),
+	}
+	return connect.NewResponse(response), nil
+}
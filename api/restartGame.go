@@ -50,9 +50,11 @@ func (s *TallyServer) RestartGame(
 	}
 	session.Game = g
 	response := &model.RestartGameResponse{
-		Board: toModalBoard(&session.Game),
-		Score: session.Game.Score(),
-		Moves: int64(session.Game.Moves()),
+		Board:      toModalBoard(&session.Game),
+		Score:      session.Game.Score(),
+		Moves:      int64(session.Game.Moves()),
+		SwapsLeft:  uint32(session.Game.SwapsLeft()),
+		PowersLeft: uint32(session.Game.PowersLeft()),
 	}
 	res := connect.NewResponse(response)
 	return res, nil
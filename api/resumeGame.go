@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func (s *TallyServer) ResumeGame(
+	ctx context.Context,
+	req *connect.Request[model.ResumeGameRequest],
+) (*connect.Response[model.ResumeGameResponse], error) {
+	session := ContextGetUserState(ctx)
+	payload := types.ResumeGamePayload{
+		UserID: session.UserID,
+		GameID: req.Msg.GameId,
+	}
+	if err := payload.Validate(); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	tg, err := s.storage.ResumeGame(ctx, payload)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrGameNotFound):
+			cerr := createError(connect.CodeNotFound, err)
+			return nil, cerr.ToConnectError()
+		case errors.Is(err, types.ErrGameFinished):
+			cerr := createError(connect.CodeFailedPrecondition, err)
+			return nil, cerr.ToConnectError()
+		}
+		s.l.Error().Err(err).Interface("payload", payload).Msg("failed to issue storage.ResumeGame in api.ResumeGame")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to resume the game: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	g, err := tallylogic.RestoreGame(&tg)
+	if err != nil {
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failed to restore the game: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	session.Game = g
+	response := &model.ResumeGameResponse{
+		Game: &model.Game{
+			Board:       toModalBoard(&session.Game),
+			Score:       session.Game.Score(),
+			Moves:       int64(session.Game.Moves()),
+			Mode:        toModelGameMode(session.Game.Rules.GameMode),
+			Description: session.Game.Description,
+			SwapsLeft:   uint32(session.Game.SwapsLeft()),
+			PowersLeft:  uint32(session.Game.PowersLeft()),
+		},
+	}
+	return connect.NewResponse(response), nil
+}
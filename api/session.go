@@ -15,17 +15,7 @@ func (s *TallyServer) GetSession(
 ) (*connect.Response[model.GetSessionResponse], error) {
 	session := ContextGetUserState(ctx)
 	response := &model.GetSessionResponse{
-		Session: &model.Session{
-			SessionId: session.SessionID,
-			Username:  session.UserName,
-			Game: &model.Game{
-				Board:       toModalBoard(&session.Game),
-				Score:       session.Game.Score(),
-				Moves:       int64(session.Game.Moves()),
-				Description: session.Game.Description,
-				Mode:        toModelGameMode(session.Rules.GameMode),
-			},
-		},
+		Session: toModalSession(session),
 	}
 	res := connect.NewResponse(response)
 	return res, nil
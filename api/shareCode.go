@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/storage"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+// CreateShareCode creates a share-code for the current game, as it was at the start.
+func (s *TallyServer) CreateShareCode(
+	ctx context.Context,
+	req *connect.Request[model.CreateShareCodeRequest],
+) (*connect.Response[model.CreateShareCodeResponse], error) {
+	session := ContextGetUserState(ctx)
+	g, err := s.storage.GetOriginalGame(ctx, types.GetOriginalGamePayload{GameID: session.Game.ID})
+	if err != nil {
+		s.l.Error().
+			Err(err).
+			Str("gameID", session.Game.ID).
+			Msg("Failed to get original game while creating share-code")
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create share-code"))
+	}
+	g.History = nil
+	if req.Msg.IncludeHistory {
+		g.History = session.Game.History.BytesCopy()
+	}
+	code, err := storage.EncodeShareCode(g)
+	if err != nil {
+		s.l.Error().
+			Err(err).
+			Str("gameID", session.Game.ID).
+			Msg("Failed to encode share-code")
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create share-code"))
+	}
+	response := &model.CreateShareCodeResponse{
+		Code: code,
+	}
+	res := connect.NewResponse(response)
+	return res, nil
+}
+
+// The history of a share-code is replayed, so it is limited to a few thousand
+// instructions. The bytes are checked first, since counting the instructions
+// reads all of them.
+const (
+	shareCodeMaxHistoryBytes        = 1 << 14
+	shareCodeMaxHistoryInstructions = 5000
+)
+
+func validateShareCodeHistory(g types.Game) error {
+	if len(g.History) > shareCodeMaxHistoryBytes {
+		return fmt.Errorf("the history of the share-code is longer than %d bytes", shareCodeMaxHistoryBytes)
+	}
+	topology, err := tallylogic.TopologyFromRules(g.Rules.Topology)
+	if err != nil {
+		return fmt.Errorf("the rules of the share-code are invalid: %w", err)
+	}
+	h := tallylogic.NewCompactHistoryFromBinary(int(g.Rules.Columns), int(g.Rules.Rows), g.History).WithTopology(topology)
+	if h.Length() > shareCodeMaxHistoryInstructions {
+		return fmt.Errorf("the history of the share-code has more than %d instructions", shareCodeMaxHistoryInstructions)
+	}
+	return nil
+}
+
+// NewGameFromShareCode starts a new game from a share-code, at the start of the shared game.
+// If the share-code includes the history, it is validated and returned, so that it can be replayed.
+func (s *TallyServer) NewGameFromShareCode(
+	ctx context.Context,
+	req *connect.Request[model.NewGameFromShareCodeRequest],
+) (*connect.Response[model.NewGameFromShareCodeResponse], error) {
+	session := ContextGetUserState(ctx)
+	g, err := storage.DecodeShareCode(req.Msg.Code)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var history []*model.Instruction
+	if len(g.History) > 0 {
+		if err := validateShareCodeHistory(g); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		replay, err := tallylogic.ReplayGame(g, g.History)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the history of the share-code is invalid: %w", err))
+		}
+		history, err = toModelInstruction(replay.Game.History)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	g.ID = s.UidGenerator()
+	g.CreatedAt = time.Now()
+	g.UserID = session.UserID
+	g.History = nil
+	g.Rules.CreatedAt = time.Now()
+	if _, err := tallylogic.RestoreGame(&g); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to restore game from share-code: %w", err))
+	}
+
+	resN, err := s.startGame(ctx, session, types.NewGamePayload{Game: g})
+	if err != nil {
+		return nil, err
+	}
+	res := connect.NewResponse(&model.NewGameFromShareCodeResponse{
+		Board:       resN.Board,
+		Score:       resN.Score,
+		Moves:       resN.Moves,
+		Description: resN.Description,
+		Mode:        resN.Mode,
+		History:     history,
+	})
+	return res, nil
+}
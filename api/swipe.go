@@ -30,24 +30,31 @@ func (s *TallyServer) SwipeBoard(
 		didWin := session.Game.IsGameWon()
 		didLose := session.Game.IsGameOver()
 		seed, state := session.Game.Seed()
+		depth, checkpoints, err := toTypeCheckpoints(&session.Game)
+		if err != nil {
+			session.Game = gameCopy
+			return nil, fmt.Errorf("intarnal failure while creating checkpoints: %w", err)
+		}
 		payload := types.UpdateGamePayload{
-			GameID:    session.Game.ID,
-			Moves:     session.Game.Moves(),
-			Score:     uint64(gameCopy.Score()),
-			State:     state,
-			Seed:      seed,
-			Cells:     session.Cells(),
-			History:   session.Game.History.Bytes(),
-			PlayState: types.PlayStateCurrent,
+			GameID:      session.Game.ID,
+			Moves:       session.Game.Moves(),
+			Score:       uint64(gameCopy.Score()),
+			State:       state,
+			Seed:        seed,
+			Cells:       session.Cells(),
+			History:     session.Game.History.Bytes(),
+			PlayState:   types.PlayStateCurrent,
+			Depth:       depth,
+			Checkpoints: checkpoints,
 		}
 		if didWin {
 			payload.PlayState = types.PlayStateWon
 			response.DidWin = true
 		} else if didLose {
 			payload.PlayState = types.PlayStateLost
-			response.DidWin = didLose
+			response.DidLose = true
 		}
-		err := s.storage.UpdateGame(ctx, payload)
+		err = s.storage.UpdateGame(ctx, payload)
 		if err != nil {
 			s.l.Error().
 				Err(err).
@@ -19,43 +19,84 @@ func (s *TallyServer) Undo(
 	if session.Game.Moves() == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Game is already at the beginning, cannot undo"))
 	}
-	g, err := s.storage.GetOriginalGame(ctx, types.GetOriginalGamePayload{GameID: session.Game.ID})
+	steps := int(req.Msg.Steps)
+	if steps == 0 {
+		steps = 1
+	}
+	depth, err := session.Game.Depth()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if steps > depth {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: cannot undo %d steps, with %d moves in effect", tallylogic.ErrNoMoreHistoryToUndo, steps, depth))
+	}
+	// We copy the game, to rollback the in-memory cache if anything goes wrong
+	gameCopy := session.Game.Copy()
+	// Undo replays the moves from the latest checkpoint before the target,
+	// so we only need the start of the game if there is no such checkpoint.
+	checkpoint, err := s.storage.GetGameCheckpoint(ctx, types.GetGameCheckpointPayload{GameID: session.Game.ID, MaxDepth: depth - steps})
 	if err != nil {
 		s.l.Error().
 			Err(err).
-			Interface("game", g).
-			Msg("Failed to get original game before undo")
+			Str("gameID", session.Game.ID).
+			Msg("Failed to get checkpoint before undo")
 
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	g2, err := tallylogic.RestoreGame(&g)
-	session.Game.ReplaceBasedOn(g2)
-	// We copy the game, to rollback the in-memory cache if anything goes wrong
-	gameCopy := session.Game.Copy()
-	err = session.Game.Undo()
+	if checkpoint != nil {
+		session.Game.AddCheckpoint(fromTypeCheckpoint(*checkpoint))
+	} else {
+		g, err := s.storage.GetOriginalGame(ctx, types.GetOriginalGamePayload{GameID: session.Game.ID})
+		if err != nil {
+			s.l.Error().
+				Err(err).
+				Interface("game", g).
+				Msg("Failed to get original game before undo")
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		g2, err := tallylogic.RestoreGame(&g)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		session.Game.ReplaceBasedOn(g2)
+	}
+	err = session.Game.UndoSteps(steps)
 	if err != nil {
 		s.l.Error().
 			Err(err).
-			Interface("game", g).
+			Int("steps", steps).
 			Msg("Failed to undo board")
 
+		session.Game = gameCopy
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	response := &model.UndoResponse{
-		Board: toModalBoard(&session.Game),
-		Moves: int64(session.Game.Moves()),
-		Score: session.Score(),
+		Board:      toModalBoard(&session.Game),
+		Moves:      int64(session.Game.Moves()),
+		Score:      session.Score(),
+		SwapsLeft:  uint32(session.Game.SwapsLeft()),
+		PowersLeft: uint32(session.Game.PowersLeft()),
+		CanUndo:    session.Game.CanUndo(),
+		CanRedo:    session.Game.CanRedo(),
 	}
 	seed, state := session.Game.Seed()
+	depth, checkpoints, err := toTypeCheckpoints(&session.Game)
+	if err != nil {
+		session.Game = gameCopy
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 	payload := types.UpdateGamePayload{
-		GameID:    session.Game.ID,
-		Moves:     session.Game.Moves(),
-		Score:     uint64(gameCopy.Score()),
-		State:     state,
-		Seed:      seed,
-		Cells:     session.Cells(),
-		History:   session.Game.History.Bytes(),
-		PlayState: types.PlayStateCurrent,
+		GameID:      session.Game.ID,
+		Moves:       session.Game.Moves(),
+		Score:       uint64(session.Game.Score()),
+		State:       state,
+		Seed:        seed,
+		Cells:       session.Cells(),
+		History:     session.Game.History.Bytes(),
+		PlayState:   types.PlayStateCurrent,
+		Depth:       depth,
+		Checkpoints: checkpoints,
 	}
 	err = s.storage.UpdateGame(ctx, payload)
 	if err != nil {
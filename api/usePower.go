@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func (s *TallyServer) UsePower(
+	ctx context.Context,
+	req *connect.Request[model.UsePowerRequest],
+) (*connect.Response[model.UsePowerResponse], error) {
+	session := ContextGetUserState(ctx)
+	// We copy the game, to rollback the in-memory cache if anything goes wrong
+	gameCopy := session.Game.Copy()
+	err := session.Game.UsePower(toGamePower(req.Msg.Power), int(req.Msg.Index))
+	if err != nil {
+		code := connect.CodeInvalidArgument
+		switch {
+		case errors.Is(err, tallylogic.ErrPowerNotAllowed),
+			errors.Is(err, tallylogic.ErrPowerNoneLeft),
+			errors.Is(err, tallylogic.ErrNoMovesLeft):
+			code = connect.CodeFailedPrecondition
+		}
+		cerr := createError(code, fmt.Errorf("cannot use the super-power: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	seed, state := session.Game.Seed()
+	depth, checkpoints, err := toTypeCheckpoints(&session.Game)
+	if err != nil {
+		session.Game = gameCopy
+		return nil, fmt.Errorf("internal failure while creating checkpoints during super-power: %w", err)
+	}
+	payload := types.UpdateGamePayload{
+		GameID:      session.Game.ID,
+		Moves:       session.Game.Moves(),
+		Score:       uint64(session.Game.Score()),
+		State:       state,
+		Seed:        seed,
+		Cells:       session.Game.Cells(),
+		History:     session.Game.History.Bytes(),
+		PlayState:   types.PlayStateCurrent,
+		Depth:       depth,
+		Checkpoints: checkpoints,
+	}
+	didWin := session.Game.IsGameWon()
+	didLose := session.Game.IsGameOver()
+	if didWin {
+		payload.PlayState = types.PlayStateWon
+	} else if didLose {
+		payload.PlayState = types.PlayStateLost
+	}
+	err = s.storage.UpdateGame(ctx, payload)
+	if err != nil {
+		s.l.Error().
+			Err(err).
+			Interface("payload", payload).
+			Msg("failed to save the board to storage during super-power-operation")
+		// rollback the game in memory
+		session.Game = gameCopy
+		return nil, fmt.Errorf("internal failure while saving the board during super-power: %w", err)
+	}
+	response := &model.UsePowerResponse{
+		Board:      toModalBoard(&session.Game),
+		Score:      session.Game.Score(),
+		Moves:      int64(session.Game.Moves()),
+		DidWin:     didWin,
+		DidLose:    didLose,
+		PowersLeft: uint32(session.Game.PowersLeft()),
+	}
+	return connect.NewResponse(response), nil
+}
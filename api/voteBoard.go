@@ -6,43 +6,41 @@ import (
 
 	"github.com/bufbuild/connect-go"
 	model "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/types"
 )
 
 func (s *TallyServer) VoteBoard(
 	ctx context.Context,
 	req *connect.Request[model.VoteBoardRequest],
 ) (*connect.Response[model.VoteBoardResponse], error) {
-	cerr := createError(connect.CodeUnimplemented, fmt.Errorf("not implemented"))
-	err := cerr.ToConnectError()
-	return nil, err
-	// session := ContextGetUserState(ctx)
-	// boardId := session.Game.BoardID()
-	// if boardId == "" {
-	// 	cerr := createError(connect.CodeInvalidArgument, fmt.Errorf("Cannot vote for this board"))
-	// 	return nil, cerr.ToConnectError()
-	// }
-	// if req.Msg.FunVote == model.Vote_VOTE_UNSPECIFIED {
-	// 	cerr := createError(connect.CodeInvalidArgument, fmt.Errorf("FunVote is required"))
-	// 	return nil, cerr.ToConnectError()
-	// }
-	// if req.Msg.UserName != "" && req.Msg.UserName != session.UserName {
-	// 	if len(req.Msg.UserName) > 200 {
-	// 		cerr := createError(connect.CodeInvalidArgument, fmt.Errorf("userName is too long"))
-	// 		return nil, cerr.ToConnectError()
-	// 	}
-	// 	session.UserName = req.Msg.UserName
-	// 	Store.SetUserState(session)
-	// }
-	// vote, err := s.storage.VoteForBoard(boardId, session.SessionID, req.Msg.UserName, int(req.Msg.FunVote))
-	// if err != nil {
-	// 	cerr := createError(connect.CodeInvalidArgument, fmt.Errorf("failure during vote: %w", err))
-	// 	return nil, cerr.ToConnectError()
-	// }
-	// session.SelfVotes[vote.ID] = vote.FunVote
-	// response := &model.VoteBoardResponse{
-	// 	Id:      vote.ID,
-	// 	FunVote: model.Vote(vote.FunVote),
-	// }
-	// res := connect.NewResponse(response)
-	// return res, nil
+	session := ContextGetUserState(ctx)
+	if session.Game.ID == "" {
+		cerr := createError(connect.CodeInvalidArgument, fmt.Errorf("Cannot vote for this board"))
+		return nil, cerr.ToConnectError()
+	}
+	if req.Msg.FunVote == model.Vote_VOTE_UNSPECIFIED {
+		cerr := createError(connect.CodeInvalidArgument, fmt.Errorf("FunVote is required"))
+		return nil, cerr.ToConnectError()
+	}
+	payload := types.VoteForBoardPayload{
+		UserID:  session.UserID,
+		GameID:  session.Game.ID,
+		FunVote: int(req.Msg.FunVote),
+	}
+	if err := payload.Validate(); err != nil {
+		cerr := createError(connect.CodeInvalidArgument, err)
+		return nil, cerr.ToConnectError()
+	}
+	vote, err := s.storage.VoteForBoard(ctx, payload)
+	if err != nil {
+		s.l.Error().Err(err).Interface("payload", payload).Msg("failed to issue storage.VoteForBoard in api.VoteBoard")
+		cerr := createError(connect.CodeInternal, fmt.Errorf("failure during vote: %w", err))
+		return nil, cerr.ToConnectError()
+	}
+	response := &model.VoteBoardResponse{
+		Id:      vote.ID,
+		FunVote: model.Vote(vote.FunVote),
+	}
+	res := connect.NewResponse(response)
+	return res, nil
 }
@@ -251,6 +251,188 @@ func (HintPreference) EnumDescriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{3}
 }
 
+// The methods a selection of cells can be evaluated with, which are enabled by the rules.
+// All methods are evaluated from left to right, with the last cell as the result.
+type EvalMethod int32
+
+const (
+	EvalMethod_EVAL_METHOD_UNSPECIFIED EvalMethod = 0
+	EvalMethod_EVAL_METHOD_SUM         EvalMethod = 1
+	EvalMethod_EVAL_METHOD_PRODUCT     EvalMethod = 2
+	// The first cell, minus the rest of the cells
+	EvalMethod_EVAL_METHOD_SUBTRACTION EvalMethod = 3
+	// The first cell, divided exactly by each of the rest of the cells
+	EvalMethod_EVAL_METHOD_DIVISION EvalMethod = 4
+	// A chain where each step may use any of the enabled operators, without precedence
+	EvalMethod_EVAL_METHOD_MIXED EvalMethod = 5
+)
+
+// Enum value maps for EvalMethod.
+var (
+	EvalMethod_name = map[int32]string{
+		0: "EVAL_METHOD_UNSPECIFIED",
+		1: "EVAL_METHOD_SUM",
+		2: "EVAL_METHOD_PRODUCT",
+		3: "EVAL_METHOD_SUBTRACTION",
+		4: "EVAL_METHOD_DIVISION",
+		5: "EVAL_METHOD_MIXED",
+	}
+	EvalMethod_value = map[string]int32{
+		"EVAL_METHOD_UNSPECIFIED": 0,
+		"EVAL_METHOD_SUM":         1,
+		"EVAL_METHOD_PRODUCT":     2,
+		"EVAL_METHOD_SUBTRACTION": 3,
+		"EVAL_METHOD_DIVISION":    4,
+		"EVAL_METHOD_MIXED":       5,
+	}
+)
+
+func (x EvalMethod) Enum() *EvalMethod {
+	p := new(EvalMethod)
+	*p = x
+	return p
+}
+
+func (x EvalMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvalMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tally_v1_board_proto_enumTypes[4].Descriptor()
+}
+
+func (EvalMethod) Type() protoreflect.EnumType {
+	return &file_proto_tally_v1_board_proto_enumTypes[4]
+}
+
+func (x EvalMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvalMethod.Descriptor instead.
+func (EvalMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{4}
+}
+
+type Topology int32
+
+const (
+	// Same as TOPOLOGY_ORTHOGONAL
+	Topology_TOPOLOGY_UNSPECIFIED Topology = 0
+	// Cells are neighbours with the cells above, below and to the sides of them
+	Topology_TOPOLOGY_ORTHOGONAL Topology = 1
+	// Like TOPOLOGY_ORTHOGONAL, and also with the diagonal cells
+	Topology_TOPOLOGY_EIGHT_WAY Topology = 2
+	// Like TOPOLOGY_ORTHOGONAL, but the edges wrap around to the opposite edge.
+	// Swipes do not wrap around.
+	Topology_TOPOLOGY_TOROIDAL Topology = 3
+)
+
+// Enum value maps for Topology.
+var (
+	Topology_name = map[int32]string{
+		0: "TOPOLOGY_UNSPECIFIED",
+		1: "TOPOLOGY_ORTHOGONAL",
+		2: "TOPOLOGY_EIGHT_WAY",
+		3: "TOPOLOGY_TOROIDAL",
+	}
+	Topology_value = map[string]int32{
+		"TOPOLOGY_UNSPECIFIED": 0,
+		"TOPOLOGY_ORTHOGONAL":  1,
+		"TOPOLOGY_EIGHT_WAY":   2,
+		"TOPOLOGY_TOROIDAL":    3,
+	}
+)
+
+func (x Topology) Enum() *Topology {
+	p := new(Topology)
+	*p = x
+	return p
+}
+
+func (x Topology) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Topology) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tally_v1_board_proto_enumTypes[5].Descriptor()
+}
+
+func (Topology) Type() protoreflect.EnumType {
+	return &file_proto_tally_v1_board_proto_enumTypes[5]
+}
+
+func (x Topology) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Topology.Descriptor instead.
+func (Topology) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{5}
+}
+
+// Super-powers change the board in ways that the other moves cannot.
+// Using one counts as a move, and the number of uses is limited by the rules.
+type Power int32
+
+const (
+	Power_POWER_UNSPECIFIED Power = 0
+	// Removes the cell from the board
+	Power_POWER_REMOVE_CELL Power = 1
+	// Doubles the value of the cell
+	Power_POWER_DOUBLE_CELL Power = 2
+	// Splits the cell into its prime factors. The cell keeps the largest
+	// factor, and the others are placed in the empty cells closest to it.
+	Power_POWER_SPLIT_CELL Power = 3
+	// Shuffles all the cells of the board
+	Power_POWER_SHUFFLE Power = 4
+)
+
+// Enum value maps for Power.
+var (
+	Power_name = map[int32]string{
+		0: "POWER_UNSPECIFIED",
+		1: "POWER_REMOVE_CELL",
+		2: "POWER_DOUBLE_CELL",
+		3: "POWER_SPLIT_CELL",
+		4: "POWER_SHUFFLE",
+	}
+	Power_value = map[string]int32{
+		"POWER_UNSPECIFIED": 0,
+		"POWER_REMOVE_CELL": 1,
+		"POWER_DOUBLE_CELL": 2,
+		"POWER_SPLIT_CELL":  3,
+		"POWER_SHUFFLE":     4,
+	}
+)
+
+func (x Power) Enum() *Power {
+	p := new(Power)
+	*p = x
+	return p
+}
+
+func (x Power) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Power) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tally_v1_board_proto_enumTypes[6].Descriptor()
+}
+
+func (Power) Type() protoreflect.EnumType {
+	return &file_proto_tally_v1_board_proto_enumTypes[6]
+}
+
+func (x Power) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Power.Descriptor instead.
+func (Power) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{6}
+}
+
 type Vote int32
 
 const (
@@ -293,11 +475,11 @@ func (x Vote) String() string {
 }
 
 func (Vote) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_tally_v1_board_proto_enumTypes[4].Descriptor()
+	return file_proto_tally_v1_board_proto_enumTypes[7].Descriptor()
 }
 
 func (Vote) Type() protoreflect.EnumType {
-	return &file_proto_tally_v1_board_proto_enumTypes[4]
+	return &file_proto_tally_v1_board_proto_enumTypes[7]
 }
 
 func (x Vote) Number() protoreflect.EnumNumber {
@@ -306,7 +488,64 @@ func (x Vote) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Vote.Descriptor instead.
 func (Vote) EnumDescriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{4}
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{7}
+}
+
+type PlayState int32
+
+const (
+	PlayState_PLAY_STATE_UNSPECIFIED PlayState = 0
+	// The game can still be played
+	PlayState_PLAY_STATE_CURRENT PlayState = 1
+	PlayState_PLAY_STATE_WON     PlayState = 2
+	PlayState_PLAY_STATE_LOST    PlayState = 3
+	// The user left the game for a different one. It can be resumed later.
+	PlayState_PLAY_STATE_ABANDONED PlayState = 4
+)
+
+// Enum value maps for PlayState.
+var (
+	PlayState_name = map[int32]string{
+		0: "PLAY_STATE_UNSPECIFIED",
+		1: "PLAY_STATE_CURRENT",
+		2: "PLAY_STATE_WON",
+		3: "PLAY_STATE_LOST",
+		4: "PLAY_STATE_ABANDONED",
+	}
+	PlayState_value = map[string]int32{
+		"PLAY_STATE_UNSPECIFIED": 0,
+		"PLAY_STATE_CURRENT":     1,
+		"PLAY_STATE_WON":         2,
+		"PLAY_STATE_LOST":        3,
+		"PLAY_STATE_ABANDONED":   4,
+	}
+)
+
+func (x PlayState) Enum() *PlayState {
+	p := new(PlayState)
+	*p = x
+	return p
+}
+
+func (x PlayState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tally_v1_board_proto_enumTypes[8].Descriptor()
+}
+
+func (PlayState) Type() protoreflect.EnumType {
+	return &file_proto_tally_v1_board_proto_enumTypes[8]
+}
+
+func (x PlayState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayState.Descriptor instead.
+func (PlayState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{8}
 }
 
 type GeneratorAlgorithm int32
@@ -346,11 +585,11 @@ func (x GeneratorAlgorithm) String() string {
 }
 
 func (GeneratorAlgorithm) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_tally_v1_board_proto_enumTypes[5].Descriptor()
+	return file_proto_tally_v1_board_proto_enumTypes[9].Descriptor()
 }
 
 func (GeneratorAlgorithm) Type() protoreflect.EnumType {
-	return &file_proto_tally_v1_board_proto_enumTypes[5]
+	return &file_proto_tally_v1_board_proto_enumTypes[9]
 }
 
 func (x GeneratorAlgorithm) Number() protoreflect.EnumNumber {
@@ -359,7 +598,7 @@ func (x GeneratorAlgorithm) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GeneratorAlgorithm.Descriptor instead.
 func (GeneratorAlgorithm) EnumDescriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{5}
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{9}
 }
 
 type Rating int32
@@ -410,11 +649,11 @@ func (x Rating) String() string {
 }
 
 func (Rating) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_tally_v1_board_proto_enumTypes[6].Descriptor()
+	return file_proto_tally_v1_board_proto_enumTypes[10].Descriptor()
 }
 
 func (Rating) Type() protoreflect.EnumType {
-	return &file_proto_tally_v1_board_proto_enumTypes[6]
+	return &file_proto_tally_v1_board_proto_enumTypes[10]
 }
 
 func (x Rating) Number() protoreflect.EnumNumber {
@@ -423,7 +662,7 @@ func (x Rating) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Rating.Descriptor instead.
 func (Rating) EnumDescriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{6}
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{10}
 }
 
 // Cell is single value on the board. The value can then be calculated with base
@@ -437,6 +676,9 @@ type Cell struct {
 	Base int64 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Pow-value
 	Twopow int64 `protobuf:"varint,2,opt,name=twopow,proto3" json:"twopow,omitempty"` // string id = 3;
+	// Blocked cells are walls or holes in the board. They cannot hold a value,
+	// and swipes and paths cannot pass through them.
+	Blocked bool `protobuf:"varint,4,opt,name=blocked,proto3" json:"blocked,omitempty"`
 }
 
 func (x *Cell) Reset() {
@@ -485,6 +727,13 @@ func (x *Cell) GetTwopow() int64 {
 	return 0
 }
 
+func (x *Cell) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
 type InternalDataHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -611,20 +860,23 @@ func (x *InternalDataGame) GetCells() []int64 {
 	return nil
 }
 
-type Board struct {
+// The contents of a share-code, see storage.EncodeShareCode
+type InternalShareCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cells   []*Cell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
-	Columns uint32  `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`
-	Rows    uint32  `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
-	Name    string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Id      string  `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Rules       *InternalRules `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	// The game as it was at the start
+	Start *InternalDataGame `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	// The CompactHistory of the game, if it was included
+	History []byte `protobuf:"bytes,5,opt,name=history,proto3" json:"history,omitempty"`
 }
 
-func (x *Board) Reset() {
-	*x = Board{}
+func (x *InternalShareCode) Reset() {
+	*x = InternalShareCode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,13 +884,13 @@ func (x *Board) Reset() {
 	}
 }
 
-func (x *Board) String() string {
+func (x *InternalShareCode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Board) ProtoMessage() {}
+func (*InternalShareCode) ProtoMessage() {}
 
-func (x *Board) ProtoReflect() protoreflect.Message {
+func (x *InternalShareCode) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -650,61 +902,75 @@ func (x *Board) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Board.ProtoReflect.Descriptor instead.
-func (*Board) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalShareCode.ProtoReflect.Descriptor instead.
+func (*InternalShareCode) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Board) GetCells() []*Cell {
+func (x *InternalShareCode) GetName() string {
 	if x != nil {
-		return x.Cells
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Board) GetColumns() uint32 {
+func (x *InternalShareCode) GetDescription() string {
 	if x != nil {
-		return x.Columns
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *Board) GetRows() uint32 {
+func (x *InternalShareCode) GetRules() *InternalRules {
 	if x != nil {
-		return x.Rows
+		return x.Rules
 	}
-	return 0
+	return nil
 }
 
-func (x *Board) GetName() string {
+func (x *InternalShareCode) GetStart() *InternalDataGame {
 	if x != nil {
-		return x.Name
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-func (x *Board) GetId() string {
+func (x *InternalShareCode) GetHistory() []byte {
 	if x != nil {
-		return x.Id
+		return x.History
 	}
-	return ""
+	return nil
 }
 
-type Instruction struct {
+// The rules of a game. The mode and topology are stored like in the database.
+type InternalRules struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to InstructionOneof:
-	//
-	//	*Instruction_Swipe
-	//	*Instruction_Combine
-	//	*Instruction_Bytes
-	InstructionOneof isInstruction_InstructionOneof `protobuf_oneof:"instruction_oneof"`
-}
-
-func (x *Instruction) Reset() {
-	*x = Instruction{}
+	Mode               int64  `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Description        string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Columns            uint32 `protobuf:"varint,3,opt,name=columns,proto3" json:"columns,omitempty"`
+	Rows               uint32 `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	TargetCellValue    uint64 `protobuf:"varint,5,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
+	TargetScore        uint64 `protobuf:"varint,6,opt,name=target_score,json=targetScore,proto3" json:"target_score,omitempty"`
+	MaxMoves           uint64 `protobuf:"varint,7,opt,name=max_moves,json=maxMoves,proto3" json:"max_moves,omitempty"`
+	RecreateOnSwipe    bool   `protobuf:"varint,8,opt,name=recreate_on_swipe,json=recreateOnSwipe,proto3" json:"recreate_on_swipe,omitempty"`
+	NoReswipe          bool   `protobuf:"varint,9,opt,name=no_reswipe,json=noReswipe,proto3" json:"no_reswipe,omitempty"`
+	NoMultiply         bool   `protobuf:"varint,10,opt,name=no_multiply,json=noMultiply,proto3" json:"no_multiply,omitempty"`
+	NoAddition         bool   `protobuf:"varint,11,opt,name=no_addition,json=noAddition,proto3" json:"no_addition,omitempty"`
+	NoSuperPowers      bool   `protobuf:"varint,12,opt,name=no_super_powers,json=noSuperPowers,proto3" json:"no_super_powers,omitempty"`
+	MaxSwaps           uint64 `protobuf:"varint,13,opt,name=max_swaps,json=maxSwaps,proto3" json:"max_swaps,omitempty"`
+	SwapNeighboursOnly bool   `protobuf:"varint,14,opt,name=swap_neighbours_only,json=swapNeighboursOnly,proto3" json:"swap_neighbours_only,omitempty"`
+	MaxPowers          uint64 `protobuf:"varint,15,opt,name=max_powers,json=maxPowers,proto3" json:"max_powers,omitempty"`
+	WithSubtraction    bool   `protobuf:"varint,16,opt,name=with_subtraction,json=withSubtraction,proto3" json:"with_subtraction,omitempty"`
+	WithDivision       bool   `protobuf:"varint,17,opt,name=with_division,json=withDivision,proto3" json:"with_division,omitempty"`
+	WithMixedOperators bool   `protobuf:"varint,18,opt,name=with_mixed_operators,json=withMixedOperators,proto3" json:"with_mixed_operators,omitempty"`
+	Topology           int64  `protobuf:"varint,19,opt,name=topology,proto3" json:"topology,omitempty"`
+}
+
+func (x *InternalRules) Reset() {
+	*x = InternalRules{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -712,13 +978,13 @@ func (x *Instruction) Reset() {
 	}
 }
 
-func (x *Instruction) String() string {
+func (x *InternalRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Instruction) ProtoMessage() {}
+func (*InternalRules) ProtoMessage() {}
 
-func (x *Instruction) ProtoReflect() protoreflect.Message {
+func (x *InternalRules) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -730,86 +996,176 @@ func (x *Instruction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Instruction.ProtoReflect.Descriptor instead.
-func (*Instruction) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalRules.ProtoReflect.Descriptor instead.
+func (*InternalRules) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{4}
 }
 
-func (m *Instruction) GetInstructionOneof() isInstruction_InstructionOneof {
-	if m != nil {
-		return m.InstructionOneof
+func (x *InternalRules) GetMode() int64 {
+	if x != nil {
+		return x.Mode
 	}
-	return nil
+	return 0
 }
 
-func (x *Instruction) GetSwipe() SwipeDirection {
-	if x, ok := x.GetInstructionOneof().(*Instruction_Swipe); ok {
-		return x.Swipe
+func (x *InternalRules) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
-	return SwipeDirection_SWIPE_DIRECTION_UNSPECIFIED
+	return ""
 }
 
-func (x *Instruction) GetCombine() *Indexes {
-	if x, ok := x.GetInstructionOneof().(*Instruction_Combine); ok {
-		return x.Combine
+func (x *InternalRules) GetColumns() uint32 {
+	if x != nil {
+		return x.Columns
 	}
-	return nil
+	return 0
 }
 
-func (x *Instruction) GetBytes() []byte {
-	if x, ok := x.GetInstructionOneof().(*Instruction_Bytes); ok {
-		return x.Bytes
+func (x *InternalRules) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
 	}
-	return nil
+	return 0
 }
 
-type isInstruction_InstructionOneof interface {
-	isInstruction_InstructionOneof()
+func (x *InternalRules) GetTargetCellValue() uint64 {
+	if x != nil {
+		return x.TargetCellValue
+	}
+	return 0
 }
 
-type Instruction_Swipe struct {
-	Swipe SwipeDirection `protobuf:"varint,1,opt,name=swipe,proto3,enum=tally.v1.SwipeDirection,oneof"`
+func (x *InternalRules) GetTargetScore() uint64 {
+	if x != nil {
+		return x.TargetScore
+	}
+	return 0
 }
 
-type Instruction_Combine struct {
-	Combine *Indexes `protobuf:"bytes,2,opt,name=combine,proto3,oneof"`
+func (x *InternalRules) GetMaxMoves() uint64 {
+	if x != nil {
+		return x.MaxMoves
+	}
+	return 0
 }
 
-type Instruction_Bytes struct {
-	Bytes []byte `protobuf:"bytes,3,opt,name=bytes,proto3,oneof"`
+func (x *InternalRules) GetRecreateOnSwipe() bool {
+	if x != nil {
+		return x.RecreateOnSwipe
+	}
+	return false
 }
 
-func (*Instruction_Swipe) isInstruction_InstructionOneof() {}
-
-func (*Instruction_Combine) isInstruction_InstructionOneof() {}
-
-func (*Instruction_Bytes) isInstruction_InstructionOneof() {}
-
-type GetHintRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *InternalRules) GetNoReswipe() bool {
+	if x != nil {
+		return x.NoReswipe
+	}
+	return false
+}
 
-	HintPreference HintPreference `protobuf:"varint,1,opt,name=hint_preference,json=hintPreference,proto3,enum=tally.v1.HintPreference" json:"hint_preference,omitempty"`
-	MaxLength      uint32         `protobuf:"varint,2,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+func (x *InternalRules) GetNoMultiply() bool {
+	if x != nil {
+		return x.NoMultiply
+	}
+	return false
 }
 
-func (x *GetHintRequest) Reset() {
-	*x = GetHintRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InternalRules) GetNoAddition() bool {
+	if x != nil {
+		return x.NoAddition
 	}
+	return false
 }
 
-func (x *GetHintRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InternalRules) GetNoSuperPowers() bool {
+	if x != nil {
+		return x.NoSuperPowers
+	}
+	return false
 }
 
-func (*GetHintRequest) ProtoMessage() {}
+func (x *InternalRules) GetMaxSwaps() uint64 {
+	if x != nil {
+		return x.MaxSwaps
+	}
+	return 0
+}
 
-func (x *GetHintRequest) ProtoReflect() protoreflect.Message {
+func (x *InternalRules) GetSwapNeighboursOnly() bool {
+	if x != nil {
+		return x.SwapNeighboursOnly
+	}
+	return false
+}
+
+func (x *InternalRules) GetMaxPowers() uint64 {
+	if x != nil {
+		return x.MaxPowers
+	}
+	return 0
+}
+
+func (x *InternalRules) GetWithSubtraction() bool {
+	if x != nil {
+		return x.WithSubtraction
+	}
+	return false
+}
+
+func (x *InternalRules) GetWithDivision() bool {
+	if x != nil {
+		return x.WithDivision
+	}
+	return false
+}
+
+func (x *InternalRules) GetWithMixedOperators() bool {
+	if x != nil {
+		return x.WithMixedOperators
+	}
+	return false
+}
+
+func (x *InternalRules) GetTopology() int64 {
+	if x != nil {
+		return x.Topology
+	}
+	return 0
+}
+
+type Board struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The cells of the board, row by row. Irregular shapes, like L-shaped boards
+	// or boards with holes, are made with blocked cells.
+	Cells   []*Cell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	Columns uint32  `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`
+	Rows    uint32  `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	Name    string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Id      string  `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Decides which cells are neighbours, and can be combined in a path
+	Topology Topology `protobuf:"varint,6,opt,name=topology,proto3,enum=tally.v1.Topology" json:"topology,omitempty"`
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,33 +1177,70 @@ func (x *GetHintRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHintRequest.ProtoReflect.Descriptor instead.
-func (*GetHintRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetHintRequest) GetHintPreference() HintPreference {
+func (x *Board) GetCells() []*Cell {
 	if x != nil {
-		return x.HintPreference
+		return x.Cells
 	}
-	return HintPreference_HINT_PREFERENCE_UNSPECIFIED
+	return nil
 }
 
-func (x *GetHintRequest) GetMaxLength() uint32 {
+func (x *Board) GetColumns() uint32 {
 	if x != nil {
-		return x.MaxLength
+		return x.Columns
 	}
 	return 0
 }
 
-type UndoRequest struct {
+func (x *Board) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Board) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Board) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Board) GetTopology() Topology {
+	if x != nil {
+		return x.Topology
+	}
+	return Topology_TOPOLOGY_UNSPECIFIED
+}
+
+type Instruction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to InstructionOneof:
+	//
+	//	*Instruction_Swipe
+	//	*Instruction_Combine
+	//	*Instruction_Bytes
+	//	*Instruction_Swap
+	//	*Instruction_Power
+	InstructionOneof isInstruction_InstructionOneof `protobuf_oneof:"instruction_oneof"`
 }
 
-func (x *UndoRequest) Reset() {
-	*x = UndoRequest{}
+func (x *Instruction) Reset() {
+	*x = Instruction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,13 +1248,13 @@ func (x *UndoRequest) Reset() {
 	}
 }
 
-func (x *UndoRequest) String() string {
+func (x *Instruction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UndoRequest) ProtoMessage() {}
+func (*Instruction) ProtoMessage() {}
 
-func (x *UndoRequest) ProtoReflect() protoreflect.Message {
+func (x *Instruction) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -873,23 +1266,100 @@ func (x *UndoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UndoRequest.ProtoReflect.Descriptor instead.
-func (*UndoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Instruction.ProtoReflect.Descriptor instead.
+func (*Instruction) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{6}
 }
 
-type UndoResponse struct {
+func (m *Instruction) GetInstructionOneof() isInstruction_InstructionOneof {
+	if m != nil {
+		return m.InstructionOneof
+	}
+	return nil
+}
+
+func (x *Instruction) GetSwipe() SwipeDirection {
+	if x, ok := x.GetInstructionOneof().(*Instruction_Swipe); ok {
+		return x.Swipe
+	}
+	return SwipeDirection_SWIPE_DIRECTION_UNSPECIFIED
+}
+
+func (x *Instruction) GetCombine() *Indexes {
+	if x, ok := x.GetInstructionOneof().(*Instruction_Combine); ok {
+		return x.Combine
+	}
+	return nil
+}
+
+func (x *Instruction) GetBytes() []byte {
+	if x, ok := x.GetInstructionOneof().(*Instruction_Bytes); ok {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Instruction) GetSwap() *Indexes {
+	if x, ok := x.GetInstructionOneof().(*Instruction_Swap); ok {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *Instruction) GetPower() *PowerInstruction {
+	if x, ok := x.GetInstructionOneof().(*Instruction_Power); ok {
+		return x.Power
+	}
+	return nil
+}
+
+type isInstruction_InstructionOneof interface {
+	isInstruction_InstructionOneof()
+}
+
+type Instruction_Swipe struct {
+	Swipe SwipeDirection `protobuf:"varint,1,opt,name=swipe,proto3,enum=tally.v1.SwipeDirection,oneof"`
+}
+
+type Instruction_Combine struct {
+	Combine *Indexes `protobuf:"bytes,2,opt,name=combine,proto3,oneof"`
+}
+
+type Instruction_Bytes struct {
+	Bytes []byte `protobuf:"bytes,3,opt,name=bytes,proto3,oneof"`
+}
+
+type Instruction_Swap struct {
+	// The two cells to swap
+	Swap *Indexes `protobuf:"bytes,4,opt,name=swap,proto3,oneof"`
+}
+
+type Instruction_Power struct {
+	Power *PowerInstruction `protobuf:"bytes,5,opt,name=power,proto3,oneof"`
+}
+
+func (*Instruction_Swipe) isInstruction_InstructionOneof() {}
+
+func (*Instruction_Combine) isInstruction_InstructionOneof() {}
+
+func (*Instruction_Bytes) isInstruction_InstructionOneof() {}
+
+func (*Instruction_Swap) isInstruction_InstructionOneof() {}
+
+func (*Instruction_Power) isInstruction_InstructionOneof() {}
+
+type PowerInstruction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	Score int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	Moves int64  `protobuf:"varint,4,opt,name=moves,proto3" json:"moves,omitempty"`
+	Power Power `protobuf:"varint,1,opt,name=power,proto3,enum=tally.v1.Power" json:"power,omitempty"`
+	// The cell the power was used on. Not set for powers that are not used on a cell.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *UndoResponse) Reset() {
-	*x = UndoResponse{}
+func (x *PowerInstruction) Reset() {
+	*x = PowerInstruction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -897,13 +1367,13 @@ func (x *UndoResponse) Reset() {
 	}
 }
 
-func (x *UndoResponse) String() string {
+func (x *PowerInstruction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UndoResponse) ProtoMessage() {}
+func (*PowerInstruction) ProtoMessage() {}
 
-func (x *UndoResponse) ProtoReflect() protoreflect.Message {
+func (x *PowerInstruction) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -915,42 +1385,36 @@ func (x *UndoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UndoResponse.ProtoReflect.Descriptor instead.
-func (*UndoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PowerInstruction.ProtoReflect.Descriptor instead.
+func (*PowerInstruction) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UndoResponse) GetBoard() *Board {
-	if x != nil {
-		return x.Board
-	}
-	return nil
-}
-
-func (x *UndoResponse) GetScore() int64 {
+func (x *PowerInstruction) GetPower() Power {
 	if x != nil {
-		return x.Score
+		return x.Power
 	}
-	return 0
+	return Power_POWER_UNSPECIFIED
 }
 
-func (x *UndoResponse) GetMoves() int64 {
+func (x *PowerInstruction) GetIndex() uint32 {
 	if x != nil {
-		return x.Moves
+		return x.Index
 	}
 	return 0
 }
 
-type GetHintResponse struct {
+type GetHintRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Instructions []*Instruction `protobuf:"bytes,1,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	HintPreference HintPreference `protobuf:"varint,1,opt,name=hint_preference,json=hintPreference,proto3,enum=tally.v1.HintPreference" json:"hint_preference,omitempty"`
+	MaxLength      uint32         `protobuf:"varint,2,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
 }
 
-func (x *GetHintResponse) Reset() {
-	*x = GetHintResponse{}
+func (x *GetHintRequest) Reset() {
+	*x = GetHintRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -958,13 +1422,13 @@ func (x *GetHintResponse) Reset() {
 	}
 }
 
-func (x *GetHintResponse) String() string {
+func (x *GetHintRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHintResponse) ProtoMessage() {}
+func (*GetHintRequest) ProtoMessage() {}
 
-func (x *GetHintResponse) ProtoReflect() protoreflect.Message {
+func (x *GetHintRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -976,26 +1440,36 @@ func (x *GetHintResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHintResponse.ProtoReflect.Descriptor instead.
-func (*GetHintResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHintRequest.ProtoReflect.Descriptor instead.
+func (*GetHintRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetHintResponse) GetInstructions() []*Instruction {
+func (x *GetHintRequest) GetHintPreference() HintPreference {
 	if x != nil {
-		return x.Instructions
+		return x.HintPreference
 	}
-	return nil
+	return HintPreference_HINT_PREFERENCE_UNSPECIFIED
 }
 
-type GetSessionRequest struct {
+func (x *GetHintRequest) GetMaxLength() uint32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+type UndoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The number of moves to undo. Zero is the same as one.
+	Steps uint32 `protobuf:"varint,1,opt,name=steps,proto3" json:"steps,omitempty"`
 }
 
-func (x *GetSessionRequest) Reset() {
-	*x = GetSessionRequest{}
+func (x *UndoRequest) Reset() {
+	*x = UndoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1003,13 +1477,13 @@ func (x *GetSessionRequest) Reset() {
 	}
 }
 
-func (x *GetSessionRequest) String() string {
+func (x *UndoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionRequest) ProtoMessage() {}
+func (*UndoRequest) ProtoMessage() {}
 
-func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *UndoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1021,19 +1495,34 @@ func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
-func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndoRequest.ProtoReflect.Descriptor instead.
+func (*UndoRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{9}
 }
 
-type RestartGameRequest struct {
+func (x *UndoRequest) GetSteps() uint32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+type UndoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Board      *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score      int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves      int64  `protobuf:"varint,4,opt,name=moves,proto3" json:"moves,omitempty"`
+	SwapsLeft  uint32 `protobuf:"varint,5,opt,name=swaps_left,json=swapsLeft,proto3" json:"swaps_left,omitempty"`
+	CanUndo    bool   `protobuf:"varint,6,opt,name=can_undo,json=canUndo,proto3" json:"can_undo,omitempty"`
+	CanRedo    bool   `protobuf:"varint,7,opt,name=can_redo,json=canRedo,proto3" json:"can_redo,omitempty"`
+	PowersLeft uint32 `protobuf:"varint,8,opt,name=powers_left,json=powersLeft,proto3" json:"powers_left,omitempty"`
 }
 
-func (x *RestartGameRequest) Reset() {
-	*x = RestartGameRequest{}
+func (x *UndoResponse) Reset() {
+	*x = UndoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1041,13 +1530,13 @@ func (x *RestartGameRequest) Reset() {
 	}
 }
 
-func (x *RestartGameRequest) String() string {
+func (x *UndoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestartGameRequest) ProtoMessage() {}
+func (*UndoResponse) ProtoMessage() {}
 
-func (x *RestartGameRequest) ProtoReflect() protoreflect.Message {
+func (x *UndoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1059,131 +1548,117 @@ func (x *RestartGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestartGameRequest.ProtoReflect.Descriptor instead.
-func (*RestartGameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndoResponse.ProtoReflect.Descriptor instead.
+func (*UndoResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{10}
 }
 
-type NewGameRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Mode GameMode `protobuf:"varint,1,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
-	// Types that are assignable to Variant:
-	//
-	//	*NewGameRequest_Difficulty
-	//	*NewGameRequest_LevelIndex
-	//	*NewGameRequest_Id
-	Variant isNewGameRequest_Variant `protobuf_oneof:"variant"`
-}
-
-func (x *NewGameRequest) Reset() {
-	*x = NewGameRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UndoResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
 	}
+	return nil
 }
 
-func (x *NewGameRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*NewGameRequest) ProtoMessage() {}
-
-func (x *NewGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tally_v1_board_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UndoResponse) GetScore() int64 {
+	if x != nil {
+		return x.Score
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use NewGameRequest.ProtoReflect.Descriptor instead.
-func (*NewGameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{11}
+func (x *UndoResponse) GetMoves() int64 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
 }
 
-func (x *NewGameRequest) GetMode() GameMode {
+func (x *UndoResponse) GetSwapsLeft() uint32 {
 	if x != nil {
-		return x.Mode
+		return x.SwapsLeft
 	}
-	return GameMode_GAME_MODE_UNSPECIFIED
+	return 0
 }
 
-func (m *NewGameRequest) GetVariant() isNewGameRequest_Variant {
-	if m != nil {
-		return m.Variant
+func (x *UndoResponse) GetCanUndo() bool {
+	if x != nil {
+		return x.CanUndo
 	}
-	return nil
+	return false
 }
 
-func (x *NewGameRequest) GetDifficulty() Difficulty {
-	if x, ok := x.GetVariant().(*NewGameRequest_Difficulty); ok {
-		return x.Difficulty
+func (x *UndoResponse) GetCanRedo() bool {
+	if x != nil {
+		return x.CanRedo
 	}
-	return Difficulty_DIFFICULTY_UNSPECIFIED
+	return false
 }
 
-func (x *NewGameRequest) GetLevelIndex() uint32 {
-	if x, ok := x.GetVariant().(*NewGameRequest_LevelIndex); ok {
-		return x.LevelIndex
+func (x *UndoResponse) GetPowersLeft() uint32 {
+	if x != nil {
+		return x.PowersLeft
 	}
 	return 0
 }
 
-func (x *NewGameRequest) GetId() string {
-	if x, ok := x.GetVariant().(*NewGameRequest_Id); ok {
-		return x.Id
-	}
-	return ""
+// Makes the last undone move again.
+type RedoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type isNewGameRequest_Variant interface {
-	isNewGameRequest_Variant()
+func (x *RedoRequest) Reset() {
+	*x = RedoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type NewGameRequest_Difficulty struct {
-	Difficulty Difficulty `protobuf:"varint,2,opt,name=difficulty,proto3,enum=tally.v1.Difficulty,oneof"`
+func (x *RedoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type NewGameRequest_LevelIndex struct {
-	LevelIndex uint32 `protobuf:"varint,3,opt,name=level_index,json=levelIndex,proto3,oneof"`
-}
+func (*RedoRequest) ProtoMessage() {}
 
-type NewGameRequest_Id struct {
-	Id string `protobuf:"bytes,4,opt,name=id,proto3,oneof"`
+func (x *RedoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*NewGameRequest_Difficulty) isNewGameRequest_Variant() {}
-
-func (*NewGameRequest_LevelIndex) isNewGameRequest_Variant() {}
-
-func (*NewGameRequest_Id) isNewGameRequest_Variant() {}
+// Deprecated: Use RedoRequest.ProtoReflect.Descriptor instead.
+func (*RedoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{11}
+}
 
-type NewGameFromTemplateRequest struct {
+type RedoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IdealMoves      uint32  `protobuf:"varint,1,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
-	IdealScore      uint32  `protobuf:"varint,2,opt,name=ideal_score,json=idealScore,proto3" json:"ideal_score,omitempty"`
-	TargetCellValue uint64  `protobuf:"varint,3,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
-	Columns         uint32  `protobuf:"varint,4,opt,name=columns,proto3" json:"columns,omitempty"`
-	Rows            uint32  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
-	Name            string  `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string  `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Cells           []*Cell `protobuf:"bytes,8,rep,name=cells,proto3" json:"cells,omitempty"`
+	Board      *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score      int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves      int64  `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	DidWin     bool   `protobuf:"varint,4,opt,name=did_win,json=didWin,proto3" json:"did_win,omitempty"`
+	DidLose    bool   `protobuf:"varint,5,opt,name=did_lose,json=didLose,proto3" json:"did_lose,omitempty"`
+	SwapsLeft  uint32 `protobuf:"varint,6,opt,name=swaps_left,json=swapsLeft,proto3" json:"swaps_left,omitempty"`
+	CanUndo    bool   `protobuf:"varint,7,opt,name=can_undo,json=canUndo,proto3" json:"can_undo,omitempty"`
+	CanRedo    bool   `protobuf:"varint,8,opt,name=can_redo,json=canRedo,proto3" json:"can_redo,omitempty"`
+	PowersLeft uint32 `protobuf:"varint,9,opt,name=powers_left,json=powersLeft,proto3" json:"powers_left,omitempty"`
 }
 
-func (x *NewGameFromTemplateRequest) Reset() {
-	*x = NewGameFromTemplateRequest{}
+func (x *RedoResponse) Reset() {
+	*x = RedoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1191,13 +1666,13 @@ func (x *NewGameFromTemplateRequest) Reset() {
 	}
 }
 
-func (x *NewGameFromTemplateRequest) String() string {
+func (x *RedoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewGameFromTemplateRequest) ProtoMessage() {}
+func (*RedoResponse) ProtoMessage() {}
 
-func (x *NewGameFromTemplateRequest) ProtoReflect() protoreflect.Message {
+func (x *RedoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,79 +1684,84 @@ func (x *NewGameFromTemplateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewGameFromTemplateRequest.ProtoReflect.Descriptor instead.
-func (*NewGameFromTemplateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RedoResponse.ProtoReflect.Descriptor instead.
+func (*RedoResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *NewGameFromTemplateRequest) GetIdealMoves() uint32 {
+func (x *RedoResponse) GetBoard() *Board {
 	if x != nil {
-		return x.IdealMoves
+		return x.Board
 	}
-	return 0
+	return nil
 }
 
-func (x *NewGameFromTemplateRequest) GetIdealScore() uint32 {
+func (x *RedoResponse) GetScore() int64 {
 	if x != nil {
-		return x.IdealScore
+		return x.Score
 	}
 	return 0
 }
 
-func (x *NewGameFromTemplateRequest) GetTargetCellValue() uint64 {
+func (x *RedoResponse) GetMoves() int64 {
 	if x != nil {
-		return x.TargetCellValue
+		return x.Moves
 	}
 	return 0
 }
 
-func (x *NewGameFromTemplateRequest) GetColumns() uint32 {
+func (x *RedoResponse) GetDidWin() bool {
 	if x != nil {
-		return x.Columns
+		return x.DidWin
 	}
-	return 0
+	return false
 }
 
-func (x *NewGameFromTemplateRequest) GetRows() uint32 {
+func (x *RedoResponse) GetDidLose() bool {
 	if x != nil {
-		return x.Rows
+		return x.DidLose
+	}
+	return false
+}
+
+func (x *RedoResponse) GetSwapsLeft() uint32 {
+	if x != nil {
+		return x.SwapsLeft
 	}
 	return 0
 }
 
-func (x *NewGameFromTemplateRequest) GetName() string {
+func (x *RedoResponse) GetCanUndo() bool {
 	if x != nil {
-		return x.Name
+		return x.CanUndo
 	}
-	return ""
+	return false
 }
 
-func (x *NewGameFromTemplateRequest) GetDescription() string {
+func (x *RedoResponse) GetCanRedo() bool {
 	if x != nil {
-		return x.Description
+		return x.CanRedo
 	}
-	return ""
+	return false
 }
 
-func (x *NewGameFromTemplateRequest) GetCells() []*Cell {
+func (x *RedoResponse) GetPowersLeft() uint32 {
 	if x != nil {
-		return x.Cells
+		return x.PowersLeft
 	}
-	return nil
+	return 0
 }
 
-type RestartGameResponse struct {
+type GetHintResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	Score int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	Moves int64  `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	Instructions []*Instruction `protobuf:"bytes,1,rep,name=instructions,proto3" json:"instructions,omitempty"`
 }
 
-func (x *RestartGameResponse) Reset() {
-	*x = RestartGameResponse{}
+func (x *GetHintResponse) Reset() {
+	*x = GetHintResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1289,13 +1769,13 @@ func (x *RestartGameResponse) Reset() {
 	}
 }
 
-func (x *RestartGameResponse) String() string {
+func (x *GetHintResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestartGameResponse) ProtoMessage() {}
+func (*GetHintResponse) ProtoMessage() {}
 
-func (x *RestartGameResponse) ProtoReflect() protoreflect.Message {
+func (x *GetHintResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1307,42 +1787,26 @@ func (x *RestartGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestartGameResponse.ProtoReflect.Descriptor instead.
-func (*RestartGameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHintResponse.ProtoReflect.Descriptor instead.
+func (*GetHintResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RestartGameResponse) GetBoard() *Board {
+func (x *GetHintResponse) GetInstructions() []*Instruction {
 	if x != nil {
-		return x.Board
+		return x.Instructions
 	}
 	return nil
 }
 
-func (x *RestartGameResponse) GetScore() int64 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *RestartGameResponse) GetMoves() int64 {
-	if x != nil {
-		return x.Moves
-	}
-	return 0
-}
-
-type GetSessionResponse struct {
+type GetSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 }
 
-func (x *GetSessionResponse) Reset() {
-	*x = GetSessionResponse{}
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1350,13 +1814,13 @@ func (x *GetSessionResponse) Reset() {
 	}
 }
 
-func (x *GetSessionResponse) String() string {
+func (x *GetSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionResponse) ProtoMessage() {}
+func (*GetSessionRequest) ProtoMessage() {}
 
-func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1368,32 +1832,19 @@ func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
-func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetSessionResponse) GetSession() *Session {
-	if x != nil {
-		return x.Session
-	}
-	return nil
-}
-
-type NewGameResponse struct {
+type RestartGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Board       *Board   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	Score       int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	Moves       int64    `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
-	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Mode        GameMode `protobuf:"varint,5,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
 }
 
-func (x *NewGameResponse) Reset() {
-	*x = NewGameResponse{}
+func (x *RestartGameRequest) Reset() {
+	*x = RestartGameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1401,13 +1852,13 @@ func (x *NewGameResponse) Reset() {
 	}
 }
 
-func (x *NewGameResponse) String() string {
+func (x *RestartGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewGameResponse) ProtoMessage() {}
+func (*RestartGameRequest) ProtoMessage() {}
 
-func (x *NewGameResponse) ProtoReflect() protoreflect.Message {
+func (x *RestartGameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1419,60 +1870,27 @@ func (x *NewGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewGameResponse.ProtoReflect.Descriptor instead.
-func (*NewGameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestartGameRequest.ProtoReflect.Descriptor instead.
+func (*RestartGameRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *NewGameResponse) GetBoard() *Board {
-	if x != nil {
-		return x.Board
-	}
-	return nil
-}
-
-func (x *NewGameResponse) GetScore() int64 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *NewGameResponse) GetMoves() int64 {
-	if x != nil {
-		return x.Moves
-	}
-	return 0
-}
-
-func (x *NewGameResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *NewGameResponse) GetMode() GameMode {
-	if x != nil {
-		return x.Mode
-	}
-	return GameMode_GAME_MODE_UNSPECIFIED
-}
-
-type NewGameFromTemplateResponse struct {
+type NewGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board       *Board   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	Score       int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	Moves       int64    `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
-	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Mode        GameMode `protobuf:"varint,5,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
+	Mode GameMode `protobuf:"varint,1,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
+	// Types that are assignable to Variant:
+	//
+	//	*NewGameRequest_Difficulty
+	//	*NewGameRequest_LevelIndex
+	//	*NewGameRequest_Id
+	Variant isNewGameRequest_Variant `protobuf_oneof:"variant"`
 }
 
-func (x *NewGameFromTemplateResponse) Reset() {
-	*x = NewGameFromTemplateResponse{}
+func (x *NewGameRequest) Reset() {
+	*x = NewGameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1480,13 +1898,13 @@ func (x *NewGameFromTemplateResponse) Reset() {
 	}
 }
 
-func (x *NewGameFromTemplateResponse) String() string {
+func (x *NewGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewGameFromTemplateResponse) ProtoMessage() {}
+func (*NewGameRequest) ProtoMessage() {}
 
-func (x *NewGameFromTemplateResponse) ProtoReflect() protoreflect.Message {
+func (x *NewGameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1498,56 +1916,85 @@ func (x *NewGameFromTemplateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewGameFromTemplateResponse.ProtoReflect.Descriptor instead.
-func (*NewGameFromTemplateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewGameRequest.ProtoReflect.Descriptor instead.
+func (*NewGameRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *NewGameFromTemplateResponse) GetBoard() *Board {
+func (x *NewGameRequest) GetMode() GameMode {
 	if x != nil {
-		return x.Board
+		return x.Mode
+	}
+	return GameMode_GAME_MODE_UNSPECIFIED
+}
+
+func (m *NewGameRequest) GetVariant() isNewGameRequest_Variant {
+	if m != nil {
+		return m.Variant
 	}
 	return nil
 }
 
-func (x *NewGameFromTemplateResponse) GetScore() int64 {
-	if x != nil {
-		return x.Score
+func (x *NewGameRequest) GetDifficulty() Difficulty {
+	if x, ok := x.GetVariant().(*NewGameRequest_Difficulty); ok {
+		return x.Difficulty
 	}
-	return 0
+	return Difficulty_DIFFICULTY_UNSPECIFIED
 }
 
-func (x *NewGameFromTemplateResponse) GetMoves() int64 {
-	if x != nil {
-		return x.Moves
+func (x *NewGameRequest) GetLevelIndex() uint32 {
+	if x, ok := x.GetVariant().(*NewGameRequest_LevelIndex); ok {
+		return x.LevelIndex
 	}
 	return 0
 }
 
-func (x *NewGameFromTemplateResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *NewGameRequest) GetId() string {
+	if x, ok := x.GetVariant().(*NewGameRequest_Id); ok {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *NewGameFromTemplateResponse) GetMode() GameMode {
-	if x != nil {
-		return x.Mode
-	}
-	return GameMode_GAME_MODE_UNSPECIFIED
+type isNewGameRequest_Variant interface {
+	isNewGameRequest_Variant()
 }
 
-type SwipeBoardRequest struct {
+type NewGameRequest_Difficulty struct {
+	Difficulty Difficulty `protobuf:"varint,2,opt,name=difficulty,proto3,enum=tally.v1.Difficulty,oneof"`
+}
+
+type NewGameRequest_LevelIndex struct {
+	LevelIndex uint32 `protobuf:"varint,3,opt,name=level_index,json=levelIndex,proto3,oneof"`
+}
+
+type NewGameRequest_Id struct {
+	Id string `protobuf:"bytes,4,opt,name=id,proto3,oneof"`
+}
+
+func (*NewGameRequest_Difficulty) isNewGameRequest_Variant() {}
+
+func (*NewGameRequest_LevelIndex) isNewGameRequest_Variant() {}
+
+func (*NewGameRequest_Id) isNewGameRequest_Variant() {}
+
+type NewGameFromTemplateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Direction SwipeDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=tally.v1.SwipeDirection" json:"direction,omitempty"`
+	IdealMoves      uint32  `protobuf:"varint,1,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
+	IdealScore      uint32  `protobuf:"varint,2,opt,name=ideal_score,json=idealScore,proto3" json:"ideal_score,omitempty"`
+	TargetCellValue uint64  `protobuf:"varint,3,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
+	Columns         uint32  `protobuf:"varint,4,opt,name=columns,proto3" json:"columns,omitempty"`
+	Rows            uint32  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	Name            string  `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string  `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Cells           []*Cell `protobuf:"bytes,8,rep,name=cells,proto3" json:"cells,omitempty"`
 }
 
-func (x *SwipeBoardRequest) Reset() {
-	*x = SwipeBoardRequest{}
+func (x *NewGameFromTemplateRequest) Reset() {
+	*x = NewGameFromTemplateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,13 +2002,13 @@ func (x *SwipeBoardRequest) Reset() {
 	}
 }
 
-func (x *SwipeBoardRequest) String() string {
+func (x *NewGameFromTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwipeBoardRequest) ProtoMessage() {}
+func (*NewGameFromTemplateRequest) ProtoMessage() {}
 
-func (x *SwipeBoardRequest) ProtoReflect() protoreflect.Message {
+func (x *NewGameFromTemplateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1573,40 +2020,81 @@ func (x *SwipeBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwipeBoardRequest.ProtoReflect.Descriptor instead.
-func (*SwipeBoardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewGameFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*NewGameFromTemplateRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SwipeBoardRequest) GetDirection() SwipeDirection {
+func (x *NewGameFromTemplateRequest) GetIdealMoves() uint32 {
 	if x != nil {
-		return x.Direction
+		return x.IdealMoves
 	}
-	return SwipeDirection_SWIPE_DIRECTION_UNSPECIFIED
+	return 0
 }
 
-type SwipeBoardResponse struct {
+func (x *NewGameFromTemplateRequest) GetIdealScore() uint32 {
+	if x != nil {
+		return x.IdealScore
+	}
+	return 0
+}
+
+func (x *NewGameFromTemplateRequest) GetTargetCellValue() uint64 {
+	if x != nil {
+		return x.TargetCellValue
+	}
+	return 0
+}
+
+func (x *NewGameFromTemplateRequest) GetColumns() uint32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *NewGameFromTemplateRequest) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *NewGameFromTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewGameFromTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NewGameFromTemplateRequest) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type RestartGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Indicates whether or not any change was made.
-	// This can happen in these scenarios:
-	// 1. The board is full.
-	// 2. Cell-generating is not active and
-	//   - All the bricks in the direction of which is beeing swiped is already
-	//     stacked at that edge
-	//
-	// This may be expanded upon with future gamemodes.
-	DidChange bool   `protobuf:"varint,1,opt,name=did_change,json=didChange,proto3" json:"did_change,omitempty"`
-	DidWin    bool   `protobuf:"varint,2,opt,name=did_win,json=didWin,proto3" json:"did_win,omitempty"`
-	DidLose   bool   `protobuf:"varint,3,opt,name=did_lose,json=didLose,proto3" json:"did_lose,omitempty"`
-	Board     *Board `protobuf:"bytes,4,opt,name=board,proto3" json:"board,omitempty"`
-	Moves     int64  `protobuf:"varint,5,opt,name=moves,proto3" json:"moves,omitempty"`
+	Board      *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score      int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves      int64  `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	SwapsLeft  uint32 `protobuf:"varint,4,opt,name=swaps_left,json=swapsLeft,proto3" json:"swaps_left,omitempty"`
+	PowersLeft uint32 `protobuf:"varint,5,opt,name=powers_left,json=powersLeft,proto3" json:"powers_left,omitempty"`
 }
 
-func (x *SwipeBoardResponse) Reset() {
-	*x = SwipeBoardResponse{}
+func (x *RestartGameResponse) Reset() {
+	*x = RestartGameResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1614,13 +2102,13 @@ func (x *SwipeBoardResponse) Reset() {
 	}
 }
 
-func (x *SwipeBoardResponse) String() string {
+func (x *RestartGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwipeBoardResponse) ProtoMessage() {}
+func (*RestartGameResponse) ProtoMessage() {}
 
-func (x *SwipeBoardResponse) ProtoReflect() protoreflect.Message {
+func (x *RestartGameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1632,57 +2120,56 @@ func (x *SwipeBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwipeBoardResponse.ProtoReflect.Descriptor instead.
-func (*SwipeBoardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestartGameResponse.ProtoReflect.Descriptor instead.
+func (*RestartGameResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SwipeBoardResponse) GetDidChange() bool {
+func (x *RestartGameResponse) GetBoard() *Board {
 	if x != nil {
-		return x.DidChange
+		return x.Board
 	}
-	return false
+	return nil
 }
 
-func (x *SwipeBoardResponse) GetDidWin() bool {
+func (x *RestartGameResponse) GetScore() int64 {
 	if x != nil {
-		return x.DidWin
+		return x.Score
 	}
-	return false
+	return 0
 }
 
-func (x *SwipeBoardResponse) GetDidLose() bool {
+func (x *RestartGameResponse) GetMoves() int64 {
 	if x != nil {
-		return x.DidLose
+		return x.Moves
 	}
-	return false
+	return 0
 }
 
-func (x *SwipeBoardResponse) GetBoard() *Board {
+func (x *RestartGameResponse) GetSwapsLeft() uint32 {
 	if x != nil {
-		return x.Board
+		return x.SwapsLeft
 	}
-	return nil
+	return 0
 }
 
-func (x *SwipeBoardResponse) GetMoves() int64 {
+func (x *RestartGameResponse) GetPowersLeft() uint32 {
 	if x != nil {
-		return x.Moves
+		return x.PowersLeft
 	}
 	return 0
 }
 
-type Coordinate struct {
+type GetSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X uint32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y uint32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 }
 
-func (x *Coordinate) Reset() {
-	*x = Coordinate{}
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1690,13 +2177,13 @@ func (x *Coordinate) Reset() {
 	}
 }
 
-func (x *Coordinate) String() string {
+func (x *GetSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Coordinate) ProtoMessage() {}
+func (*GetSessionResponse) ProtoMessage() {}
 
-func (x *Coordinate) ProtoReflect() protoreflect.Message {
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1708,35 +2195,34 @@ func (x *Coordinate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
-func (*Coordinate) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Coordinate) GetX() uint32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *Coordinate) GetY() uint32 {
+func (x *GetSessionResponse) GetSession() *Session {
 	if x != nil {
-		return x.Y
+		return x.Session
 	}
-	return 0
+	return nil
 }
 
-type Indexes struct {
+type NewGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index []uint32 `protobuf:"varint,1,rep,packed,name=index,proto3" json:"index,omitempty"`
+	Board       *Board   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score       int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves       int64    `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Mode        GameMode `protobuf:"varint,5,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
+	SwapsLeft   uint32   `protobuf:"varint,6,opt,name=swaps_left,json=swapsLeft,proto3" json:"swaps_left,omitempty"`
+	PowersLeft  uint32   `protobuf:"varint,7,opt,name=powers_left,json=powersLeft,proto3" json:"powers_left,omitempty"`
 }
 
-func (x *Indexes) Reset() {
-	*x = Indexes{}
+func (x *NewGameResponse) Reset() {
+	*x = NewGameResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1744,13 +2230,13 @@ func (x *Indexes) Reset() {
 	}
 }
 
-func (x *Indexes) String() string {
+func (x *NewGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Indexes) ProtoMessage() {}
+func (*NewGameResponse) ProtoMessage() {}
 
-func (x *Indexes) ProtoReflect() protoreflect.Message {
+func (x *NewGameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1762,28 +2248,74 @@ func (x *Indexes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Indexes.ProtoReflect.Descriptor instead.
-func (*Indexes) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewGameResponse.ProtoReflect.Descriptor instead.
+func (*NewGameResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Indexes) GetIndex() []uint32 {
+func (x *NewGameResponse) GetBoard() *Board {
 	if x != nil {
-		return x.Index
+		return x.Board
 	}
 	return nil
 }
 
-type SelectionCoordinates struct {
+func (x *NewGameResponse) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *NewGameResponse) GetMoves() int64 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+func (x *NewGameResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NewGameResponse) GetMode() GameMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GameMode_GAME_MODE_UNSPECIFIED
+}
+
+func (x *NewGameResponse) GetSwapsLeft() uint32 {
+	if x != nil {
+		return x.SwapsLeft
+	}
+	return 0
+}
+
+func (x *NewGameResponse) GetPowersLeft() uint32 {
+	if x != nil {
+		return x.PowersLeft
+	}
+	return 0
+}
+
+type NewGameFromTemplateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Coordinate []*Coordinate `protobuf:"bytes,1,rep,name=coordinate,proto3" json:"coordinate,omitempty"`
+	Board       *Board   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score       int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves       int64    `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Mode        GameMode `protobuf:"varint,5,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
 }
 
-func (x *SelectionCoordinates) Reset() {
-	*x = SelectionCoordinates{}
+func (x *NewGameFromTemplateResponse) Reset() {
+	*x = NewGameFromTemplateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1791,13 +2323,13 @@ func (x *SelectionCoordinates) Reset() {
 	}
 }
 
-func (x *SelectionCoordinates) String() string {
+func (x *NewGameFromTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelectionCoordinates) ProtoMessage() {}
+func (*NewGameFromTemplateResponse) ProtoMessage() {}
 
-func (x *SelectionCoordinates) ProtoReflect() protoreflect.Message {
+func (x *NewGameFromTemplateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1809,32 +2341,56 @@ func (x *SelectionCoordinates) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelectionCoordinates.ProtoReflect.Descriptor instead.
-func (*SelectionCoordinates) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewGameFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*NewGameFromTemplateResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SelectionCoordinates) GetCoordinate() []*Coordinate {
+func (x *NewGameFromTemplateResponse) GetBoard() *Board {
 	if x != nil {
-		return x.Coordinate
+		return x.Board
 	}
 	return nil
 }
 
-type CombineCellsRequest struct {
+func (x *NewGameFromTemplateResponse) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *NewGameFromTemplateResponse) GetMoves() int64 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+func (x *NewGameFromTemplateResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NewGameFromTemplateResponse) GetMode() GameMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GameMode_GAME_MODE_UNSPECIFIED
+}
+
+type SwipeBoardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Selection:
-	//
-	//	*CombineCellsRequest_Indexes
-	//	*CombineCellsRequest_Coordinate
-	Selection isCombineCellsRequest_Selection `protobuf_oneof:"selection"`
+	Direction SwipeDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=tally.v1.SwipeDirection" json:"direction,omitempty"`
 }
 
-func (x *CombineCellsRequest) Reset() {
-	*x = CombineCellsRequest{}
+func (x *SwipeBoardRequest) Reset() {
+	*x = SwipeBoardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,13 +2398,13 @@ func (x *CombineCellsRequest) Reset() {
 	}
 }
 
-func (x *CombineCellsRequest) String() string {
+func (x *SwipeBoardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CombineCellsRequest) ProtoMessage() {}
+func (*SwipeBoardRequest) ProtoMessage() {}
 
-func (x *CombineCellsRequest) ProtoReflect() protoreflect.Message {
+func (x *SwipeBoardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1860,62 +2416,40 @@ func (x *CombineCellsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CombineCellsRequest.ProtoReflect.Descriptor instead.
-func (*CombineCellsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwipeBoardRequest.ProtoReflect.Descriptor instead.
+func (*SwipeBoardRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{22}
 }
 
-func (m *CombineCellsRequest) GetSelection() isCombineCellsRequest_Selection {
-	if m != nil {
-		return m.Selection
-	}
-	return nil
-}
-
-func (x *CombineCellsRequest) GetIndexes() *Indexes {
-	if x, ok := x.GetSelection().(*CombineCellsRequest_Indexes); ok {
-		return x.Indexes
-	}
-	return nil
-}
-
-func (x *CombineCellsRequest) GetCoordinate() *Coordinate {
-	if x, ok := x.GetSelection().(*CombineCellsRequest_Coordinate); ok {
-		return x.Coordinate
+func (x *SwipeBoardRequest) GetDirection() SwipeDirection {
+	if x != nil {
+		return x.Direction
 	}
-	return nil
-}
-
-type isCombineCellsRequest_Selection interface {
-	isCombineCellsRequest_Selection()
-}
-
-type CombineCellsRequest_Indexes struct {
-	Indexes *Indexes `protobuf:"bytes,1,opt,name=indexes,proto3,oneof"`
-}
-
-type CombineCellsRequest_Coordinate struct {
-	Coordinate *Coordinate `protobuf:"bytes,2,opt,name=coordinate,proto3,oneof"`
+	return SwipeDirection_SWIPE_DIRECTION_UNSPECIFIED
 }
 
-func (*CombineCellsRequest_Indexes) isCombineCellsRequest_Selection() {}
-
-func (*CombineCellsRequest_Coordinate) isCombineCellsRequest_Selection() {}
-
-type CombineCellsResponse struct {
+type SwipeBoardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board   *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	Score   int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	Moves   int64  `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
-	DidWin  bool   `protobuf:"varint,4,opt,name=did_win,json=didWin,proto3" json:"did_win,omitempty"`
-	DidLose bool   `protobuf:"varint,5,opt,name=did_lose,json=didLose,proto3" json:"did_lose,omitempty"`
+	// Indicates whether or not any change was made.
+	// This can happen in these scenarios:
+	// 1. The board is full.
+	// 2. Cell-generating is not active and
+	//   - All the bricks in the direction of which is beeing swiped is already
+	//     stacked at that edge
+	//
+	// This may be expanded upon with future gamemodes.
+	DidChange bool   `protobuf:"varint,1,opt,name=did_change,json=didChange,proto3" json:"did_change,omitempty"`
+	DidWin    bool   `protobuf:"varint,2,opt,name=did_win,json=didWin,proto3" json:"did_win,omitempty"`
+	DidLose   bool   `protobuf:"varint,3,opt,name=did_lose,json=didLose,proto3" json:"did_lose,omitempty"`
+	Board     *Board `protobuf:"bytes,4,opt,name=board,proto3" json:"board,omitempty"`
+	Moves     int64  `protobuf:"varint,5,opt,name=moves,proto3" json:"moves,omitempty"`
 }
 
-func (x *CombineCellsResponse) Reset() {
-	*x = CombineCellsResponse{}
+func (x *SwipeBoardResponse) Reset() {
+	*x = SwipeBoardResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1923,13 +2457,13 @@ func (x *CombineCellsResponse) Reset() {
 	}
 }
 
-func (x *CombineCellsResponse) String() string {
+func (x *SwipeBoardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CombineCellsResponse) ProtoMessage() {}
+func (*SwipeBoardResponse) ProtoMessage() {}
 
-func (x *CombineCellsResponse) ProtoReflect() protoreflect.Message {
+func (x *SwipeBoardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1941,58 +2475,57 @@ func (x *CombineCellsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CombineCellsResponse.ProtoReflect.Descriptor instead.
-func (*CombineCellsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwipeBoardResponse.ProtoReflect.Descriptor instead.
+func (*SwipeBoardResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CombineCellsResponse) GetBoard() *Board {
+func (x *SwipeBoardResponse) GetDidChange() bool {
 	if x != nil {
-		return x.Board
+		return x.DidChange
 	}
-	return nil
+	return false
 }
 
-func (x *CombineCellsResponse) GetScore() int64 {
+func (x *SwipeBoardResponse) GetDidWin() bool {
 	if x != nil {
-		return x.Score
+		return x.DidWin
 	}
-	return 0
+	return false
 }
 
-func (x *CombineCellsResponse) GetMoves() int64 {
+func (x *SwipeBoardResponse) GetDidLose() bool {
 	if x != nil {
-		return x.Moves
+		return x.DidLose
 	}
-	return 0
+	return false
 }
 
-func (x *CombineCellsResponse) GetDidWin() bool {
+func (x *SwipeBoardResponse) GetBoard() *Board {
 	if x != nil {
-		return x.DidWin
+		return x.Board
 	}
-	return false
+	return nil
 }
 
-func (x *CombineCellsResponse) GetDidLose() bool {
+func (x *SwipeBoardResponse) GetMoves() int64 {
 	if x != nil {
-		return x.DidLose
+		return x.Moves
 	}
-	return false
+	return 0
 }
 
-type VoteBoardRequest struct {
+type Coordinate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	// How fun the board is to play
-	FunVote Vote `protobuf:"varint,3,opt,name=fun_vote,json=funVote,proto3,enum=tally.v1.Vote" json:"fun_vote,omitempty"`
+	X uint32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y uint32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *VoteBoardRequest) Reset() {
-	*x = VoteBoardRequest{}
+func (x *Coordinate) Reset() {
+	*x = Coordinate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2000,13 +2533,13 @@ func (x *VoteBoardRequest) Reset() {
 	}
 }
 
-func (x *VoteBoardRequest) String() string {
+func (x *Coordinate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteBoardRequest) ProtoMessage() {}
+func (*Coordinate) ProtoMessage() {}
 
-func (x *VoteBoardRequest) ProtoReflect() protoreflect.Message {
+func (x *Coordinate) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2018,36 +2551,35 @@ func (x *VoteBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteBoardRequest.ProtoReflect.Descriptor instead.
-func (*VoteBoardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
+func (*Coordinate) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *VoteBoardRequest) GetUserName() string {
+func (x *Coordinate) GetX() uint32 {
 	if x != nil {
-		return x.UserName
+		return x.X
 	}
-	return ""
+	return 0
 }
 
-func (x *VoteBoardRequest) GetFunVote() Vote {
+func (x *Coordinate) GetY() uint32 {
 	if x != nil {
-		return x.FunVote
+		return x.Y
 	}
-	return Vote_VOTE_UNSPECIFIED
+	return 0
 }
 
-type VoteBoardResponse struct {
+type Indexes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FunVote Vote   `protobuf:"varint,2,opt,name=fun_vote,json=funVote,proto3,enum=tally.v1.Vote" json:"fun_vote,omitempty"`
+	Index []uint32 `protobuf:"varint,1,rep,packed,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *VoteBoardResponse) Reset() {
-	*x = VoteBoardResponse{}
+func (x *Indexes) Reset() {
+	*x = Indexes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2055,13 +2587,13 @@ func (x *VoteBoardResponse) Reset() {
 	}
 }
 
-func (x *VoteBoardResponse) String() string {
+func (x *Indexes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteBoardResponse) ProtoMessage() {}
+func (*Indexes) ProtoMessage() {}
 
-func (x *VoteBoardResponse) ProtoReflect() protoreflect.Message {
+func (x *Indexes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2073,39 +2605,28 @@ func (x *VoteBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteBoardResponse.ProtoReflect.Descriptor instead.
-func (*VoteBoardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Indexes.ProtoReflect.Descriptor instead.
+func (*Indexes) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *VoteBoardResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *VoteBoardResponse) GetFunVote() Vote {
+func (x *Indexes) GetIndex() []uint32 {
 	if x != nil {
-		return x.FunVote
+		return x.Index
 	}
-	return Vote_VOTE_UNSPECIFIED
+	return nil
 }
 
-type Game struct {
+type SelectionCoordinates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board       *Board   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	Score       int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	Moves       int64    `protobuf:"varint,4,opt,name=moves,proto3" json:"moves,omitempty"`
-	Mode        GameMode `protobuf:"varint,5,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
-	Description string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Coordinate []*Coordinate `protobuf:"bytes,1,rep,name=coordinate,proto3" json:"coordinate,omitempty"`
 }
 
-func (x *Game) Reset() {
-	*x = Game{}
+func (x *SelectionCoordinates) Reset() {
+	*x = SelectionCoordinates{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2113,13 +2634,13 @@ func (x *Game) Reset() {
 	}
 }
 
-func (x *Game) String() string {
+func (x *SelectionCoordinates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Game) ProtoMessage() {}
+func (*SelectionCoordinates) ProtoMessage() {}
 
-func (x *Game) ProtoReflect() protoreflect.Message {
+func (x *SelectionCoordinates) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2131,59 +2652,32 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
+// Deprecated: Use SelectionCoordinates.ProtoReflect.Descriptor instead.
+func (*SelectionCoordinates) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Game) GetBoard() *Board {
+func (x *SelectionCoordinates) GetCoordinate() []*Coordinate {
 	if x != nil {
-		return x.Board
+		return x.Coordinate
 	}
 	return nil
 }
 
-func (x *Game) GetScore() int64 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *Game) GetMoves() int64 {
-	if x != nil {
-		return x.Moves
-	}
-	return 0
-}
-
-func (x *Game) GetMode() GameMode {
-	if x != nil {
-		return x.Mode
-	}
-	return GameMode_GAME_MODE_UNSPECIFIED
-}
-
-func (x *Game) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-type Session struct {
+type CombineCellsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Game        *Game  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
-	SessionId   string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Username    string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	GamesPlayed int64  `protobuf:"varint,4,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	// Types that are assignable to Selection:
+	//
+	//	*CombineCellsRequest_Indexes
+	//	*CombineCellsRequest_Coordinate
+	Selection isCombineCellsRequest_Selection `protobuf_oneof:"selection"`
 }
 
-func (x *Session) Reset() {
-	*x = Session{}
+func (x *CombineCellsRequest) Reset() {
+	*x = CombineCellsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2191,13 +2685,13 @@ func (x *Session) Reset() {
 	}
 }
 
-func (x *Session) String() string {
+func (x *CombineCellsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Session) ProtoMessage() {}
+func (*CombineCellsRequest) ProtoMessage() {}
 
-func (x *Session) ProtoReflect() protoreflect.Message {
+func (x *CombineCellsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2209,60 +2703,64 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Session.ProtoReflect.Descriptor instead.
-func (*Session) Descriptor() ([]byte, []int) {
+// Deprecated: Use CombineCellsRequest.ProtoReflect.Descriptor instead.
+func (*CombineCellsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Session) GetGame() *Game {
-	if x != nil {
-		return x.Game
+func (m *CombineCellsRequest) GetSelection() isCombineCellsRequest_Selection {
+	if m != nil {
+		return m.Selection
 	}
 	return nil
 }
 
-func (x *Session) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
+func (x *CombineCellsRequest) GetIndexes() *Indexes {
+	if x, ok := x.GetSelection().(*CombineCellsRequest_Indexes); ok {
+		return x.Indexes
 	}
-	return ""
+	return nil
 }
 
-func (x *Session) GetUsername() string {
-	if x != nil {
-		return x.Username
+func (x *CombineCellsRequest) GetCoordinate() *Coordinate {
+	if x, ok := x.GetSelection().(*CombineCellsRequest_Coordinate); ok {
+		return x.Coordinate
 	}
-	return ""
+	return nil
 }
 
-func (x *Session) GetGamesPlayed() int64 {
-	if x != nil {
-		return x.GamesPlayed
-	}
-	return 0
+type isCombineCellsRequest_Selection interface {
+	isCombineCellsRequest_Selection()
 }
 
-type GenerateGameRequest struct {
+type CombineCellsRequest_Indexes struct {
+	Indexes *Indexes `protobuf:"bytes,1,opt,name=indexes,proto3,oneof"`
+}
+
+type CombineCellsRequest_Coordinate struct {
+	Coordinate *Coordinate `protobuf:"bytes,2,opt,name=coordinate,proto3,oneof"`
+}
+
+func (*CombineCellsRequest_Indexes) isCombineCellsRequest_Selection() {}
+
+func (*CombineCellsRequest_Coordinate) isCombineCellsRequest_Selection() {}
+
+type CombineCellsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rows               uint32             `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
-	Columns            uint32             `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`
-	TargetCellValue    uint64             `protobuf:"varint,3,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
-	MaxBricks          uint32             `protobuf:"varint,4,opt,name=max_bricks,json=maxBricks,proto3" json:"max_bricks,omitempty"`
-	MinMoves           uint64             `protobuf:"varint,6,opt,name=min_moves,json=minMoves,proto3" json:"min_moves,omitempty"`
-	MaxMoves           uint64             `protobuf:"varint,7,opt,name=max_moves,json=maxMoves,proto3" json:"max_moves,omitempty"`
-	MaxAdditionalCells uint64             `protobuf:"varint,8,opt,name=max_additional_cells,json=maxAdditionalCells,proto3" json:"max_additional_cells,omitempty"`
-	RandomCellChance   uint64             `protobuf:"varint,9,opt,name=random_cell_chance,json=randomCellChance,proto3" json:"random_cell_chance,omitempty"`
-	Seed               uint64             `protobuf:"varint,10,opt,name=seed,proto3" json:"seed,omitempty"`
-	Salt               uint64             `protobuf:"varint,11,opt,name=salt,proto3" json:"salt,omitempty"`
-	WithSolutions      bool               `protobuf:"varint,12,opt,name=with_solutions,json=withSolutions,proto3" json:"with_solutions,omitempty"`
-	Algorithm          GeneratorAlgorithm `protobuf:"varint,13,opt,name=algorithm,proto3,enum=tally.v1.GeneratorAlgorithm" json:"algorithm,omitempty"`
+	Board   *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score   int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves   int64  `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	DidWin  bool   `protobuf:"varint,4,opt,name=did_win,json=didWin,proto3" json:"did_win,omitempty"`
+	DidLose bool   `protobuf:"varint,5,opt,name=did_lose,json=didLose,proto3" json:"did_lose,omitempty"`
+	// The method used to evaluate the cells
+	EvalMethod EvalMethod `protobuf:"varint,6,opt,name=eval_method,json=evalMethod,proto3,enum=tally.v1.EvalMethod" json:"eval_method,omitempty"`
 }
 
-func (x *GenerateGameRequest) Reset() {
-	*x = GenerateGameRequest{}
+func (x *CombineCellsResponse) Reset() {
+	*x = CombineCellsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2270,13 +2768,13 @@ func (x *GenerateGameRequest) Reset() {
 	}
 }
 
-func (x *GenerateGameRequest) String() string {
+func (x *CombineCellsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateGameRequest) ProtoMessage() {}
+func (*CombineCellsResponse) ProtoMessage() {}
 
-func (x *GenerateGameRequest) ProtoReflect() protoreflect.Message {
+func (x *CombineCellsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2288,125 +2786,141 @@ func (x *GenerateGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateGameRequest.ProtoReflect.Descriptor instead.
-func (*GenerateGameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CombineCellsResponse.ProtoReflect.Descriptor instead.
+func (*CombineCellsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GenerateGameRequest) GetRows() uint32 {
+func (x *CombineCellsResponse) GetBoard() *Board {
 	if x != nil {
-		return x.Rows
+		return x.Board
 	}
-	return 0
+	return nil
 }
 
-func (x *GenerateGameRequest) GetColumns() uint32 {
+func (x *CombineCellsResponse) GetScore() int64 {
 	if x != nil {
-		return x.Columns
+		return x.Score
 	}
 	return 0
 }
 
-func (x *GenerateGameRequest) GetTargetCellValue() uint64 {
+func (x *CombineCellsResponse) GetMoves() int64 {
 	if x != nil {
-		return x.TargetCellValue
+		return x.Moves
 	}
 	return 0
 }
 
-func (x *GenerateGameRequest) GetMaxBricks() uint32 {
+func (x *CombineCellsResponse) GetDidWin() bool {
 	if x != nil {
-		return x.MaxBricks
+		return x.DidWin
 	}
-	return 0
+	return false
 }
 
-func (x *GenerateGameRequest) GetMinMoves() uint64 {
+func (x *CombineCellsResponse) GetDidLose() bool {
 	if x != nil {
-		return x.MinMoves
+		return x.DidLose
 	}
-	return 0
+	return false
 }
 
-func (x *GenerateGameRequest) GetMaxMoves() uint64 {
+func (x *CombineCellsResponse) GetEvalMethod() EvalMethod {
 	if x != nil {
-		return x.MaxMoves
+		return x.EvalMethod
 	}
-	return 0
+	return EvalMethod_EVAL_METHOD_UNSPECIFIED
 }
 
-func (x *GenerateGameRequest) GetMaxAdditionalCells() uint64 {
-	if x != nil {
-		return x.MaxAdditionalCells
-	}
-	return 0
+// Swaps two cells. This counts as a move, and the number of swaps are limited
+// by the rules. Some rules only allows swapping neighbouring cells.
+type SwapCellsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IndexA uint32 `protobuf:"varint,1,opt,name=index_a,json=indexA,proto3" json:"index_a,omitempty"`
+	IndexB uint32 `protobuf:"varint,2,opt,name=index_b,json=indexB,proto3" json:"index_b,omitempty"`
 }
 
-func (x *GenerateGameRequest) GetRandomCellChance() uint64 {
-	if x != nil {
-		return x.RandomCellChance
+func (x *SwapCellsRequest) Reset() {
+	*x = SwapCellsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GenerateGameRequest) GetSeed() uint64 {
-	if x != nil {
-		return x.Seed
-	}
-	return 0
+func (x *SwapCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GenerateGameRequest) GetSalt() uint64 {
-	if x != nil {
-		return x.Salt
+func (*SwapCellsRequest) ProtoMessage() {}
+
+func (x *SwapCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GenerateGameRequest) GetWithSolutions() bool {
+// Deprecated: Use SwapCellsRequest.ProtoReflect.Descriptor instead.
+func (*SwapCellsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SwapCellsRequest) GetIndexA() uint32 {
 	if x != nil {
-		return x.WithSolutions
+		return x.IndexA
 	}
-	return false
+	return 0
 }
 
-func (x *GenerateGameRequest) GetAlgorithm() GeneratorAlgorithm {
+func (x *SwapCellsRequest) GetIndexB() uint32 {
 	if x != nil {
-		return x.Algorithm
+		return x.IndexB
 	}
-	return GeneratorAlgorithm_GENERATOR_ALGORITHM_UNSPECIFIED
+	return 0
 }
 
-type GenerateGameResponse struct {
+type SwapCellsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Game         *Game      `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
-	IdealMoves   uint32     `protobuf:"varint,2,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
-	IdealScore   uint64     `protobuf:"varint,3,opt,name=ideal_score,json=idealScore,proto3" json:"ideal_score,omitempty"`
-	HighestScore uint64     `protobuf:"varint,4,opt,name=highest_score,json=highestScore,proto3" json:"highest_score,omitempty"`
-	Solutions    []*Game    `protobuf:"bytes,5,rep,name=solutions,proto3" json:"solutions,omitempty"`
-	Stats        *GameStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	Board   *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score   int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves   int64  `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	DidWin  bool   `protobuf:"varint,4,opt,name=did_win,json=didWin,proto3" json:"did_win,omitempty"`
+	DidLose bool   `protobuf:"varint,5,opt,name=did_lose,json=didLose,proto3" json:"did_lose,omitempty"`
+	// The number of times cells can still be swapped
+	SwapsLeft uint32 `protobuf:"varint,6,opt,name=swaps_left,json=swapsLeft,proto3" json:"swaps_left,omitempty"`
 }
 
-func (x *GenerateGameResponse) Reset() {
-	*x = GenerateGameResponse{}
+func (x *SwapCellsResponse) Reset() {
+	*x = SwapCellsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[29]
+		mi := &file_proto_tally_v1_board_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateGameResponse) String() string {
+func (x *SwapCellsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateGameResponse) ProtoMessage() {}
+func (*SwapCellsResponse) ProtoMessage() {}
 
-func (x *GenerateGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tally_v1_board_proto_msgTypes[29]
+func (x *SwapCellsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2417,101 +2931,65 @@ func (x *GenerateGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateGameResponse.ProtoReflect.Descriptor instead.
-func (*GenerateGameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SwapCellsResponse.ProtoReflect.Descriptor instead.
+func (*SwapCellsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GenerateGameResponse) GetGame() *Game {
+func (x *SwapCellsResponse) GetBoard() *Board {
 	if x != nil {
-		return x.Game
+		return x.Board
 	}
 	return nil
 }
 
-func (x *GenerateGameResponse) GetIdealMoves() uint32 {
+func (x *SwapCellsResponse) GetScore() int64 {
 	if x != nil {
-		return x.IdealMoves
+		return x.Score
 	}
 	return 0
 }
 
-func (x *GenerateGameResponse) GetIdealScore() uint64 {
+func (x *SwapCellsResponse) GetMoves() int64 {
 	if x != nil {
-		return x.IdealScore
+		return x.Moves
 	}
 	return 0
 }
 
-func (x *GenerateGameResponse) GetHighestScore() uint64 {
+func (x *SwapCellsResponse) GetDidWin() bool {
 	if x != nil {
-		return x.HighestScore
+		return x.DidWin
 	}
-	return 0
+	return false
 }
 
-func (x *GenerateGameResponse) GetSolutions() []*Game {
+func (x *SwapCellsResponse) GetDidLose() bool {
 	if x != nil {
-		return x.Solutions
+		return x.DidLose
 	}
-	return nil
+	return false
 }
 
-func (x *GenerateGameResponse) GetStats() *GameStats {
+func (x *SwapCellsResponse) GetSwapsLeft() uint32 {
 	if x != nil {
-		return x.Stats
-	}
-	return nil
-}
-
-type GetGameChallengesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetGameChallengesRequest) Reset() {
-	*x = GetGameChallengesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.SwapsLeft
 	}
+	return 0
 }
 
-func (x *GetGameChallengesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetGameChallengesRequest) ProtoMessage() {}
-
-func (x *GetGameChallengesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tally_v1_board_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetGameChallengesRequest.ProtoReflect.Descriptor instead.
-func (*GetGameChallengesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{30}
-}
-
-type GetGameChallengesResponse struct {
+type UsePowerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Challenges []*GameChallenge `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	Power Power `protobuf:"varint,1,opt,name=power,proto3,enum=tally.v1.Power" json:"power,omitempty"`
+	// The cell to use the power on. Not used for POWER_SHUFFLE
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *GetGameChallengesResponse) Reset() {
-	*x = GetGameChallengesResponse{}
+func (x *UsePowerRequest) Reset() {
+	*x = UsePowerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2519,13 +2997,13 @@ func (x *GetGameChallengesResponse) Reset() {
 	}
 }
 
-func (x *GetGameChallengesResponse) String() string {
+func (x *UsePowerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameChallengesResponse) ProtoMessage() {}
+func (*UsePowerRequest) ProtoMessage() {}
 
-func (x *GetGameChallengesResponse) ProtoReflect() protoreflect.Message {
+func (x *UsePowerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2537,43 +3015,41 @@ func (x *GetGameChallengesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameChallengesResponse.ProtoReflect.Descriptor instead.
-func (*GetGameChallengesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsePowerRequest.ProtoReflect.Descriptor instead.
+func (*UsePowerRequest) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetGameChallengesResponse) GetChallenges() []*GameChallenge {
+func (x *UsePowerRequest) GetPower() Power {
 	if x != nil {
-		return x.Challenges
+		return x.Power
 	}
-	return nil
+	return Power_POWER_UNSPECIFIED
 }
 
-type GameChallenge struct {
+func (x *UsePowerRequest) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type UsePowerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                      string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ChallengeNumber         uint32  `protobuf:"varint,2,opt,name=challenge_number,json=challengeNumber,proto3" json:"challenge_number,omitempty"`
-	IdealMoves              uint32  `protobuf:"varint,3,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
-	IdealScore              uint64  `protobuf:"varint,4,opt,name=ideal_score,json=idealScore,proto3" json:"ideal_score,omitempty"`
-	TargetCellValue         uint64  `protobuf:"varint,5,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
-	Columns                 uint32  `protobuf:"varint,6,opt,name=columns,proto3" json:"columns,omitempty"`
-	Rows                    uint32  `protobuf:"varint,7,opt,name=rows,proto3" json:"rows,omitempty"`
-	Name                    string  `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
-	Description             string  `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
-	Cells                   []*Cell `protobuf:"bytes,10,rep,name=cells,proto3" json:"cells,omitempty"`
-	CurrentUsersBestScore   uint64  `protobuf:"varint,11,opt,name=current_users_best_score,json=currentUsersBestScore,proto3" json:"current_users_best_score,omitempty"`
-	CurrentUsersFewestMoves uint32  `protobuf:"varint,12,opt,name=current_users_fewest_moves,json=currentUsersFewestMoves,proto3" json:"current_users_fewest_moves,omitempty"`
-	// Current rating the user has for the challenge, if played and solved.
-	Rating Rating `protobuf:"varint,13,opt,name=rating,proto3,enum=tally.v1.Rating" json:"rating,omitempty"`
-	// Indicated that the challenge is locked.
-	Locked bool `protobuf:"varint,14,opt,name=locked,proto3" json:"locked,omitempty"`
+	Board   *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score   int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves   int64  `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	DidWin  bool   `protobuf:"varint,4,opt,name=did_win,json=didWin,proto3" json:"did_win,omitempty"`
+	DidLose bool   `protobuf:"varint,5,opt,name=did_lose,json=didLose,proto3" json:"did_lose,omitempty"`
+	// The number of super-powers that can still be used
+	PowersLeft uint32 `protobuf:"varint,6,opt,name=powers_left,json=powersLeft,proto3" json:"powers_left,omitempty"`
 }
 
-func (x *GameChallenge) Reset() {
-	*x = GameChallenge{}
+func (x *UsePowerResponse) Reset() {
+	*x = UsePowerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_tally_v1_board_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2581,13 +3057,13 @@ func (x *GameChallenge) Reset() {
 	}
 }
 
-func (x *GameChallenge) String() string {
+func (x *UsePowerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameChallenge) ProtoMessage() {}
+func (*UsePowerResponse) ProtoMessage() {}
 
-func (x *GameChallenge) ProtoReflect() protoreflect.Message {
+func (x *UsePowerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_tally_v1_board_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2599,142 +3075,135 @@ func (x *GameChallenge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameChallenge.ProtoReflect.Descriptor instead.
-func (*GameChallenge) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsePowerResponse.ProtoReflect.Descriptor instead.
+func (*UsePowerResponse) Descriptor() ([]byte, []int) {
 	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GameChallenge) GetId() string {
+func (x *UsePowerResponse) GetBoard() *Board {
 	if x != nil {
-		return x.Id
+		return x.Board
 	}
-	return ""
+	return nil
 }
 
-func (x *GameChallenge) GetChallengeNumber() uint32 {
+func (x *UsePowerResponse) GetScore() int64 {
 	if x != nil {
-		return x.ChallengeNumber
+		return x.Score
 	}
 	return 0
 }
 
-func (x *GameChallenge) GetIdealMoves() uint32 {
+func (x *UsePowerResponse) GetMoves() int64 {
 	if x != nil {
-		return x.IdealMoves
+		return x.Moves
 	}
 	return 0
 }
 
-func (x *GameChallenge) GetIdealScore() uint64 {
+func (x *UsePowerResponse) GetDidWin() bool {
 	if x != nil {
-		return x.IdealScore
+		return x.DidWin
 	}
-	return 0
+	return false
 }
 
-func (x *GameChallenge) GetTargetCellValue() uint64 {
+func (x *UsePowerResponse) GetDidLose() bool {
 	if x != nil {
-		return x.TargetCellValue
+		return x.DidLose
 	}
-	return 0
+	return false
 }
 
-func (x *GameChallenge) GetColumns() uint32 {
+func (x *UsePowerResponse) GetPowersLeft() uint32 {
 	if x != nil {
-		return x.Columns
+		return x.PowersLeft
 	}
 	return 0
 }
 
-func (x *GameChallenge) GetRows() uint32 {
-	if x != nil {
-		return x.Rows
-	}
-	return 0
+type VoteBoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// How fun the board is to play
+	FunVote Vote `protobuf:"varint,3,opt,name=fun_vote,json=funVote,proto3,enum=tally.v1.Vote" json:"fun_vote,omitempty"`
 }
 
-func (x *GameChallenge) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *VoteBoardRequest) Reset() {
+	*x = VoteBoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GameChallenge) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *VoteBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameChallenge) GetCells() []*Cell {
-	if x != nil {
-		return x.Cells
-	}
-	return nil
-}
+func (*VoteBoardRequest) ProtoMessage() {}
 
-func (x *GameChallenge) GetCurrentUsersBestScore() uint64 {
-	if x != nil {
-		return x.CurrentUsersBestScore
+func (x *VoteBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GameChallenge) GetCurrentUsersFewestMoves() uint32 {
-	if x != nil {
-		return x.CurrentUsersFewestMoves
-	}
-	return 0
+// Deprecated: Use VoteBoardRequest.ProtoReflect.Descriptor instead.
+func (*VoteBoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GameChallenge) GetRating() Rating {
+func (x *VoteBoardRequest) GetUserName() string {
 	if x != nil {
-		return x.Rating
+		return x.UserName
 	}
-	return Rating_RATING_UNSPECIFIED
+	return ""
 }
 
-func (x *GameChallenge) GetLocked() bool {
+func (x *VoteBoardRequest) GetFunVote() Vote {
 	if x != nil {
-		return x.Locked
+		return x.FunVote
 	}
-	return false
+	return Vote_VOTE_UNSPECIFIED
 }
 
-type CreateGameChallengeRequest struct {
+type VoteBoardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChallengeNumber uint32  `protobuf:"varint,1,opt,name=challenge_number,json=challengeNumber,proto3" json:"challenge_number,omitempty"`
-	IdealMoves      uint32  `protobuf:"varint,2,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
-	IdealScore      uint32  `protobuf:"varint,3,opt,name=ideal_score,json=idealScore,proto3" json:"ideal_score,omitempty"`
-	TargetCellValue uint64  `protobuf:"varint,4,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
-	Columns         uint32  `protobuf:"varint,5,opt,name=columns,proto3" json:"columns,omitempty"`
-	Rows            uint32  `protobuf:"varint,6,opt,name=rows,proto3" json:"rows,omitempty"`
-	Name            string  `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string  `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	Cells           []*Cell `protobuf:"bytes,9,rep,name=cells,proto3" json:"cells,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FunVote Vote   `protobuf:"varint,2,opt,name=fun_vote,json=funVote,proto3,enum=tally.v1.Vote" json:"fun_vote,omitempty"`
 }
 
-func (x *CreateGameChallengeRequest) Reset() {
-	*x = CreateGameChallengeRequest{}
+func (x *VoteBoardResponse) Reset() {
+	*x = VoteBoardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[33]
+		mi := &file_proto_tally_v1_board_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateGameChallengeRequest) String() string {
+func (x *VoteBoardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameChallengeRequest) ProtoMessage() {}
+func (*VoteBoardResponse) ProtoMessage() {}
 
-func (x *CreateGameChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tally_v1_board_proto_msgTypes[33]
+func (x *VoteBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2745,100 +3214,152 @@ func (x *CreateGameChallengeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameChallengeRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use VoteBoardResponse.ProtoReflect.Descriptor instead.
+func (*VoteBoardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreateGameChallengeRequest) GetChallengeNumber() uint32 {
+func (x *VoteBoardResponse) GetId() string {
 	if x != nil {
-		return x.ChallengeNumber
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateGameChallengeRequest) GetIdealMoves() uint32 {
+func (x *VoteBoardResponse) GetFunVote() Vote {
 	if x != nil {
-		return x.IdealMoves
+		return x.FunVote
 	}
-	return 0
+	return Vote_VOTE_UNSPECIFIED
 }
 
-func (x *CreateGameChallengeRequest) GetIdealScore() uint32 {
-	if x != nil {
-		return x.IdealScore
+type Game struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board       *Board   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score       int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves       int64    `protobuf:"varint,4,opt,name=moves,proto3" json:"moves,omitempty"`
+	Mode        GameMode `protobuf:"varint,5,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
+	Description string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// The number of times cells can still be swapped
+	SwapsLeft uint32 `protobuf:"varint,7,opt,name=swaps_left,json=swapsLeft,proto3" json:"swaps_left,omitempty"`
+	// The number of super-powers that can still be used
+	PowersLeft uint32 `protobuf:"varint,8,opt,name=powers_left,json=powersLeft,proto3" json:"powers_left,omitempty"`
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CreateGameChallengeRequest) GetTargetCellValue() uint64 {
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Game) GetBoard() *Board {
 	if x != nil {
-		return x.TargetCellValue
+		return x.Board
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateGameChallengeRequest) GetColumns() uint32 {
+func (x *Game) GetScore() int64 {
 	if x != nil {
-		return x.Columns
+		return x.Score
 	}
 	return 0
 }
 
-func (x *CreateGameChallengeRequest) GetRows() uint32 {
+func (x *Game) GetMoves() int64 {
 	if x != nil {
-		return x.Rows
+		return x.Moves
 	}
 	return 0
 }
 
-func (x *CreateGameChallengeRequest) GetName() string {
+func (x *Game) GetMode() GameMode {
 	if x != nil {
-		return x.Name
+		return x.Mode
 	}
-	return ""
+	return GameMode_GAME_MODE_UNSPECIFIED
 }
 
-func (x *CreateGameChallengeRequest) GetDescription() string {
+func (x *Game) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateGameChallengeRequest) GetCells() []*Cell {
+func (x *Game) GetSwapsLeft() uint32 {
 	if x != nil {
-		return x.Cells
+		return x.SwapsLeft
 	}
-	return nil
+	return 0
 }
 
-type CreateGameChallengeResponse struct {
+func (x *Game) GetPowersLeft() uint32 {
+	if x != nil {
+		return x.PowersLeft
+	}
+	return 0
+}
+
+type Session struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ChallengeNumber uint32 `protobuf:"varint,2,opt,name=challenge_number,json=challengeNumber,proto3" json:"challenge_number,omitempty"`
+	Game        *Game  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	SessionId   string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Username    string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	GamesPlayed int64  `protobuf:"varint,4,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	// Set when the user has registered with a username and password
+	Registered bool `protobuf:"varint,5,opt,name=registered,proto3" json:"registered,omitempty"`
 }
 
-func (x *CreateGameChallengeResponse) Reset() {
-	*x = CreateGameChallengeResponse{}
+func (x *Session) Reset() {
+	*x = Session{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[34]
+		mi := &file_proto_tally_v1_board_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateGameChallengeResponse) String() string {
+func (x *Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameChallengeResponse) ProtoMessage() {}
+func (*Session) ProtoMessage() {}
 
-func (x *CreateGameChallengeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tally_v1_board_proto_msgTypes[34]
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2849,71 +3370,81 @@ func (x *CreateGameChallengeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameChallengeResponse.ProtoReflect.Descriptor instead.
-func (*CreateGameChallengeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateGameChallengeResponse) GetId() string {
+func (x *Session) GetGame() *Game {
 	if x != nil {
-		return x.Id
+		return x.Game
+	}
+	return nil
+}
+
+func (x *Session) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *CreateGameChallengeResponse) GetChallengeNumber() uint32 {
+func (x *Session) GetUsername() string {
 	if x != nil {
-		return x.ChallengeNumber
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Session) GetGamesPlayed() int64 {
+	if x != nil {
+		return x.GamesPlayed
 	}
 	return 0
 }
 
-type GameStats struct {
+func (x *Session) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+// Lists the users games, the most recently created first.
+type ListGamesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of unique factors across all cells
-	UniqueFactors []uint64 `protobuf:"varint,1,rep,packed,name=unique_factors,json=uniqueFactors,proto3" json:"unique_factors,omitempty"`
-	// List of unique values across all cells
-	UniqueValues []uint64 `protobuf:"varint,2,rep,packed,name=unique_values,json=uniqueValues,proto3" json:"unique_values,omitempty"`
-	// Count of duplicate factors
-	DuplicateFactors uint64 `protobuf:"varint,3,opt,name=duplicate_factors,json=duplicateFactors,proto3" json:"duplicate_factors,omitempty"`
-	// Count of duplicate values
-	DuplicateValues uint64 `protobuf:"varint,4,opt,name=duplicate_values,json=duplicateValues,proto3" json:"duplicate_values,omitempty"`
-	// Cells with value (non-empty)
-	WithValueCount uint64 `protobuf:"varint,5,opt,name=with_value_count,json=withValueCount,proto3" json:"with_value_count,omitempty"`
-	// Total number of cells
-	CellCount uint64 `protobuf:"varint,6,opt,name=cell_count,json=cellCount,proto3" json:"cell_count,omitempty"`
-	// Unique hints at start
-	UniqueHints uint64 `protobuf:"varint,7,opt,name=unique_hints,json=uniqueHints,proto3" json:"unique_hints,omitempty"`
-	// Hints at start
-	Hints                   []*Instruction  `protobuf:"bytes,8,rep,name=hints,proto3" json:"hints,omitempty"`
-	IdealMovesSolutionIndex uint32          `protobuf:"varint,9,opt,name=ideal_moves_solution_index,json=idealMovesSolutionIndex,proto3" json:"ideal_moves_solution_index,omitempty"`
-	MaxScoreSolutionIndex   uint32          `protobuf:"varint,10,opt,name=max_score_solution_index,json=maxScoreSolutionIndex,proto3" json:"max_score_solution_index,omitempty"`
-	IdealMoves              uint32          `protobuf:"varint,11,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
-	ScoreOnIdeal            uint64          `protobuf:"varint,12,opt,name=score_on_ideal,json=scoreOnIdeal,proto3" json:"score_on_ideal,omitempty"`
-	MaxScore                uint64          `protobuf:"varint,13,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
-	SolutionStats           []*SolutionStat `protobuf:"bytes,14,rep,name=solution_stats,json=solutionStats,proto3" json:"solution_stats,omitempty"`
+	// Only include games with any of these play-states
+	PlayStates []PlayState `protobuf:"varint,1,rep,packed,name=play_states,json=playStates,proto3,enum=tally.v1.PlayState" json:"play_states,omitempty"`
+	// Only include games with any of these modes
+	Modes []GameMode `protobuf:"varint,2,rep,packed,name=modes,proto3,enum=tally.v1.GameMode" json:"modes,omitempty"`
+	// Only include games for this challenge
+	ChallengeId string `protobuf:"bytes,3,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// Maximum number of games to return. Defaults to 20, and is capped at 100
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Number of games to skip
+	Offset uint32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *GameStats) Reset() {
-	*x = GameStats{}
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[35]
+		mi := &file_proto_tally_v1_board_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GameStats) String() string {
+func (x *ListGamesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameStats) ProtoMessage() {}
+func (*ListGamesRequest) ProtoMessage() {}
 
-func (x *GameStats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tally_v1_board_proto_msgTypes[35]
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2924,136 +3455,243 @@ func (x *GameStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameStats.ProtoReflect.Descriptor instead.
-func (*GameStats) Descriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GameStats) GetUniqueFactors() []uint64 {
+func (x *ListGamesRequest) GetPlayStates() []PlayState {
 	if x != nil {
-		return x.UniqueFactors
+		return x.PlayStates
 	}
 	return nil
 }
 
-func (x *GameStats) GetUniqueValues() []uint64 {
+func (x *ListGamesRequest) GetModes() []GameMode {
 	if x != nil {
-		return x.UniqueValues
+		return x.Modes
 	}
 	return nil
 }
 
-func (x *GameStats) GetDuplicateFactors() uint64 {
+func (x *ListGamesRequest) GetChallengeId() string {
 	if x != nil {
-		return x.DuplicateFactors
+		return x.ChallengeId
 	}
-	return 0
+	return ""
 }
 
-func (x *GameStats) GetDuplicateValues() uint64 {
+func (x *ListGamesRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.DuplicateValues
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *GameStats) GetWithValueCount() uint64 {
+func (x *ListGamesRequest) GetOffset() uint32 {
 	if x != nil {
-		return x.WithValueCount
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *GameStats) GetCellCount() uint64 {
+type ListGamesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*ListedGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	// Number of games matching the filters
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse) ProtoMessage() {}
+
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListGamesResponse) GetGames() []*ListedGame {
 	if x != nil {
-		return x.CellCount
+		return x.Games
 	}
-	return 0
+	return nil
 }
 
-func (x *GameStats) GetUniqueHints() uint64 {
+func (x *ListGamesResponse) GetTotal() uint32 {
 	if x != nil {
-		return x.UniqueHints
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GameStats) GetHints() []*Instruction {
+type ListedGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The board as it currently is
+	Board     *Board    `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Score     int64     `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Moves     int64     `protobuf:"varint,4,opt,name=moves,proto3" json:"moves,omitempty"`
+	Mode      GameMode  `protobuf:"varint,5,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
+	PlayState PlayState `protobuf:"varint,6,opt,name=play_state,json=playState,proto3,enum=tally.v1.PlayState" json:"play_state,omitempty"`
+	// Set if the game is for a challenge
+	ChallengeId string `protobuf:"bytes,7,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// Set for the game currently being played
+	Active      bool   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	Description string `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ListedGame) Reset() {
+	*x = ListedGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListedGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListedGame) ProtoMessage() {}
+
+func (x *ListedGame) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListedGame.ProtoReflect.Descriptor instead.
+func (*ListedGame) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListedGame) GetId() string {
 	if x != nil {
-		return x.Hints
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListedGame) GetBoard() *Board {
+	if x != nil {
+		return x.Board
 	}
 	return nil
 }
 
-func (x *GameStats) GetIdealMovesSolutionIndex() uint32 {
+func (x *ListedGame) GetScore() int64 {
 	if x != nil {
-		return x.IdealMovesSolutionIndex
+		return x.Score
 	}
 	return 0
 }
 
-func (x *GameStats) GetMaxScoreSolutionIndex() uint32 {
+func (x *ListedGame) GetMoves() int64 {
 	if x != nil {
-		return x.MaxScoreSolutionIndex
+		return x.Moves
 	}
 	return 0
 }
 
-func (x *GameStats) GetIdealMoves() uint32 {
+func (x *ListedGame) GetMode() GameMode {
 	if x != nil {
-		return x.IdealMoves
+		return x.Mode
 	}
-	return 0
+	return GameMode_GAME_MODE_UNSPECIFIED
 }
 
-func (x *GameStats) GetScoreOnIdeal() uint64 {
+func (x *ListedGame) GetPlayState() PlayState {
 	if x != nil {
-		return x.ScoreOnIdeal
+		return x.PlayState
 	}
-	return 0
+	return PlayState_PLAY_STATE_UNSPECIFIED
 }
 
-func (x *GameStats) GetMaxScore() uint64 {
+func (x *ListedGame) GetChallengeId() string {
 	if x != nil {
-		return x.MaxScore
+		return x.ChallengeId
 	}
-	return 0
+	return ""
 }
 
-func (x *GameStats) GetSolutionStats() []*SolutionStat {
+func (x *ListedGame) GetActive() bool {
 	if x != nil {
-		return x.SolutionStats
+		return x.Active
 	}
-	return nil
+	return false
 }
 
-type SolutionStat struct {
+func (x *ListedGame) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Continues an unfinished game. The game currently being played is abandoned,
+// and can be resumed later.
+type ResumeGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Moves          uint32            `protobuf:"varint,1,opt,name=moves,proto3" json:"moves,omitempty"`
-	Score          uint64            `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	InstructionTag []*InstructionTag `protobuf:"bytes,3,rep,name=instruction_tag,json=instructionTag,proto3" json:"instruction_tag,omitempty"`
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *SolutionStat) Reset() {
-	*x = SolutionStat{}
+func (x *ResumeGameRequest) Reset() {
+	*x = ResumeGameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[36]
+		mi := &file_proto_tally_v1_board_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SolutionStat) String() string {
+func (x *ResumeGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SolutionStat) ProtoMessage() {}
+func (*ResumeGameRequest) ProtoMessage() {}
 
-func (x *SolutionStat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tally_v1_board_proto_msgTypes[36]
+func (x *ResumeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3064,61 +3702,94 @@ func (x *SolutionStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SolutionStat.ProtoReflect.Descriptor instead.
-func (*SolutionStat) Descriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ResumeGameRequest.ProtoReflect.Descriptor instead.
+func (*ResumeGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SolutionStat) GetMoves() uint32 {
+func (x *ResumeGameRequest) GetGameId() string {
 	if x != nil {
-		return x.Moves
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-func (x *SolutionStat) GetScore() uint64 {
-	if x != nil {
-		return x.Score
+type ResumeGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *ResumeGameResponse) Reset() {
+	*x = ResumeGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *SolutionStat) GetInstructionTag() []*InstructionTag {
+func (x *ResumeGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeGameResponse) ProtoMessage() {}
+
+func (x *ResumeGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeGameResponse.ProtoReflect.Descriptor instead.
+func (*ResumeGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ResumeGameResponse) GetGame() *Game {
 	if x != nil {
-		return x.InstructionTag
+		return x.Game
 	}
 	return nil
 }
 
-type InstructionTag struct {
+// Registers the current user with a unique username and a password,
+// so that the user can be reached from other devices.
+type RegisterUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok               bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	IsMultiplication bool   `protobuf:"varint,2,opt,name=is_multiplication,json=isMultiplication,proto3" json:"is_multiplication,omitempty"`
-	IsAddition       bool   `protobuf:"varint,3,opt,name=is_addition,json=isAddition,proto3" json:"is_addition,omitempty"`
-	IsSwipe          bool   `protobuf:"varint,4,opt,name=is_swipe,json=isSwipe,proto3" json:"is_swipe,omitempty"`
-	TwoPow           uint64 `protobuf:"varint,5,opt,name=two_pow,json=twoPow,proto3" json:"two_pow,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// A password or passphrase, at least 8 characters long
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *InstructionTag) Reset() {
-	*x = InstructionTag{}
+func (x *RegisterUserRequest) Reset() {
+	*x = RegisterUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tally_v1_board_proto_msgTypes[37]
+		mi := &file_proto_tally_v1_board_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstructionTag) String() string {
+func (x *RegisterUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstructionTag) ProtoMessage() {}
+func (*RegisterUserRequest) ProtoMessage() {}
 
-func (x *InstructionTag) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tally_v1_board_proto_msgTypes[37]
+func (x *RegisterUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3129,674 +3800,3770 @@ func (x *InstructionTag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstructionTag.ProtoReflect.Descriptor instead.
-func (*InstructionTag) Descriptor() ([]byte, []int) {
-	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RegisterUserRequest.ProtoReflect.Descriptor instead.
+func (*RegisterUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *InstructionTag) GetOk() bool {
+func (x *RegisterUserRequest) GetUsername() string {
 	if x != nil {
-		return x.Ok
+		return x.Username
 	}
-	return false
+	return ""
 }
 
-func (x *InstructionTag) GetIsMultiplication() bool {
+func (x *RegisterUserRequest) GetPassword() string {
 	if x != nil {
-		return x.IsMultiplication
+		return x.Password
 	}
-	return false
+	return ""
 }
 
-func (x *InstructionTag) GetIsAddition() bool {
-	if x != nil {
-		return x.IsAddition
-	}
-	return false
-}
+type RegisterUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *InstructionTag) GetIsSwipe() bool {
-	if x != nil {
-		return x.IsSwipe
-	}
-	return false
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 }
 
-func (x *InstructionTag) GetTwoPow() uint64 {
-	if x != nil {
-		return x.TwoPow
+func (x *RegisterUserResponse) Reset() {
+	*x = RegisterUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-var File_proto_tally_v1_board_proto protoreflect.FileDescriptor
-
-var file_proto_tally_v1_board_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x61,
-	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x22, 0x32, 0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x12,
-	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x61,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x6f, 0x77, 0x22, 0x7a, 0x0a, 0x13, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
-	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x52, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x7f, 0x0a, 0x05, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65,
-	0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x0b,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x73,
-	0x77, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c,
-	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x70, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x73, 0x77, 0x69, 0x70, 0x65, 0x12, 0x2d, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x73, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x05,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x72, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x68,
-	0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x48, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e,
-	0x68, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x0d, 0x0a,
-	0x0b, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61, 0x0a, 0x0c,
-	0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61,
-	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x22,
-	0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x13, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x77,
-	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x48, 0x00, 0x52,
-	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0b, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x00, 0x52, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64,
-	0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x1a,
-	0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64,
-	0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
-	0x64, 0x65, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
-	0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05,
-	0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61,
-	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c,
-	0x6c, 0x73, 0x22, 0x68, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0xae, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x22, 0xba, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f,
-	0x76, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x4b, 0x0a,
-	0x11, 0x53, 0x77, 0x69, 0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x77, 0x69, 0x70, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x53,
-	0x77, 0x69, 0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x64, 0x69, 0x64, 0x57, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x69, 0x64,
-	0x5f, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x64,
-	0x4c, 0x6f, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d,
-	0x6f, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65,
-	0x73, 0x22, 0x28, 0x0a, 0x0a, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12,
-	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
-	0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x79, 0x22, 0x1f, 0x0a, 0x07, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x4c, 0x0a, 0x14,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x0a,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x43,
-	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x73, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63,
-	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x62, 0x69,
-	0x6e, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76,
-	0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x64, 0x57, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64,
-	0x69, 0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
-	0x69, 0x64, 0x4c, 0x6f, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x5f, 0x76,
-	0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x07, 0x66, 0x75, 0x6e, 0x56, 0x6f,
-	0x74, 0x65, 0x22, 0x4e, 0x0a, 0x11, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x5f, 0x76,
-	0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x07, 0x66, 0x75, 0x6e, 0x56, 0x6f,
-	0x74, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c,
-	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x26,
-	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74,
-	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x73,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22, 0xb3, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f,
-	0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x11,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
-	0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
-	0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61,
-	0x78, 0x42, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6d,
-	0x6f, 0x76, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4d,
-	0x6f, 0x76, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x6f, 0x76, 0x65,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4d, 0x6f, 0x76, 0x65,
-	0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x12, 0x6d, 0x61, 0x78, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x65,
-	0x6c, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x63, 0x65,
-	0x6c, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x43, 0x65, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x74,
-	0x68, 0x5f, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x3a, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
-	0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0xfa, 0x01, 0x0a,
-	0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65,
-	0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x69, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64,
-	0x65, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x68,
-	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x12, 0x2c, 0x0a, 0x09, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
-	0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xfa, 0x03, 0x0a, 0x0d,
-	0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a,
-	0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61,
-	0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69,
-	0x64, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65,
-	0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x69, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x65,
-	0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73,
-	0x12, 0x37, 0x0a, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x42, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x66, 0x65, 0x77, 0x65, 0x73,
-	0x74, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x65, 0x77, 0x65, 0x73,
-	0x74, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0xbf, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x6f,
-	0x76, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
-	0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
-	0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x58, 0x0a, 0x1b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x22, 0xe1, 0x04, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2b,
-	0x0a, 0x11, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x64, 0x75, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x64,
-	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x48, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x3b, 0x0a, 0x1a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x5f, 0x73,
-	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x17, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x53,
-	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x18,
-	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15,
-	0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d,
-	0x6f, 0x76, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x61,
-	0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x6e, 0x49, 0x64, 0x65, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x73, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0d, 0x73, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x7d, 0x0a, 0x0c, 0x53, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x73,
-	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x61, 0x64,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
-	0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73,
-	0x77, 0x69, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x53, 0x77,
-	0x69, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x77, 0x6f, 0x5f, 0x70, 0x6f, 0x77, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x50, 0x6f, 0x77, 0x2a, 0x98, 0x01, 0x0a,
-	0x0e, 0x53, 0x77, 0x69, 0x70, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1f, 0x0a, 0x1b, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x16, 0x0a, 0x12, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x57, 0x49, 0x50,
-	0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x49, 0x47, 0x48,
-	0x54, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52,
-	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x18, 0x0a,
-	0x14, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x04, 0x2a, 0x73, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
-	0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44,
-	0x4f, 0x4d, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x54, 0x55, 0x54, 0x4f, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
-	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d,
-	0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x2a, 0x69, 0x0a, 0x0a,
-	0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49,
-	0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43,
-	0x55, 0x4c, 0x54, 0x59, 0x5f, 0x45, 0x41, 0x53, 0x59, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x44,
-	0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d,
-	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59,
-	0x5f, 0x48, 0x41, 0x52, 0x44, 0x10, 0x03, 0x2a, 0xeb, 0x01, 0x0a, 0x0e, 0x48, 0x69, 0x6e, 0x74,
-	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x48, 0x49,
-	0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x48,
-	0x49, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x53,
-	0x48, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x49, 0x4e, 0x54, 0x5f, 0x50,
-	0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x45, 0x53,
-	0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x48, 0x49, 0x4e,
-	0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x49, 0x4e,
-	0x49, 0x4d, 0x55, 0x4d, 0x5f, 0x53, 0x57, 0x49, 0x50, 0x45, 0x53, 0x10, 0x04, 0x12, 0x33, 0x0a,
-	0x2f, 0x48, 0x49, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45,
-	0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x55, 0x4d, 0x5f, 0x53, 0x57, 0x49, 0x50, 0x45, 0x53, 0x5f,
-	0x54, 0x4f, 0x5f, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4f,
-	0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x49, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45,
-	0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x42,
-	0x49, 0x4e, 0x45, 0x10, 0x06, 0x2a, 0x73, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x10, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x52,
-	0x49, 0x42, 0x4c, 0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x4f, 0x54, 0x45,
-	0x5f, 0x42, 0x41, 0x44, 0x5f, 0x32, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x4f, 0x54, 0x45,
-	0x5f, 0x4f, 0x4b, 0x5f, 0x33, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x4f, 0x54, 0x45, 0x5f,
-	0x47, 0x4f, 0x4f, 0x44, 0x5f, 0x34, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x4f, 0x54, 0x45,
-	0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x5f, 0x35, 0x10, 0x05, 0x2a, 0x7e, 0x0a, 0x12, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x12, 0x23, 0x0a, 0x1f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x4c,
-	0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54,
-	0x4f, 0x52, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x52, 0x41, 0x4e,
-	0x44, 0x4f, 0x4d, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x45, 0x4e,
-	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d,
-	0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x02, 0x2a, 0x9e, 0x01, 0x0a, 0x06, 0x52,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
-	0x0f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x4b, 0x10,
-	0x14, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x45, 0x4c, 0x4c,
-	0x10, 0x28, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x4f, 0x4f,
-	0x44, 0x10, 0x3c, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x52,
-	0x45, 0x41, 0x54, 0x10, 0x50, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f,
-	0x53, 0x55, 0x50, 0x45, 0x52, 0x42, 0x10, 0x64, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x41, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x42, 0x45, 0x59, 0x4f, 0x4e, 0x44, 0x10, 0x78, 0x32, 0xc5, 0x07, 0x0a, 0x0c,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x07,
-	0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77,
-	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
-	0x0a, 0x13, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x61,
-	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12,
-	0x18, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x15,
-	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1c,
-	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74,
-	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x74, 0x61,
-	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x77, 0x69, 0x70,
-	0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x77, 0x69, 0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x77, 0x69, 0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x43, 0x65,
-	0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x47, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
-	0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x73, 0x12, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a,
-	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x61, 0x6c,
-	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x72, 0x75, 0x6e, 0x61, 0x72, 0x2d, 0x72, 0x6b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2f,
-	0x67, 0x6f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *RegisterUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var (
-	file_proto_tally_v1_board_proto_rawDescOnce sync.Once
-	file_proto_tally_v1_board_proto_rawDescData = file_proto_tally_v1_board_proto_rawDesc
-)
+func (*RegisterUserResponse) ProtoMessage() {}
 
-func file_proto_tally_v1_board_proto_rawDescGZIP() []byte {
-	file_proto_tally_v1_board_proto_rawDescOnce.Do(func() {
-		file_proto_tally_v1_board_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_tally_v1_board_proto_rawDescData)
-	})
-	return file_proto_tally_v1_board_proto_rawDescData
+func (x *RegisterUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_proto_tally_v1_board_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_proto_tally_v1_board_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
-var file_proto_tally_v1_board_proto_goTypes = []interface{}{
-	(SwipeDirection)(0),                 // 0: tally.v1.SwipeDirection
-	(GameMode)(0),                       // 1: tally.v1.GameMode
-	(Difficulty)(0),                     // 2: tally.v1.Difficulty
-	(HintPreference)(0),                 // 3: tally.v1.HintPreference
-	(Vote)(0),                           // 4: tally.v1.Vote
-	(GeneratorAlgorithm)(0),             // 5: tally.v1.GeneratorAlgorithm
-	(Rating)(0),                         // 6: tally.v1.Rating
-	(*Cell)(nil),                        // 7: tally.v1.Cell
-	(*InternalDataHistory)(nil),         // 8: tally.v1.InternalDataHistory
-	(*InternalDataGame)(nil),            // 9: tally.v1.InternalDataGame
-	(*Board)(nil),                       // 10: tally.v1.Board
-	(*Instruction)(nil),                 // 11: tally.v1.Instruction
-	(*GetHintRequest)(nil),              // 12: tally.v1.GetHintRequest
-	(*UndoRequest)(nil),                 // 13: tally.v1.UndoRequest
-	(*UndoResponse)(nil),                // 14: tally.v1.UndoResponse
-	(*GetHintResponse)(nil),             // 15: tally.v1.GetHintResponse
-	(*GetSessionRequest)(nil),           // 16: tally.v1.GetSessionRequest
-	(*RestartGameRequest)(nil),          // 17: tally.v1.RestartGameRequest
-	(*NewGameRequest)(nil),              // 18: tally.v1.NewGameRequest
-	(*NewGameFromTemplateRequest)(nil),  // 19: tally.v1.NewGameFromTemplateRequest
-	(*RestartGameResponse)(nil),         // 20: tally.v1.RestartGameResponse
-	(*GetSessionResponse)(nil),          // 21: tally.v1.GetSessionResponse
-	(*NewGameResponse)(nil),             // 22: tally.v1.NewGameResponse
-	(*NewGameFromTemplateResponse)(nil), // 23: tally.v1.NewGameFromTemplateResponse
-	(*SwipeBoardRequest)(nil),           // 24: tally.v1.SwipeBoardRequest
-	(*SwipeBoardResponse)(nil),          // 25: tally.v1.SwipeBoardResponse
-	(*Coordinate)(nil),                  // 26: tally.v1.Coordinate
-	(*Indexes)(nil),                     // 27: tally.v1.Indexes
-	(*SelectionCoordinates)(nil),        // 28: tally.v1.SelectionCoordinates
-	(*CombineCellsRequest)(nil),         // 29: tally.v1.CombineCellsRequest
-	(*CombineCellsResponse)(nil),        // 30: tally.v1.CombineCellsResponse
-	(*VoteBoardRequest)(nil),            // 31: tally.v1.VoteBoardRequest
-	(*VoteBoardResponse)(nil),           // 32: tally.v1.VoteBoardResponse
-	(*Game)(nil),                        // 33: tally.v1.Game
-	(*Session)(nil),                     // 34: tally.v1.Session
-	(*GenerateGameRequest)(nil),         // 35: tally.v1.GenerateGameRequest
-	(*GenerateGameResponse)(nil),        // 36: tally.v1.GenerateGameResponse
-	(*GetGameChallengesRequest)(nil),    // 37: tally.v1.GetGameChallengesRequest
-	(*GetGameChallengesResponse)(nil),   // 38: tally.v1.GetGameChallengesResponse
-	(*GameChallenge)(nil),               // 39: tally.v1.GameChallenge
-	(*CreateGameChallengeRequest)(nil),  // 40: tally.v1.CreateGameChallengeRequest
-	(*CreateGameChallengeResponse)(nil), // 41: tally.v1.CreateGameChallengeResponse
-	(*GameStats)(nil),                   // 42: tally.v1.GameStats
-	(*SolutionStat)(nil),                // 43: tally.v1.SolutionStat
-	(*InstructionTag)(nil),              // 44: tally.v1.InstructionTag
-}
-var file_proto_tally_v1_board_proto_depIdxs = []int32{
-	11, // 0: tally.v1.InternalDataHistory.instruction:type_name -> tally.v1.Instruction
-	7,  // 1: tally.v1.Board.cells:type_name -> tally.v1.Cell
-	0,  // 2: tally.v1.Instruction.swipe:type_name -> tally.v1.SwipeDirection
-	27, // 3: tally.v1.Instruction.combine:type_name -> tally.v1.Indexes
-	3,  // 4: tally.v1.GetHintRequest.hint_preference:type_name -> tally.v1.HintPreference
-	10, // 5: tally.v1.UndoResponse.board:type_name -> tally.v1.Board
-	11, // 6: tally.v1.GetHintResponse.instructions:type_name -> tally.v1.Instruction
-	1,  // 7: tally.v1.NewGameRequest.mode:type_name -> tally.v1.GameMode
-	2,  // 8: tally.v1.NewGameRequest.difficulty:type_name -> tally.v1.Difficulty
-	7,  // 9: tally.v1.NewGameFromTemplateRequest.cells:type_name -> tally.v1.Cell
-	10, // 10: tally.v1.RestartGameResponse.board:type_name -> tally.v1.Board
-	34, // 11: tally.v1.GetSessionResponse.session:type_name -> tally.v1.Session
-	10, // 12: tally.v1.NewGameResponse.board:type_name -> tally.v1.Board
-	1,  // 13: tally.v1.NewGameResponse.mode:type_name -> tally.v1.GameMode
-	10, // 14: tally.v1.NewGameFromTemplateResponse.board:type_name -> tally.v1.Board
-	1,  // 15: tally.v1.NewGameFromTemplateResponse.mode:type_name -> tally.v1.GameMode
-	0,  // 16: tally.v1.SwipeBoardRequest.direction:type_name -> tally.v1.SwipeDirection
-	10, // 17: tally.v1.SwipeBoardResponse.board:type_name -> tally.v1.Board
-	26, // 18: tally.v1.SelectionCoordinates.coordinate:type_name -> tally.v1.Coordinate
-	27, // 19: tally.v1.CombineCellsRequest.indexes:type_name -> tally.v1.Indexes
-	26, // 20: tally.v1.CombineCellsRequest.coordinate:type_name -> tally.v1.Coordinate
-	10, // 21: tally.v1.CombineCellsResponse.board:type_name -> tally.v1.Board
-	4,  // 22: tally.v1.VoteBoardRequest.fun_vote:type_name -> tally.v1.Vote
-	4,  // 23: tally.v1.VoteBoardResponse.fun_vote:type_name -> tally.v1.Vote
-	10, // 24: tally.v1.Game.board:type_name -> tally.v1.Board
-	1,  // 25: tally.v1.Game.mode:type_name -> tally.v1.GameMode
-	33, // 26: tally.v1.Session.game:type_name -> tally.v1.Game
-	5,  // 27: tally.v1.GenerateGameRequest.algorithm:type_name -> tally.v1.GeneratorAlgorithm
-	33, // 28: tally.v1.GenerateGameResponse.game:type_name -> tally.v1.Game
-	33, // 29: tally.v1.GenerateGameResponse.solutions:type_name -> tally.v1.Game
-	42, // 30: tally.v1.GenerateGameResponse.stats:type_name -> tally.v1.GameStats
-	39, // 31: tally.v1.GetGameChallengesResponse.challenges:type_name -> tally.v1.GameChallenge
-	7,  // 32: tally.v1.GameChallenge.cells:type_name -> tally.v1.Cell
-	6,  // 33: tally.v1.GameChallenge.rating:type_name -> tally.v1.Rating
-	7,  // 34: tally.v1.CreateGameChallengeRequest.cells:type_name -> tally.v1.Cell
-	11, // 35: tally.v1.GameStats.hints:type_name -> tally.v1.Instruction
-	43, // 36: tally.v1.GameStats.solution_stats:type_name -> tally.v1.SolutionStat
-	44, // 37: tally.v1.SolutionStat.instruction_tag:type_name -> tally.v1.InstructionTag
-	18, // 38: tally.v1.BoardService.NewGame:input_type -> tally.v1.NewGameRequest
-	19, // 39: tally.v1.BoardService.NewGameFromTemplate:input_type -> tally.v1.NewGameFromTemplateRequest
-	12, // 40: tally.v1.BoardService.GetHint:input_type -> tally.v1.GetHintRequest
-	13, // 41: tally.v1.BoardService.Undo:input_type -> tally.v1.UndoRequest
-	17, // 42: tally.v1.BoardService.RestartGame:input_type -> tally.v1.RestartGameRequest
-	16, // 43: tally.v1.BoardService.GetSession:input_type -> tally.v1.GetSessionRequest
-	24, // 44: tally.v1.BoardService.SwipeBoard:input_type -> tally.v1.SwipeBoardRequest
-	29, // 45: tally.v1.BoardService.CombineCells:input_type -> tally.v1.CombineCellsRequest
-	35, // 46: tally.v1.BoardService.GenerateGame:input_type -> tally.v1.GenerateGameRequest
-	31, // 47: tally.v1.BoardService.VoteBoard:input_type -> tally.v1.VoteBoardRequest
-	37, // 48: tally.v1.BoardService.GetGameChallenges:input_type -> tally.v1.GetGameChallengesRequest
-	40, // 49: tally.v1.BoardService.CreateGameChallenge:input_type -> tally.v1.CreateGameChallengeRequest
-	22, // 50: tally.v1.BoardService.NewGame:output_type -> tally.v1.NewGameResponse
-	23, // 51: tally.v1.BoardService.NewGameFromTemplate:output_type -> tally.v1.NewGameFromTemplateResponse
-	15, // 52: tally.v1.BoardService.GetHint:output_type -> tally.v1.GetHintResponse
-	14, // 53: tally.v1.BoardService.Undo:output_type -> tally.v1.UndoResponse
-	20, // 54: tally.v1.BoardService.RestartGame:output_type -> tally.v1.RestartGameResponse
-	21, // 55: tally.v1.BoardService.GetSession:output_type -> tally.v1.GetSessionResponse
-	25, // 56: tally.v1.BoardService.SwipeBoard:output_type -> tally.v1.SwipeBoardResponse
-	30, // 57: tally.v1.BoardService.CombineCells:output_type -> tally.v1.CombineCellsResponse
-	36, // 58: tally.v1.BoardService.GenerateGame:output_type -> tally.v1.GenerateGameResponse
-	32, // 59: tally.v1.BoardService.VoteBoard:output_type -> tally.v1.VoteBoardResponse
-	38, // 60: tally.v1.BoardService.GetGameChallenges:output_type -> tally.v1.GetGameChallengesResponse
-	41, // 61: tally.v1.BoardService.CreateGameChallenge:output_type -> tally.v1.CreateGameChallengeResponse
-	50, // [50:62] is the sub-list for method output_type
-	38, // [38:50] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+// Deprecated: Use RegisterUserResponse.ProtoReflect.Descriptor instead.
+func (*RegisterUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{43}
 }
 
-func init() { file_proto_tally_v1_board_proto_init() }
-func file_proto_tally_v1_board_proto_init() {
-	if File_proto_tally_v1_board_proto != nil {
-		return
+func (x *RegisterUserResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// Attaches the current session to a registered user
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LoginResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// Creates a one-time code, which can be used from another device to attach
+// its session to the current user.
+type CreateLinkCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateLinkCodeRequest) Reset() {
+	*x = CreateLinkCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkCodeRequest) ProtoMessage() {}
+
+func (x *CreateLinkCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{46}
+}
+
+type CreateLinkCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Number of seconds until the code expires
+	ExpiresInSeconds uint32 `protobuf:"varint,2,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+}
+
+func (x *CreateLinkCodeResponse) Reset() {
+	*x = CreateLinkCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkCodeResponse) ProtoMessage() {}
+
+func (x *CreateLinkCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreateLinkCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateLinkCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateLinkCodeResponse) GetExpiresInSeconds() uint32 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type UseLinkCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *UseLinkCodeRequest) Reset() {
+	*x = UseLinkCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UseLinkCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseLinkCodeRequest) ProtoMessage() {}
+
+func (x *UseLinkCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseLinkCodeRequest.ProtoReflect.Descriptor instead.
+func (*UseLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UseLinkCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type UseLinkCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *UseLinkCodeResponse) Reset() {
+	*x = UseLinkCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UseLinkCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseLinkCodeResponse) ProtoMessage() {}
+
+func (x *UseLinkCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseLinkCodeResponse.ProtoReflect.Descriptor instead.
+func (*UseLinkCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UseLinkCodeResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type CreateShareCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Include the moves made so far, so that the game can be replayed
+	IncludeHistory bool `protobuf:"varint,1,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+}
+
+func (x *CreateShareCodeRequest) Reset() {
+	*x = CreateShareCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShareCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareCodeRequest) ProtoMessage() {}
+
+func (x *CreateShareCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateShareCodeRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+type CreateShareCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A URL-safe code for the current game, as it was at the start
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CreateShareCodeResponse) Reset() {
+	*x = CreateShareCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShareCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareCodeResponse) ProtoMessage() {}
+
+func (x *CreateShareCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreateShareCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateShareCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type NewGameFromShareCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *NewGameFromShareCodeRequest) Reset() {
+	*x = NewGameFromShareCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewGameFromShareCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGameFromShareCodeRequest) ProtoMessage() {}
+
+func (x *NewGameFromShareCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGameFromShareCodeRequest.ProtoReflect.Descriptor instead.
+func (*NewGameFromShareCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *NewGameFromShareCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type NewGameFromShareCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board       *Board   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Score       int64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves       int64    `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Mode        GameMode `protobuf:"varint,5,opt,name=mode,proto3,enum=tally.v1.GameMode" json:"mode,omitempty"`
+	// The moves of the shared game, if the share-code included them
+	History []*Instruction `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *NewGameFromShareCodeResponse) Reset() {
+	*x = NewGameFromShareCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewGameFromShareCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGameFromShareCodeResponse) ProtoMessage() {}
+
+func (x *NewGameFromShareCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGameFromShareCodeResponse.ProtoReflect.Descriptor instead.
+func (*NewGameFromShareCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NewGameFromShareCodeResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *NewGameFromShareCodeResponse) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *NewGameFromShareCodeResponse) GetMoves() int64 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+func (x *NewGameFromShareCodeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NewGameFromShareCodeResponse) GetMode() GameMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GameMode_GAME_MODE_UNSPECIFIED
+}
+
+func (x *NewGameFromShareCodeResponse) GetHistory() []*Instruction {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type GenerateGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows               uint32             `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns            uint32             `protobuf:"varint,2,opt,name=columns,proto3" json:"columns,omitempty"`
+	TargetCellValue    uint64             `protobuf:"varint,3,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
+	MaxBricks          uint32             `protobuf:"varint,4,opt,name=max_bricks,json=maxBricks,proto3" json:"max_bricks,omitempty"`
+	MinMoves           uint64             `protobuf:"varint,6,opt,name=min_moves,json=minMoves,proto3" json:"min_moves,omitempty"`
+	MaxMoves           uint64             `protobuf:"varint,7,opt,name=max_moves,json=maxMoves,proto3" json:"max_moves,omitempty"`
+	MaxAdditionalCells uint64             `protobuf:"varint,8,opt,name=max_additional_cells,json=maxAdditionalCells,proto3" json:"max_additional_cells,omitempty"`
+	RandomCellChance   uint64             `protobuf:"varint,9,opt,name=random_cell_chance,json=randomCellChance,proto3" json:"random_cell_chance,omitempty"`
+	Seed               uint64             `protobuf:"varint,10,opt,name=seed,proto3" json:"seed,omitempty"`
+	Salt               uint64             `protobuf:"varint,11,opt,name=salt,proto3" json:"salt,omitempty"`
+	WithSolutions      bool               `protobuf:"varint,12,opt,name=with_solutions,json=withSolutions,proto3" json:"with_solutions,omitempty"`
+	Algorithm          GeneratorAlgorithm `protobuf:"varint,13,opt,name=algorithm,proto3,enum=tally.v1.GeneratorAlgorithm" json:"algorithm,omitempty"`
+}
+
+func (x *GenerateGameRequest) Reset() {
+	*x = GenerateGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateGameRequest) ProtoMessage() {}
+
+func (x *GenerateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateGameRequest.ProtoReflect.Descriptor instead.
+func (*GenerateGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GenerateGameRequest) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetColumns() uint32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetTargetCellValue() uint64 {
+	if x != nil {
+		return x.TargetCellValue
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetMaxBricks() uint32 {
+	if x != nil {
+		return x.MaxBricks
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetMinMoves() uint64 {
+	if x != nil {
+		return x.MinMoves
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetMaxMoves() uint64 {
+	if x != nil {
+		return x.MaxMoves
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetMaxAdditionalCells() uint64 {
+	if x != nil {
+		return x.MaxAdditionalCells
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetRandomCellChance() uint64 {
+	if x != nil {
+		return x.RandomCellChance
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetSalt() uint64 {
+	if x != nil {
+		return x.Salt
+	}
+	return 0
+}
+
+func (x *GenerateGameRequest) GetWithSolutions() bool {
+	if x != nil {
+		return x.WithSolutions
+	}
+	return false
+}
+
+func (x *GenerateGameRequest) GetAlgorithm() GeneratorAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return GeneratorAlgorithm_GENERATOR_ALGORITHM_UNSPECIFIED
+}
+
+type GenerateGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game         *Game      `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	IdealMoves   uint32     `protobuf:"varint,2,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
+	IdealScore   uint64     `protobuf:"varint,3,opt,name=ideal_score,json=idealScore,proto3" json:"ideal_score,omitempty"`
+	HighestScore uint64     `protobuf:"varint,4,opt,name=highest_score,json=highestScore,proto3" json:"highest_score,omitempty"`
+	Solutions    []*Game    `protobuf:"bytes,5,rep,name=solutions,proto3" json:"solutions,omitempty"`
+	Stats        *GameStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GenerateGameResponse) Reset() {
+	*x = GenerateGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateGameResponse) ProtoMessage() {}
+
+func (x *GenerateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateGameResponse.ProtoReflect.Descriptor instead.
+func (*GenerateGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GenerateGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GenerateGameResponse) GetIdealMoves() uint32 {
+	if x != nil {
+		return x.IdealMoves
+	}
+	return 0
+}
+
+func (x *GenerateGameResponse) GetIdealScore() uint64 {
+	if x != nil {
+		return x.IdealScore
+	}
+	return 0
+}
+
+func (x *GenerateGameResponse) GetHighestScore() uint64 {
+	if x != nil {
+		return x.HighestScore
+	}
+	return 0
+}
+
+func (x *GenerateGameResponse) GetSolutions() []*Game {
+	if x != nil {
+		return x.Solutions
+	}
+	return nil
+}
+
+func (x *GenerateGameResponse) GetStats() *GameStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GenerateGameStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *GenerateGameRequest `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// The stream ends after this many candidates have been sent. Defaults to 10
+	MaxCandidates uint32 `protobuf:"varint,2,opt,name=max_candidates,json=maxCandidates,proto3" json:"max_candidates,omitempty"`
+}
+
+func (x *GenerateGameStreamRequest) Reset() {
+	*x = GenerateGameStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateGameStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateGameStreamRequest) ProtoMessage() {}
+
+func (x *GenerateGameStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateGameStreamRequest.ProtoReflect.Descriptor instead.
+func (*GenerateGameStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GenerateGameStreamRequest) GetOptions() *GenerateGameRequest {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GenerateGameStreamRequest) GetMaxCandidates() uint32 {
+	if x != nil {
+		return x.MaxCandidates
+	}
+	return 0
+}
+
+type GeneratorProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of boards generated
+	Iterations uint64 `protobuf:"varint,1,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// Number of unique boards visited by the solvers
+	SolverVisits uint64 `protobuf:"varint,2,opt,name=solver_visits,json=solverVisits,proto3" json:"solver_visits,omitempty"`
+	// Number of solvable games found
+	Candidates uint64 `protobuf:"varint,3,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	// The number of rejected boards, grouped by the reason
+	Rejections map[string]uint64 `protobuf:"bytes,4,rep,name=rejections,proto3" json:"rejections,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GeneratorProgress) Reset() {
+	*x = GeneratorProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratorProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratorProgress) ProtoMessage() {}
+
+func (x *GeneratorProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratorProgress.ProtoReflect.Descriptor instead.
+func (*GeneratorProgress) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GeneratorProgress) GetIterations() uint64 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *GeneratorProgress) GetSolverVisits() uint64 {
+	if x != nil {
+		return x.SolverVisits
+	}
+	return 0
+}
+
+func (x *GeneratorProgress) GetCandidates() uint64 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+func (x *GeneratorProgress) GetRejections() map[string]uint64 {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+type GenerateGameStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Progress *GeneratorProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Set when a solvable game was found
+	Candidate *GenerateGameResponse `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+}
+
+func (x *GenerateGameStreamResponse) Reset() {
+	*x = GenerateGameStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateGameStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateGameStreamResponse) ProtoMessage() {}
+
+func (x *GenerateGameStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateGameStreamResponse.ProtoReflect.Descriptor instead.
+func (*GenerateGameStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GenerateGameStreamResponse) GetProgress() *GeneratorProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *GenerateGameStreamResponse) GetCandidate() *GenerateGameResponse {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+type GetGameChallengesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetGameChallengesRequest) Reset() {
+	*x = GetGameChallengesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameChallengesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameChallengesRequest) ProtoMessage() {}
+
+func (x *GetGameChallengesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameChallengesRequest.ProtoReflect.Descriptor instead.
+func (*GetGameChallengesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{59}
+}
+
+type GetGameChallengesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Challenges []*GameChallenge `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+}
+
+func (x *GetGameChallengesResponse) Reset() {
+	*x = GetGameChallengesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameChallengesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameChallengesResponse) ProtoMessage() {}
+
+func (x *GetGameChallengesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameChallengesResponse.ProtoReflect.Descriptor instead.
+func (*GetGameChallengesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetGameChallengesResponse) GetChallenges() []*GameChallenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+type GameChallenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChallengeNumber         uint32  `protobuf:"varint,2,opt,name=challenge_number,json=challengeNumber,proto3" json:"challenge_number,omitempty"`
+	IdealMoves              uint32  `protobuf:"varint,3,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
+	IdealScore              uint64  `protobuf:"varint,4,opt,name=ideal_score,json=idealScore,proto3" json:"ideal_score,omitempty"`
+	TargetCellValue         uint64  `protobuf:"varint,5,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
+	Columns                 uint32  `protobuf:"varint,6,opt,name=columns,proto3" json:"columns,omitempty"`
+	Rows                    uint32  `protobuf:"varint,7,opt,name=rows,proto3" json:"rows,omitempty"`
+	Name                    string  `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Description             string  `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	Cells                   []*Cell `protobuf:"bytes,10,rep,name=cells,proto3" json:"cells,omitempty"`
+	CurrentUsersBestScore   uint64  `protobuf:"varint,11,opt,name=current_users_best_score,json=currentUsersBestScore,proto3" json:"current_users_best_score,omitempty"`
+	CurrentUsersFewestMoves uint32  `protobuf:"varint,12,opt,name=current_users_fewest_moves,json=currentUsersFewestMoves,proto3" json:"current_users_fewest_moves,omitempty"`
+	// Current rating the user has for the challenge, if played and solved.
+	Rating Rating `protobuf:"varint,13,opt,name=rating,proto3,enum=tally.v1.Rating" json:"rating,omitempty"`
+	// Indicated that the challenge is locked.
+	Locked bool `protobuf:"varint,14,opt,name=locked,proto3" json:"locked,omitempty"`
+	// Number of votes registered for the challenge
+	VoteCount uint64 `protobuf:"varint,15,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	// Sum of all the fun-votes registered for the challenge
+	FunVoteTotal uint64 `protobuf:"varint,16,opt,name=fun_vote_total,json=funVoteTotal,proto3" json:"fun_vote_total,omitempty"`
+}
+
+func (x *GameChallenge) Reset() {
+	*x = GameChallenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameChallenge) ProtoMessage() {}
+
+func (x *GameChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameChallenge.ProtoReflect.Descriptor instead.
+func (*GameChallenge) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GameChallenge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameChallenge) GetChallengeNumber() uint32 {
+	if x != nil {
+		return x.ChallengeNumber
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetIdealMoves() uint32 {
+	if x != nil {
+		return x.IdealMoves
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetIdealScore() uint64 {
+	if x != nil {
+		return x.IdealScore
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetTargetCellValue() uint64 {
+	if x != nil {
+		return x.TargetCellValue
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetColumns() uint32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameChallenge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GameChallenge) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *GameChallenge) GetCurrentUsersBestScore() uint64 {
+	if x != nil {
+		return x.CurrentUsersBestScore
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetCurrentUsersFewestMoves() uint32 {
+	if x != nil {
+		return x.CurrentUsersFewestMoves
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetRating() Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return Rating_RATING_UNSPECIFIED
+}
+
+func (x *GameChallenge) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *GameChallenge) GetVoteCount() uint64 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+func (x *GameChallenge) GetFunVoteTotal() uint64 {
+	if x != nil {
+		return x.FunVoteTotal
+	}
+	return 0
+}
+
+type GetChallengeLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeId string `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// Maximum number of entries to return. Defaults to 20, and is capped at 100
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Number of entries to skip
+	Offset uint32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetChallengeLeaderboardRequest) Reset() {
+	*x = GetChallengeLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChallengeLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetChallengeLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengeLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetChallengeLeaderboardRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *GetChallengeLeaderboardRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetChallengeLeaderboardRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetChallengeLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Number of users that have won the challenge
+	TotalPlayers uint32 `protobuf:"varint,2,opt,name=total_players,json=totalPlayers,proto3" json:"total_players,omitempty"`
+	// The current users entry, if they have won the challenge
+	CurrentUser *LeaderboardEntry `protobuf:"bytes,3,opt,name=current_user,json=currentUser,proto3" json:"current_user,omitempty"`
+}
+
+func (x *GetChallengeLeaderboardResponse) Reset() {
+	*x = GetChallengeLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChallengeLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetChallengeLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetChallengeLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetChallengeLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetChallengeLeaderboardResponse) GetTotalPlayers() uint32 {
+	if x != nil {
+		return x.TotalPlayers
+	}
+	return 0
+}
+
+func (x *GetChallengeLeaderboardResponse) GetCurrentUser() *LeaderboardEntry {
+	if x != nil {
+		return x.CurrentUser
+	}
+	return nil
+}
+
+// A users best attempt at a challenge, ranked by moves, then score, then time.
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Users with equal moves, score and time share the same rank
+	Rank     uint32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId   string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Score    uint64 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Moves    uint32 `protobuf:"varint,5,opt,name=moves,proto3" json:"moves,omitempty"`
+	GameId   string `protobuf:"bytes,6,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LeaderboardEntry) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetMoves() uint32 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// The challenge of a single day, with the same board for every user.
+// Only the first attempt of each user is ranked.
+type DailyChallenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The day of the challenge, formatted as YYYY-MM-DD. Days are in UTC.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Can be played with NewGame, using the id of the challenge
+	Challenge *GameChallenge `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The current users ranked attempt, if they have started one
+	RankedAttempt *DailyAttempt `protobuf:"bytes,3,opt,name=ranked_attempt,json=rankedAttempt,proto3" json:"ranked_attempt,omitempty"`
+}
+
+func (x *DailyChallenge) Reset() {
+	*x = DailyChallenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyChallenge) ProtoMessage() {}
+
+func (x *DailyChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyChallenge.ProtoReflect.Descriptor instead.
+func (*DailyChallenge) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DailyChallenge) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyChallenge) GetChallenge() *GameChallenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *DailyChallenge) GetRankedAttempt() *DailyAttempt {
+	if x != nil {
+		return x.RankedAttempt
+	}
+	return nil
+}
+
+type DailyAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId    string    `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Score     uint64    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Moves     uint32    `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	PlayState PlayState `protobuf:"varint,4,opt,name=play_state,json=playState,proto3,enum=tally.v1.PlayState" json:"play_state,omitempty"`
+}
+
+func (x *DailyAttempt) Reset() {
+	*x = DailyAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyAttempt) ProtoMessage() {}
+
+func (x *DailyAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyAttempt.ProtoReflect.Descriptor instead.
+func (*DailyAttempt) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DailyAttempt) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DailyAttempt) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DailyAttempt) GetMoves() uint32 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+func (x *DailyAttempt) GetPlayState() PlayState {
+	if x != nil {
+		return x.PlayState
+	}
+	return PlayState_PLAY_STATE_UNSPECIFIED
+}
+
+type GetDailyChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Formatted as YYYY-MM-DD. Defaults to today
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *GetDailyChallengeRequest) Reset() {
+	*x = GetDailyChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyChallengeRequest) ProtoMessage() {}
+
+func (x *GetDailyChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetDailyChallengeRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetDailyChallengeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DailyChallenge *DailyChallenge `protobuf:"bytes,1,opt,name=daily_challenge,json=dailyChallenge,proto3" json:"daily_challenge,omitempty"`
+}
+
+func (x *GetDailyChallengeResponse) Reset() {
+	*x = GetDailyChallengeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyChallengeResponse) ProtoMessage() {}
+
+func (x *GetDailyChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyChallengeResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetDailyChallengeResponse) GetDailyChallenge() *DailyChallenge {
+	if x != nil {
+		return x.DailyChallenge
+	}
+	return nil
+}
+
+// Lists the previous daily challenges, the most recent day first
+type ListDailyChallengesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of challenges to return. Defaults to 20, and is capped at 100
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Number of challenges to skip
+	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListDailyChallengesRequest) Reset() {
+	*x = ListDailyChallengesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDailyChallengesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDailyChallengesRequest) ProtoMessage() {}
+
+func (x *ListDailyChallengesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDailyChallengesRequest.ProtoReflect.Descriptor instead.
+func (*ListDailyChallengesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListDailyChallengesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDailyChallengesRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDailyChallengesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DailyChallenges []*DailyChallenge `protobuf:"bytes,1,rep,name=daily_challenges,json=dailyChallenges,proto3" json:"daily_challenges,omitempty"`
+	// Number of days with a challenge
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListDailyChallengesResponse) Reset() {
+	*x = ListDailyChallengesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDailyChallengesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDailyChallengesResponse) ProtoMessage() {}
+
+func (x *ListDailyChallengesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDailyChallengesResponse.ProtoReflect.Descriptor instead.
+func (*ListDailyChallengesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListDailyChallengesResponse) GetDailyChallenges() []*DailyChallenge {
+	if x != nil {
+		return x.DailyChallenges
+	}
+	return nil
+}
+
+func (x *ListDailyChallengesResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetDailyLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Formatted as YYYY-MM-DD. Defaults to today
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Maximum number of entries to return. Defaults to 20, and is capped at 100
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Number of entries to skip
+	Offset uint32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetDailyLeaderboardRequest) Reset() {
+	*x = GetDailyLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetDailyLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetDailyLeaderboardRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDailyLeaderboardRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDailyLeaderboardRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetDailyLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Number of users that won the challenge on their first attempt
+	TotalPlayers uint32 `protobuf:"varint,2,opt,name=total_players,json=totalPlayers,proto3" json:"total_players,omitempty"`
+	// The current users entry, if they won on their first attempt
+	CurrentUser *LeaderboardEntry `protobuf:"bytes,3,opt,name=current_user,json=currentUser,proto3" json:"current_user,omitempty"`
+}
+
+func (x *GetDailyLeaderboardResponse) Reset() {
+	*x = GetDailyLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetDailyLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetDailyLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetDailyLeaderboardResponse) GetTotalPlayers() uint32 {
+	if x != nil {
+		return x.TotalPlayers
+	}
+	return 0
+}
+
+func (x *GetDailyLeaderboardResponse) GetCurrentUser() *LeaderboardEntry {
+	if x != nil {
+		return x.CurrentUser
+	}
+	return nil
+}
+
+type CreateGameChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeNumber uint32  `protobuf:"varint,1,opt,name=challenge_number,json=challengeNumber,proto3" json:"challenge_number,omitempty"`
+	IdealMoves      uint32  `protobuf:"varint,2,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
+	IdealScore      uint32  `protobuf:"varint,3,opt,name=ideal_score,json=idealScore,proto3" json:"ideal_score,omitempty"`
+	TargetCellValue uint64  `protobuf:"varint,4,opt,name=target_cell_value,json=targetCellValue,proto3" json:"target_cell_value,omitempty"`
+	Columns         uint32  `protobuf:"varint,5,opt,name=columns,proto3" json:"columns,omitempty"`
+	Rows            uint32  `protobuf:"varint,6,opt,name=rows,proto3" json:"rows,omitempty"`
+	Name            string  `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string  `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Cells           []*Cell `protobuf:"bytes,9,rep,name=cells,proto3" json:"cells,omitempty"`
+	// The number of times cells can be swapped. Swaps are not allowed if zero
+	MaxSwaps uint32 `protobuf:"varint,10,opt,name=max_swaps,json=maxSwaps,proto3" json:"max_swaps,omitempty"`
+	// Only allow swapping cells that are neighbours
+	SwapNeighboursOnly bool `protobuf:"varint,11,opt,name=swap_neighbours_only,json=swapNeighboursOnly,proto3" json:"swap_neighbours_only,omitempty"`
+	// The number of super-powers that can be used. Super-powers are not allowed if zero
+	MaxPowers uint32 `protobuf:"varint,12,opt,name=max_powers,json=maxPowers,proto3" json:"max_powers,omitempty"`
+	// Allow subtraction as an evaluation-method
+	WithSubtraction bool `protobuf:"varint,13,opt,name=with_subtraction,json=withSubtraction,proto3" json:"with_subtraction,omitempty"`
+	// Allow exact division as an evaluation-method
+	WithDivision bool `protobuf:"varint,14,opt,name=with_division,json=withDivision,proto3" json:"with_division,omitempty"`
+	// Allow chains of mixed operators, evaluated from left to right
+	WithMixedOperators bool `protobuf:"varint,15,opt,name=with_mixed_operators,json=withMixedOperators,proto3" json:"with_mixed_operators,omitempty"`
+	// Decides which cells are neighbours. Defaults to orthogonal
+	Topology Topology `protobuf:"varint,16,opt,name=topology,proto3,enum=tally.v1.Topology" json:"topology,omitempty"`
+}
+
+func (x *CreateGameChallengeRequest) Reset() {
+	*x = CreateGameChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameChallengeRequest) ProtoMessage() {}
+
+func (x *CreateGameChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateGameChallengeRequest) GetChallengeNumber() uint32 {
+	if x != nil {
+		return x.ChallengeNumber
+	}
+	return 0
+}
+
+func (x *CreateGameChallengeRequest) GetIdealMoves() uint32 {
+	if x != nil {
+		return x.IdealMoves
+	}
+	return 0
+}
+
+func (x *CreateGameChallengeRequest) GetIdealScore() uint32 {
+	if x != nil {
+		return x.IdealScore
+	}
+	return 0
+}
+
+func (x *CreateGameChallengeRequest) GetTargetCellValue() uint64 {
+	if x != nil {
+		return x.TargetCellValue
+	}
+	return 0
+}
+
+func (x *CreateGameChallengeRequest) GetColumns() uint32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *CreateGameChallengeRequest) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *CreateGameChallengeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGameChallengeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGameChallengeRequest) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *CreateGameChallengeRequest) GetMaxSwaps() uint32 {
+	if x != nil {
+		return x.MaxSwaps
+	}
+	return 0
+}
+
+func (x *CreateGameChallengeRequest) GetSwapNeighboursOnly() bool {
+	if x != nil {
+		return x.SwapNeighboursOnly
+	}
+	return false
+}
+
+func (x *CreateGameChallengeRequest) GetMaxPowers() uint32 {
+	if x != nil {
+		return x.MaxPowers
+	}
+	return 0
+}
+
+func (x *CreateGameChallengeRequest) GetWithSubtraction() bool {
+	if x != nil {
+		return x.WithSubtraction
+	}
+	return false
+}
+
+func (x *CreateGameChallengeRequest) GetWithDivision() bool {
+	if x != nil {
+		return x.WithDivision
+	}
+	return false
+}
+
+func (x *CreateGameChallengeRequest) GetWithMixedOperators() bool {
+	if x != nil {
+		return x.WithMixedOperators
+	}
+	return false
+}
+
+func (x *CreateGameChallengeRequest) GetTopology() Topology {
+	if x != nil {
+		return x.Topology
+	}
+	return Topology_TOPOLOGY_UNSPECIFIED
+}
+
+type CreateGameChallengeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChallengeNumber uint32 `protobuf:"varint,2,opt,name=challenge_number,json=challengeNumber,proto3" json:"challenge_number,omitempty"`
+}
+
+func (x *CreateGameChallengeResponse) Reset() {
+	*x = CreateGameChallengeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameChallengeResponse) ProtoMessage() {}
+
+func (x *CreateGameChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameChallengeResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateGameChallengeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateGameChallengeResponse) GetChallengeNumber() uint32 {
+	if x != nil {
+		return x.ChallengeNumber
+	}
+	return 0
+}
+
+type GameStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of unique factors across all cells
+	UniqueFactors []uint64 `protobuf:"varint,1,rep,packed,name=unique_factors,json=uniqueFactors,proto3" json:"unique_factors,omitempty"`
+	// List of unique values across all cells
+	UniqueValues []uint64 `protobuf:"varint,2,rep,packed,name=unique_values,json=uniqueValues,proto3" json:"unique_values,omitempty"`
+	// Count of duplicate factors
+	DuplicateFactors uint64 `protobuf:"varint,3,opt,name=duplicate_factors,json=duplicateFactors,proto3" json:"duplicate_factors,omitempty"`
+	// Count of duplicate values
+	DuplicateValues uint64 `protobuf:"varint,4,opt,name=duplicate_values,json=duplicateValues,proto3" json:"duplicate_values,omitempty"`
+	// Cells with value (non-empty)
+	WithValueCount uint64 `protobuf:"varint,5,opt,name=with_value_count,json=withValueCount,proto3" json:"with_value_count,omitempty"`
+	// Total number of cells
+	CellCount uint64 `protobuf:"varint,6,opt,name=cell_count,json=cellCount,proto3" json:"cell_count,omitempty"`
+	// Unique hints at start
+	UniqueHints uint64 `protobuf:"varint,7,opt,name=unique_hints,json=uniqueHints,proto3" json:"unique_hints,omitempty"`
+	// Hints at start
+	Hints                   []*Instruction  `protobuf:"bytes,8,rep,name=hints,proto3" json:"hints,omitempty"`
+	IdealMovesSolutionIndex uint32          `protobuf:"varint,9,opt,name=ideal_moves_solution_index,json=idealMovesSolutionIndex,proto3" json:"ideal_moves_solution_index,omitempty"`
+	MaxScoreSolutionIndex   uint32          `protobuf:"varint,10,opt,name=max_score_solution_index,json=maxScoreSolutionIndex,proto3" json:"max_score_solution_index,omitempty"`
+	IdealMoves              uint32          `protobuf:"varint,11,opt,name=ideal_moves,json=idealMoves,proto3" json:"ideal_moves,omitempty"`
+	ScoreOnIdeal            uint64          `protobuf:"varint,12,opt,name=score_on_ideal,json=scoreOnIdeal,proto3" json:"score_on_ideal,omitempty"`
+	MaxScore                uint64          `protobuf:"varint,13,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	SolutionStats           []*SolutionStat `protobuf:"bytes,14,rep,name=solution_stats,json=solutionStats,proto3" json:"solution_stats,omitempty"`
+}
+
+func (x *GameStats) Reset() {
+	*x = GameStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStats) ProtoMessage() {}
+
+func (x *GameStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStats.ProtoReflect.Descriptor instead.
+func (*GameStats) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GameStats) GetUniqueFactors() []uint64 {
+	if x != nil {
+		return x.UniqueFactors
+	}
+	return nil
+}
+
+func (x *GameStats) GetUniqueValues() []uint64 {
+	if x != nil {
+		return x.UniqueValues
+	}
+	return nil
+}
+
+func (x *GameStats) GetDuplicateFactors() uint64 {
+	if x != nil {
+		return x.DuplicateFactors
+	}
+	return 0
+}
+
+func (x *GameStats) GetDuplicateValues() uint64 {
+	if x != nil {
+		return x.DuplicateValues
+	}
+	return 0
+}
+
+func (x *GameStats) GetWithValueCount() uint64 {
+	if x != nil {
+		return x.WithValueCount
+	}
+	return 0
+}
+
+func (x *GameStats) GetCellCount() uint64 {
+	if x != nil {
+		return x.CellCount
+	}
+	return 0
+}
+
+func (x *GameStats) GetUniqueHints() uint64 {
+	if x != nil {
+		return x.UniqueHints
+	}
+	return 0
+}
+
+func (x *GameStats) GetHints() []*Instruction {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+func (x *GameStats) GetIdealMovesSolutionIndex() uint32 {
+	if x != nil {
+		return x.IdealMovesSolutionIndex
+	}
+	return 0
+}
+
+func (x *GameStats) GetMaxScoreSolutionIndex() uint32 {
+	if x != nil {
+		return x.MaxScoreSolutionIndex
+	}
+	return 0
+}
+
+func (x *GameStats) GetIdealMoves() uint32 {
+	if x != nil {
+		return x.IdealMoves
+	}
+	return 0
+}
+
+func (x *GameStats) GetScoreOnIdeal() uint64 {
+	if x != nil {
+		return x.ScoreOnIdeal
+	}
+	return 0
+}
+
+func (x *GameStats) GetMaxScore() uint64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *GameStats) GetSolutionStats() []*SolutionStat {
+	if x != nil {
+		return x.SolutionStats
+	}
+	return nil
+}
+
+type SolutionStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Moves          uint32            `protobuf:"varint,1,opt,name=moves,proto3" json:"moves,omitempty"`
+	Score          uint64            `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	InstructionTag []*InstructionTag `protobuf:"bytes,3,rep,name=instruction_tag,json=instructionTag,proto3" json:"instruction_tag,omitempty"`
+}
+
+func (x *SolutionStat) Reset() {
+	*x = SolutionStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolutionStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolutionStat) ProtoMessage() {}
+
+func (x *SolutionStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolutionStat.ProtoReflect.Descriptor instead.
+func (*SolutionStat) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SolutionStat) GetMoves() uint32 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+func (x *SolutionStat) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SolutionStat) GetInstructionTag() []*InstructionTag {
+	if x != nil {
+		return x.InstructionTag
+	}
+	return nil
+}
+
+type InstructionTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok               bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	IsMultiplication bool   `protobuf:"varint,2,opt,name=is_multiplication,json=isMultiplication,proto3" json:"is_multiplication,omitempty"`
+	IsAddition       bool   `protobuf:"varint,3,opt,name=is_addition,json=isAddition,proto3" json:"is_addition,omitempty"`
+	IsSwipe          bool   `protobuf:"varint,4,opt,name=is_swipe,json=isSwipe,proto3" json:"is_swipe,omitempty"`
+	TwoPow           uint64 `protobuf:"varint,5,opt,name=two_pow,json=twoPow,proto3" json:"two_pow,omitempty"`
+	IsSubtraction    bool   `protobuf:"varint,6,opt,name=is_subtraction,json=isSubtraction,proto3" json:"is_subtraction,omitempty"`
+	IsDivision       bool   `protobuf:"varint,7,opt,name=is_division,json=isDivision,proto3" json:"is_division,omitempty"`
+	IsMixed          bool   `protobuf:"varint,8,opt,name=is_mixed,json=isMixed,proto3" json:"is_mixed,omitempty"`
+}
+
+func (x *InstructionTag) Reset() {
+	*x = InstructionTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tally_v1_board_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstructionTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstructionTag) ProtoMessage() {}
+
+func (x *InstructionTag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tally_v1_board_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstructionTag.ProtoReflect.Descriptor instead.
+func (*InstructionTag) Descriptor() ([]byte, []int) {
+	return file_proto_tally_v1_board_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *InstructionTag) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *InstructionTag) GetIsMultiplication() bool {
+	if x != nil {
+		return x.IsMultiplication
+	}
+	return false
+}
+
+func (x *InstructionTag) GetIsAddition() bool {
+	if x != nil {
+		return x.IsAddition
+	}
+	return false
+}
+
+func (x *InstructionTag) GetIsSwipe() bool {
+	if x != nil {
+		return x.IsSwipe
+	}
+	return false
+}
+
+func (x *InstructionTag) GetTwoPow() uint64 {
+	if x != nil {
+		return x.TwoPow
+	}
+	return 0
+}
+
+func (x *InstructionTag) GetIsSubtraction() bool {
+	if x != nil {
+		return x.IsSubtraction
+	}
+	return false
+}
+
+func (x *InstructionTag) GetIsDivision() bool {
+	if x != nil {
+		return x.IsDivision
+	}
+	return false
+}
+
+func (x *InstructionTag) GetIsMixed() bool {
+	if x != nil {
+		return x.IsMixed
+	}
+	return false
+}
+
+var File_proto_tally_v1_board_proto protoreflect.FileDescriptor
+
+var file_proto_tally_v1_board_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x22, 0x4c, 0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x22, 0x7a, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x37, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73,
+	0x22, 0x52, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x65, 0x6c, 0x6c, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2d, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xa0, 0x05, 0x0a, 0x0d,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x72, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x77, 0x69,
+	0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x6e, 0x53, 0x77, 0x69, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f,
+	0x72, 0x65, 0x73, 0x77, 0x69, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e,
+	0x6f, 0x52, 0x65, 0x73, 0x77, 0x69, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e,
+	0x6f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x6e, 0x6f, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x6f,
+	0x5f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x6f, 0x53, 0x75, 0x70, 0x65, 0x72, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12,
+	0x30, 0x0a, 0x14, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75,
+	0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x73,
+	0x77, 0x61, 0x70, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x73, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68,
+	0x53, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x77, 0x69, 0x74, 0x68, 0x4d, 0x69, 0x78, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x22, 0xaf,
+	0x01, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2e, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x22, 0xf8, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x77, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x70, 0x65,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x73, 0x77, 0x69,
+	0x70, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
+	0x65, 0x12, 0x16, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x77, 0x61,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x48, 0x00, 0x52, 0x04, 0x73, 0x77,
+	0x61, 0x70, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x4f, 0x0a, 0x10, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52,
+	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x72, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41,
+	0x0a, 0x0f, 0x68, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x0e, 0x68, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x22, 0x23, 0x0a, 0x0b, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f,
+	0x75, 0x6e, 0x64, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x55,
+	0x6e, 0x64, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x64, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x64, 0x6f, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22,
+	0x0d, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b,
+	0x02, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x64, 0x57, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64,
+	0x69, 0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
+	0x69, 0x64, 0x4c, 0x6f, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f,
+	0x6c, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x6e, 0x64,
+	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x55, 0x6e, 0x64, 0x6f,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x64, 0x6f, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x64, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x4c, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x36, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0b, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x42, 0x09, 0x0a,
+	0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x1a, 0x4e, 0x65, 0x77,
+	0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c,
+	0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61,
+	0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69,
+	0x64, 0x65, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22,
+	0xa8, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x73, 0x77, 0x61, 0x70, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x41, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xee, 0x01,
+	0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d,
+	0x6f, 0x76, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0xba,
+	0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x4b, 0x0a, 0x11, 0x53,
+	0x77, 0x69, 0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x77, 0x69, 0x70, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x53, 0x77, 0x69,
+	0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x69, 0x64, 0x57, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x69, 0x64, 0x5f, 0x6c,
+	0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x64, 0x4c, 0x6f,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x22,
+	0x28, 0x0a, 0x0a, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a,
+	0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x79, 0x22, 0x1f, 0x0a, 0x07, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x4c, 0x0a, 0x14, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12,
+	0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd4, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65,
+	0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f,
+	0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x69, 0x64, 0x57, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x69, 0x64,
+	0x5f, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x64,
+	0x4c, 0x6f, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x0a, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x53,
+	0x77, 0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x42, 0x22, 0xb9, 0x01, 0x0a, 0x11, 0x53, 0x77, 0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69,
+	0x64, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x64,
+	0x57, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x69, 0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x64, 0x4c, 0x6f, 0x73, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x4e, 0x0a,
+	0x0f, 0x55, 0x73, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xba, 0x01,
+	0x0a, 0x10, 0x55, 0x73, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x64, 0x5f, 0x77, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x64, 0x57, 0x69, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x64, 0x69, 0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x69, 0x64, 0x4c, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x5a, 0x0a, 0x10, 0x56, 0x6f,
+	0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x66,
+	0x75, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x07, 0x66,
+	0x75, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x4e, 0x0a, 0x11, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x08, 0x66,
+	0x75, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x07, 0x66,
+	0x75, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0xab, 0x01, 0x0a,
+	0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67,
+	0x61, 0x6d, 0x65, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x55, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xa8, 0x02, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x22, 0x38, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x43, 0x0a, 0x14, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x46,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3c, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a,
+	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x55, 0x73, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x42, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x2d, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x1b, 0x4e, 0x65, 0x77,
+	0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xec, 0x01, 0x0a,
+	0x1c, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f,
+	0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xb3, 0x03, 0x0a, 0x13,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x42, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x43, 0x65, 0x6c, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61,
+	0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x53, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x22, 0xfa, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x67, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x7b,
+	0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x11,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0a,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xbf, 0x04, 0x0a, 0x0d, 0x47,
+	0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c,
+	0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61,
+	0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69,
+	0x64, 0x65, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12,
+	0x37, 0x0a, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42,
+	0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x66, 0x65, 0x77, 0x65, 0x73, 0x74,
+	0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x65, 0x77, 0x65, 0x73, 0x74,
+	0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x76, 0x6f, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x5f, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x66, 0x75, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x71, 0x0a, 0x1e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0xbb, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x3d,
+	0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0xa0, 0x01,
+	0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x3d,
+	0x0a, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x0d,
+	0x72, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x87, 0x01,
+	0x0a, 0x0c, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x6f,
+	0x76, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x78, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x5e, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xb7, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0xdf, 0x04, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x65,
+	0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65,
+	0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x77, 0x61, 0x70, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x75, 0x72, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x73, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x53, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x44,
+	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x6d, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x69, 0x78, 0x65, 0x64,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52,
+	0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x22, 0x58, 0x0a, 0x1b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0xe1, 0x04, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x77, 0x69, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x48, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x2b, 0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3b,
+	0x0a, 0x1a, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x5f, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x17, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x18, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d,
+	0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x6f,
+	0x76, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x61, 0x6c,
+	0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x4f, 0x6e, 0x49, 0x64, 0x65, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0d, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x7d, 0x0a, 0x0c, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x22, 0x85, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x73, 0x5f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73, 0x77,
+	0x69, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x53, 0x77, 0x69,
+	0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x77, 0x6f, 0x5f, 0x70, 0x6f, 0x77, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x50, 0x6f, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x73, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x53, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x44, 0x69, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4d, 0x69, 0x78, 0x65, 0x64, 0x2a, 0x98,
+	0x01, 0x0a, 0x0e, 0x53, 0x77, 0x69, 0x70, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x57,
+	0x49, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x49,
+	0x47, 0x48, 0x54, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x44,
+	0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12,
+	0x18, 0x0a, 0x14, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x04, 0x2a, 0x73, 0x0a, 0x08, 0x47, 0x61, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41,
+	0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x54, 0x55, 0x54, 0x4f, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x1e,
+	0x0a, 0x1a, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44,
+	0x4f, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x2a, 0x69,
+	0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16,
+	0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46, 0x46,
+	0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x45, 0x41, 0x53, 0x59, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x44, 0x49,
+	0x55, 0x4d, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c,
+	0x54, 0x59, 0x5f, 0x48, 0x41, 0x52, 0x44, 0x10, 0x03, 0x2a, 0xeb, 0x01, 0x0a, 0x0e, 0x48, 0x69,
+	0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x1b,
+	0x48, 0x49, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x48, 0x49, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45,
+	0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x49, 0x4e, 0x54,
+	0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x48,
+	0x49, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4d,
+	0x49, 0x4e, 0x49, 0x4d, 0x55, 0x4d, 0x5f, 0x53, 0x57, 0x49, 0x50, 0x45, 0x53, 0x10, 0x04, 0x12,
+	0x33, 0x0a, 0x2f, 0x48, 0x49, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e,
+	0x43, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x55, 0x4d, 0x5f, 0x53, 0x57, 0x49, 0x50, 0x45,
+	0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x49, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x45,
+	0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x43, 0x4f,
+	0x4d, 0x42, 0x49, 0x4e, 0x45, 0x10, 0x06, 0x2a, 0xa5, 0x01, 0x0a, 0x0a, 0x45, 0x76, 0x61, 0x6c,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x56, 0x41, 0x4c,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x10,
+	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
+	0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x18,
+	0x0a, 0x14, 0x45, 0x56, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x44, 0x49,
+	0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x56, 0x41, 0x4c,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4d, 0x49, 0x58, 0x45, 0x44, 0x10, 0x05, 0x2a,
+	0x6c, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x54,
+	0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47,
+	0x59, 0x5f, 0x4f, 0x52, 0x54, 0x48, 0x4f, 0x47, 0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x45, 0x49, 0x47, 0x48, 0x54,
+	0x5f, 0x57, 0x41, 0x59, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f,
+	0x47, 0x59, 0x5f, 0x54, 0x4f, 0x52, 0x4f, 0x49, 0x44, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x75, 0x0a,
+	0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x43, 0x45,
+	0x4c, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x44, 0x4f,
+	0x55, 0x42, 0x4c, 0x45, 0x5f, 0x43, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x50,
+	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x43, 0x45, 0x4c, 0x4c, 0x10,
+	0x03, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55, 0x46, 0x46,
+	0x4c, 0x45, 0x10, 0x04, 0x2a, 0x73, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x10,
+	0x56, 0x4f, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x49,
+	0x42, 0x4c, 0x45, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x4f, 0x54, 0x45, 0x5f,
+	0x42, 0x41, 0x44, 0x5f, 0x32, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x4f, 0x54, 0x45, 0x5f,
+	0x4f, 0x4b, 0x5f, 0x33, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x47,
+	0x4f, 0x4f, 0x44, 0x5f, 0x34, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x4f, 0x54, 0x45, 0x5f,
+	0x47, 0x52, 0x45, 0x41, 0x54, 0x5f, 0x35, 0x10, 0x05, 0x2a, 0x82, 0x01, 0x0a, 0x09, 0x50, 0x6c,
+	0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4c, 0x41, 0x59, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50,
+	0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x4f, 0x4e, 0x10, 0x02, 0x12,
+	0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f,
+	0x53, 0x54, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x7e,
+	0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x23, 0x0a, 0x1f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x47, 0x45, 0x4e,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d,
+	0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a,
+	0x1b, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52,
+	0x49, 0x54, 0x48, 0x4d, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x02, 0x2a, 0x9e,
+	0x01, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x41, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x50, 0x4c,
+	0x41, 0x59, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x4f, 0x4b, 0x10, 0x14, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x57, 0x45, 0x4c, 0x4c, 0x10, 0x28, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x10, 0x3c, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x10, 0x50, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x41, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x55, 0x50, 0x45, 0x52, 0x42, 0x10, 0x64, 0x12, 0x11, 0x0a, 0x0d,
+	0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x45, 0x59, 0x4f, 0x4e, 0x44, 0x10, 0x78, 0x32,
+	0x96, 0x12, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x40, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61,
+	0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48,
+	0x69, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x55, 0x6e,
+	0x64, 0x6f, 0x12, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e,
+	0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x52, 0x65, 0x64, 0x6f, 0x12, 0x15, 0x2e, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b,
+	0x55, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x61,
+	0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x77,
+	0x69, 0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x77, 0x69, 0x70, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65,
+	0x43, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x43, 0x65,
+	0x6c, 0x6c, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x43,
+	0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x08, 0x55, 0x73, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x56, 0x6f, 0x74,
+	0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x70, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x28, 0x2e, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x67, 0x0a, 0x14, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x75, 0x6e, 0x61, 0x72, 0x2d, 0x72, 0x6b, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x2f, 0x67, 0x6f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2f, 0x76, 0x31, 0x3b,
+	0x74, 0x61, 0x6c, 0x6c, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_tally_v1_board_proto_rawDescOnce sync.Once
+	file_proto_tally_v1_board_proto_rawDescData = file_proto_tally_v1_board_proto_rawDesc
+)
+
+func file_proto_tally_v1_board_proto_rawDescGZIP() []byte {
+	file_proto_tally_v1_board_proto_rawDescOnce.Do(func() {
+		file_proto_tally_v1_board_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_tally_v1_board_proto_rawDescData)
+	})
+	return file_proto_tally_v1_board_proto_rawDescData
+}
+
+var file_proto_tally_v1_board_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_proto_tally_v1_board_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_proto_tally_v1_board_proto_goTypes = []interface{}{
+	(SwipeDirection)(0),                     // 0: tally.v1.SwipeDirection
+	(GameMode)(0),                           // 1: tally.v1.GameMode
+	(Difficulty)(0),                         // 2: tally.v1.Difficulty
+	(HintPreference)(0),                     // 3: tally.v1.HintPreference
+	(EvalMethod)(0),                         // 4: tally.v1.EvalMethod
+	(Topology)(0),                           // 5: tally.v1.Topology
+	(Power)(0),                              // 6: tally.v1.Power
+	(Vote)(0),                               // 7: tally.v1.Vote
+	(PlayState)(0),                          // 8: tally.v1.PlayState
+	(GeneratorAlgorithm)(0),                 // 9: tally.v1.GeneratorAlgorithm
+	(Rating)(0),                             // 10: tally.v1.Rating
+	(*Cell)(nil),                            // 11: tally.v1.Cell
+	(*InternalDataHistory)(nil),             // 12: tally.v1.InternalDataHistory
+	(*InternalDataGame)(nil),                // 13: tally.v1.InternalDataGame
+	(*InternalShareCode)(nil),               // 14: tally.v1.InternalShareCode
+	(*InternalRules)(nil),                   // 15: tally.v1.InternalRules
+	(*Board)(nil),                           // 16: tally.v1.Board
+	(*Instruction)(nil),                     // 17: tally.v1.Instruction
+	(*PowerInstruction)(nil),                // 18: tally.v1.PowerInstruction
+	(*GetHintRequest)(nil),                  // 19: tally.v1.GetHintRequest
+	(*UndoRequest)(nil),                     // 20: tally.v1.UndoRequest
+	(*UndoResponse)(nil),                    // 21: tally.v1.UndoResponse
+	(*RedoRequest)(nil),                     // 22: tally.v1.RedoRequest
+	(*RedoResponse)(nil),                    // 23: tally.v1.RedoResponse
+	(*GetHintResponse)(nil),                 // 24: tally.v1.GetHintResponse
+	(*GetSessionRequest)(nil),               // 25: tally.v1.GetSessionRequest
+	(*RestartGameRequest)(nil),              // 26: tally.v1.RestartGameRequest
+	(*NewGameRequest)(nil),                  // 27: tally.v1.NewGameRequest
+	(*NewGameFromTemplateRequest)(nil),      // 28: tally.v1.NewGameFromTemplateRequest
+	(*RestartGameResponse)(nil),             // 29: tally.v1.RestartGameResponse
+	(*GetSessionResponse)(nil),              // 30: tally.v1.GetSessionResponse
+	(*NewGameResponse)(nil),                 // 31: tally.v1.NewGameResponse
+	(*NewGameFromTemplateResponse)(nil),     // 32: tally.v1.NewGameFromTemplateResponse
+	(*SwipeBoardRequest)(nil),               // 33: tally.v1.SwipeBoardRequest
+	(*SwipeBoardResponse)(nil),              // 34: tally.v1.SwipeBoardResponse
+	(*Coordinate)(nil),                      // 35: tally.v1.Coordinate
+	(*Indexes)(nil),                         // 36: tally.v1.Indexes
+	(*SelectionCoordinates)(nil),            // 37: tally.v1.SelectionCoordinates
+	(*CombineCellsRequest)(nil),             // 38: tally.v1.CombineCellsRequest
+	(*CombineCellsResponse)(nil),            // 39: tally.v1.CombineCellsResponse
+	(*SwapCellsRequest)(nil),                // 40: tally.v1.SwapCellsRequest
+	(*SwapCellsResponse)(nil),               // 41: tally.v1.SwapCellsResponse
+	(*UsePowerRequest)(nil),                 // 42: tally.v1.UsePowerRequest
+	(*UsePowerResponse)(nil),                // 43: tally.v1.UsePowerResponse
+	(*VoteBoardRequest)(nil),                // 44: tally.v1.VoteBoardRequest
+	(*VoteBoardResponse)(nil),               // 45: tally.v1.VoteBoardResponse
+	(*Game)(nil),                            // 46: tally.v1.Game
+	(*Session)(nil),                         // 47: tally.v1.Session
+	(*ListGamesRequest)(nil),                // 48: tally.v1.ListGamesRequest
+	(*ListGamesResponse)(nil),               // 49: tally.v1.ListGamesResponse
+	(*ListedGame)(nil),                      // 50: tally.v1.ListedGame
+	(*ResumeGameRequest)(nil),               // 51: tally.v1.ResumeGameRequest
+	(*ResumeGameResponse)(nil),              // 52: tally.v1.ResumeGameResponse
+	(*RegisterUserRequest)(nil),             // 53: tally.v1.RegisterUserRequest
+	(*RegisterUserResponse)(nil),            // 54: tally.v1.RegisterUserResponse
+	(*LoginRequest)(nil),                    // 55: tally.v1.LoginRequest
+	(*LoginResponse)(nil),                   // 56: tally.v1.LoginResponse
+	(*CreateLinkCodeRequest)(nil),           // 57: tally.v1.CreateLinkCodeRequest
+	(*CreateLinkCodeResponse)(nil),          // 58: tally.v1.CreateLinkCodeResponse
+	(*UseLinkCodeRequest)(nil),              // 59: tally.v1.UseLinkCodeRequest
+	(*UseLinkCodeResponse)(nil),             // 60: tally.v1.UseLinkCodeResponse
+	(*CreateShareCodeRequest)(nil),          // 61: tally.v1.CreateShareCodeRequest
+	(*CreateShareCodeResponse)(nil),         // 62: tally.v1.CreateShareCodeResponse
+	(*NewGameFromShareCodeRequest)(nil),     // 63: tally.v1.NewGameFromShareCodeRequest
+	(*NewGameFromShareCodeResponse)(nil),    // 64: tally.v1.NewGameFromShareCodeResponse
+	(*GenerateGameRequest)(nil),             // 65: tally.v1.GenerateGameRequest
+	(*GenerateGameResponse)(nil),            // 66: tally.v1.GenerateGameResponse
+	(*GenerateGameStreamRequest)(nil),       // 67: tally.v1.GenerateGameStreamRequest
+	(*GeneratorProgress)(nil),               // 68: tally.v1.GeneratorProgress
+	(*GenerateGameStreamResponse)(nil),      // 69: tally.v1.GenerateGameStreamResponse
+	(*GetGameChallengesRequest)(nil),        // 70: tally.v1.GetGameChallengesRequest
+	(*GetGameChallengesResponse)(nil),       // 71: tally.v1.GetGameChallengesResponse
+	(*GameChallenge)(nil),                   // 72: tally.v1.GameChallenge
+	(*GetChallengeLeaderboardRequest)(nil),  // 73: tally.v1.GetChallengeLeaderboardRequest
+	(*GetChallengeLeaderboardResponse)(nil), // 74: tally.v1.GetChallengeLeaderboardResponse
+	(*LeaderboardEntry)(nil),                // 75: tally.v1.LeaderboardEntry
+	(*DailyChallenge)(nil),                  // 76: tally.v1.DailyChallenge
+	(*DailyAttempt)(nil),                    // 77: tally.v1.DailyAttempt
+	(*GetDailyChallengeRequest)(nil),        // 78: tally.v1.GetDailyChallengeRequest
+	(*GetDailyChallengeResponse)(nil),       // 79: tally.v1.GetDailyChallengeResponse
+	(*ListDailyChallengesRequest)(nil),      // 80: tally.v1.ListDailyChallengesRequest
+	(*ListDailyChallengesResponse)(nil),     // 81: tally.v1.ListDailyChallengesResponse
+	(*GetDailyLeaderboardRequest)(nil),      // 82: tally.v1.GetDailyLeaderboardRequest
+	(*GetDailyLeaderboardResponse)(nil),     // 83: tally.v1.GetDailyLeaderboardResponse
+	(*CreateGameChallengeRequest)(nil),      // 84: tally.v1.CreateGameChallengeRequest
+	(*CreateGameChallengeResponse)(nil),     // 85: tally.v1.CreateGameChallengeResponse
+	(*GameStats)(nil),                       // 86: tally.v1.GameStats
+	(*SolutionStat)(nil),                    // 87: tally.v1.SolutionStat
+	(*InstructionTag)(nil),                  // 88: tally.v1.InstructionTag
+	nil,                                     // 89: tally.v1.GeneratorProgress.RejectionsEntry
+}
+var file_proto_tally_v1_board_proto_depIdxs = []int32{
+	17,  // 0: tally.v1.InternalDataHistory.instruction:type_name -> tally.v1.Instruction
+	15,  // 1: tally.v1.InternalShareCode.rules:type_name -> tally.v1.InternalRules
+	13,  // 2: tally.v1.InternalShareCode.start:type_name -> tally.v1.InternalDataGame
+	11,  // 3: tally.v1.Board.cells:type_name -> tally.v1.Cell
+	5,   // 4: tally.v1.Board.topology:type_name -> tally.v1.Topology
+	0,   // 5: tally.v1.Instruction.swipe:type_name -> tally.v1.SwipeDirection
+	36,  // 6: tally.v1.Instruction.combine:type_name -> tally.v1.Indexes
+	36,  // 7: tally.v1.Instruction.swap:type_name -> tally.v1.Indexes
+	18,  // 8: tally.v1.Instruction.power:type_name -> tally.v1.PowerInstruction
+	6,   // 9: tally.v1.PowerInstruction.power:type_name -> tally.v1.Power
+	3,   // 10: tally.v1.GetHintRequest.hint_preference:type_name -> tally.v1.HintPreference
+	16,  // 11: tally.v1.UndoResponse.board:type_name -> tally.v1.Board
+	16,  // 12: tally.v1.RedoResponse.board:type_name -> tally.v1.Board
+	17,  // 13: tally.v1.GetHintResponse.instructions:type_name -> tally.v1.Instruction
+	1,   // 14: tally.v1.NewGameRequest.mode:type_name -> tally.v1.GameMode
+	2,   // 15: tally.v1.NewGameRequest.difficulty:type_name -> tally.v1.Difficulty
+	11,  // 16: tally.v1.NewGameFromTemplateRequest.cells:type_name -> tally.v1.Cell
+	16,  // 17: tally.v1.RestartGameResponse.board:type_name -> tally.v1.Board
+	47,  // 18: tally.v1.GetSessionResponse.session:type_name -> tally.v1.Session
+	16,  // 19: tally.v1.NewGameResponse.board:type_name -> tally.v1.Board
+	1,   // 20: tally.v1.NewGameResponse.mode:type_name -> tally.v1.GameMode
+	16,  // 21: tally.v1.NewGameFromTemplateResponse.board:type_name -> tally.v1.Board
+	1,   // 22: tally.v1.NewGameFromTemplateResponse.mode:type_name -> tally.v1.GameMode
+	0,   // 23: tally.v1.SwipeBoardRequest.direction:type_name -> tally.v1.SwipeDirection
+	16,  // 24: tally.v1.SwipeBoardResponse.board:type_name -> tally.v1.Board
+	35,  // 25: tally.v1.SelectionCoordinates.coordinate:type_name -> tally.v1.Coordinate
+	36,  // 26: tally.v1.CombineCellsRequest.indexes:type_name -> tally.v1.Indexes
+	35,  // 27: tally.v1.CombineCellsRequest.coordinate:type_name -> tally.v1.Coordinate
+	16,  // 28: tally.v1.CombineCellsResponse.board:type_name -> tally.v1.Board
+	4,   // 29: tally.v1.CombineCellsResponse.eval_method:type_name -> tally.v1.EvalMethod
+	16,  // 30: tally.v1.SwapCellsResponse.board:type_name -> tally.v1.Board
+	6,   // 31: tally.v1.UsePowerRequest.power:type_name -> tally.v1.Power
+	16,  // 32: tally.v1.UsePowerResponse.board:type_name -> tally.v1.Board
+	7,   // 33: tally.v1.VoteBoardRequest.fun_vote:type_name -> tally.v1.Vote
+	7,   // 34: tally.v1.VoteBoardResponse.fun_vote:type_name -> tally.v1.Vote
+	16,  // 35: tally.v1.Game.board:type_name -> tally.v1.Board
+	1,   // 36: tally.v1.Game.mode:type_name -> tally.v1.GameMode
+	46,  // 37: tally.v1.Session.game:type_name -> tally.v1.Game
+	8,   // 38: tally.v1.ListGamesRequest.play_states:type_name -> tally.v1.PlayState
+	1,   // 39: tally.v1.ListGamesRequest.modes:type_name -> tally.v1.GameMode
+	50,  // 40: tally.v1.ListGamesResponse.games:type_name -> tally.v1.ListedGame
+	16,  // 41: tally.v1.ListedGame.board:type_name -> tally.v1.Board
+	1,   // 42: tally.v1.ListedGame.mode:type_name -> tally.v1.GameMode
+	8,   // 43: tally.v1.ListedGame.play_state:type_name -> tally.v1.PlayState
+	46,  // 44: tally.v1.ResumeGameResponse.game:type_name -> tally.v1.Game
+	47,  // 45: tally.v1.RegisterUserResponse.session:type_name -> tally.v1.Session
+	47,  // 46: tally.v1.LoginResponse.session:type_name -> tally.v1.Session
+	47,  // 47: tally.v1.UseLinkCodeResponse.session:type_name -> tally.v1.Session
+	16,  // 48: tally.v1.NewGameFromShareCodeResponse.board:type_name -> tally.v1.Board
+	1,   // 49: tally.v1.NewGameFromShareCodeResponse.mode:type_name -> tally.v1.GameMode
+	17,  // 50: tally.v1.NewGameFromShareCodeResponse.history:type_name -> tally.v1.Instruction
+	9,   // 51: tally.v1.GenerateGameRequest.algorithm:type_name -> tally.v1.GeneratorAlgorithm
+	46,  // 52: tally.v1.GenerateGameResponse.game:type_name -> tally.v1.Game
+	46,  // 53: tally.v1.GenerateGameResponse.solutions:type_name -> tally.v1.Game
+	86,  // 54: tally.v1.GenerateGameResponse.stats:type_name -> tally.v1.GameStats
+	65,  // 55: tally.v1.GenerateGameStreamRequest.options:type_name -> tally.v1.GenerateGameRequest
+	89,  // 56: tally.v1.GeneratorProgress.rejections:type_name -> tally.v1.GeneratorProgress.RejectionsEntry
+	68,  // 57: tally.v1.GenerateGameStreamResponse.progress:type_name -> tally.v1.GeneratorProgress
+	66,  // 58: tally.v1.GenerateGameStreamResponse.candidate:type_name -> tally.v1.GenerateGameResponse
+	72,  // 59: tally.v1.GetGameChallengesResponse.challenges:type_name -> tally.v1.GameChallenge
+	11,  // 60: tally.v1.GameChallenge.cells:type_name -> tally.v1.Cell
+	10,  // 61: tally.v1.GameChallenge.rating:type_name -> tally.v1.Rating
+	75,  // 62: tally.v1.GetChallengeLeaderboardResponse.entries:type_name -> tally.v1.LeaderboardEntry
+	75,  // 63: tally.v1.GetChallengeLeaderboardResponse.current_user:type_name -> tally.v1.LeaderboardEntry
+	72,  // 64: tally.v1.DailyChallenge.challenge:type_name -> tally.v1.GameChallenge
+	77,  // 65: tally.v1.DailyChallenge.ranked_attempt:type_name -> tally.v1.DailyAttempt
+	8,   // 66: tally.v1.DailyAttempt.play_state:type_name -> tally.v1.PlayState
+	76,  // 67: tally.v1.GetDailyChallengeResponse.daily_challenge:type_name -> tally.v1.DailyChallenge
+	76,  // 68: tally.v1.ListDailyChallengesResponse.daily_challenges:type_name -> tally.v1.DailyChallenge
+	75,  // 69: tally.v1.GetDailyLeaderboardResponse.entries:type_name -> tally.v1.LeaderboardEntry
+	75,  // 70: tally.v1.GetDailyLeaderboardResponse.current_user:type_name -> tally.v1.LeaderboardEntry
+	11,  // 71: tally.v1.CreateGameChallengeRequest.cells:type_name -> tally.v1.Cell
+	5,   // 72: tally.v1.CreateGameChallengeRequest.topology:type_name -> tally.v1.Topology
+	17,  // 73: tally.v1.GameStats.hints:type_name -> tally.v1.Instruction
+	87,  // 74: tally.v1.GameStats.solution_stats:type_name -> tally.v1.SolutionStat
+	88,  // 75: tally.v1.SolutionStat.instruction_tag:type_name -> tally.v1.InstructionTag
+	27,  // 76: tally.v1.BoardService.NewGame:input_type -> tally.v1.NewGameRequest
+	28,  // 77: tally.v1.BoardService.NewGameFromTemplate:input_type -> tally.v1.NewGameFromTemplateRequest
+	19,  // 78: tally.v1.BoardService.GetHint:input_type -> tally.v1.GetHintRequest
+	20,  // 79: tally.v1.BoardService.Undo:input_type -> tally.v1.UndoRequest
+	22,  // 80: tally.v1.BoardService.Redo:input_type -> tally.v1.RedoRequest
+	26,  // 81: tally.v1.BoardService.RestartGame:input_type -> tally.v1.RestartGameRequest
+	48,  // 82: tally.v1.BoardService.ListGames:input_type -> tally.v1.ListGamesRequest
+	51,  // 83: tally.v1.BoardService.ResumeGame:input_type -> tally.v1.ResumeGameRequest
+	25,  // 84: tally.v1.BoardService.GetSession:input_type -> tally.v1.GetSessionRequest
+	53,  // 85: tally.v1.BoardService.RegisterUser:input_type -> tally.v1.RegisterUserRequest
+	55,  // 86: tally.v1.BoardService.Login:input_type -> tally.v1.LoginRequest
+	57,  // 87: tally.v1.BoardService.CreateLinkCode:input_type -> tally.v1.CreateLinkCodeRequest
+	59,  // 88: tally.v1.BoardService.UseLinkCode:input_type -> tally.v1.UseLinkCodeRequest
+	33,  // 89: tally.v1.BoardService.SwipeBoard:input_type -> tally.v1.SwipeBoardRequest
+	38,  // 90: tally.v1.BoardService.CombineCells:input_type -> tally.v1.CombineCellsRequest
+	40,  // 91: tally.v1.BoardService.SwapCells:input_type -> tally.v1.SwapCellsRequest
+	42,  // 92: tally.v1.BoardService.UsePower:input_type -> tally.v1.UsePowerRequest
+	65,  // 93: tally.v1.BoardService.GenerateGame:input_type -> tally.v1.GenerateGameRequest
+	67,  // 94: tally.v1.BoardService.GenerateGameStream:input_type -> tally.v1.GenerateGameStreamRequest
+	44,  // 95: tally.v1.BoardService.VoteBoard:input_type -> tally.v1.VoteBoardRequest
+	70,  // 96: tally.v1.BoardService.GetGameChallenges:input_type -> tally.v1.GetGameChallengesRequest
+	73,  // 97: tally.v1.BoardService.GetChallengeLeaderboard:input_type -> tally.v1.GetChallengeLeaderboardRequest
+	78,  // 98: tally.v1.BoardService.GetDailyChallenge:input_type -> tally.v1.GetDailyChallengeRequest
+	80,  // 99: tally.v1.BoardService.ListDailyChallenges:input_type -> tally.v1.ListDailyChallengesRequest
+	82,  // 100: tally.v1.BoardService.GetDailyLeaderboard:input_type -> tally.v1.GetDailyLeaderboardRequest
+	84,  // 101: tally.v1.BoardService.CreateGameChallenge:input_type -> tally.v1.CreateGameChallengeRequest
+	61,  // 102: tally.v1.BoardService.CreateShareCode:input_type -> tally.v1.CreateShareCodeRequest
+	63,  // 103: tally.v1.BoardService.NewGameFromShareCode:input_type -> tally.v1.NewGameFromShareCodeRequest
+	31,  // 104: tally.v1.BoardService.NewGame:output_type -> tally.v1.NewGameResponse
+	32,  // 105: tally.v1.BoardService.NewGameFromTemplate:output_type -> tally.v1.NewGameFromTemplateResponse
+	24,  // 106: tally.v1.BoardService.GetHint:output_type -> tally.v1.GetHintResponse
+	21,  // 107: tally.v1.BoardService.Undo:output_type -> tally.v1.UndoResponse
+	23,  // 108: tally.v1.BoardService.Redo:output_type -> tally.v1.RedoResponse
+	29,  // 109: tally.v1.BoardService.RestartGame:output_type -> tally.v1.RestartGameResponse
+	49,  // 110: tally.v1.BoardService.ListGames:output_type -> tally.v1.ListGamesResponse
+	52,  // 111: tally.v1.BoardService.ResumeGame:output_type -> tally.v1.ResumeGameResponse
+	30,  // 112: tally.v1.BoardService.GetSession:output_type -> tally.v1.GetSessionResponse
+	54,  // 113: tally.v1.BoardService.RegisterUser:output_type -> tally.v1.RegisterUserResponse
+	56,  // 114: tally.v1.BoardService.Login:output_type -> tally.v1.LoginResponse
+	58,  // 115: tally.v1.BoardService.CreateLinkCode:output_type -> tally.v1.CreateLinkCodeResponse
+	60,  // 116: tally.v1.BoardService.UseLinkCode:output_type -> tally.v1.UseLinkCodeResponse
+	34,  // 117: tally.v1.BoardService.SwipeBoard:output_type -> tally.v1.SwipeBoardResponse
+	39,  // 118: tally.v1.BoardService.CombineCells:output_type -> tally.v1.CombineCellsResponse
+	41,  // 119: tally.v1.BoardService.SwapCells:output_type -> tally.v1.SwapCellsResponse
+	43,  // 120: tally.v1.BoardService.UsePower:output_type -> tally.v1.UsePowerResponse
+	66,  // 121: tally.v1.BoardService.GenerateGame:output_type -> tally.v1.GenerateGameResponse
+	69,  // 122: tally.v1.BoardService.GenerateGameStream:output_type -> tally.v1.GenerateGameStreamResponse
+	45,  // 123: tally.v1.BoardService.VoteBoard:output_type -> tally.v1.VoteBoardResponse
+	71,  // 124: tally.v1.BoardService.GetGameChallenges:output_type -> tally.v1.GetGameChallengesResponse
+	74,  // 125: tally.v1.BoardService.GetChallengeLeaderboard:output_type -> tally.v1.GetChallengeLeaderboardResponse
+	79,  // 126: tally.v1.BoardService.GetDailyChallenge:output_type -> tally.v1.GetDailyChallengeResponse
+	81,  // 127: tally.v1.BoardService.ListDailyChallenges:output_type -> tally.v1.ListDailyChallengesResponse
+	83,  // 128: tally.v1.BoardService.GetDailyLeaderboard:output_type -> tally.v1.GetDailyLeaderboardResponse
+	85,  // 129: tally.v1.BoardService.CreateGameChallenge:output_type -> tally.v1.CreateGameChallengeResponse
+	62,  // 130: tally.v1.BoardService.CreateShareCode:output_type -> tally.v1.CreateShareCodeResponse
+	64,  // 131: tally.v1.BoardService.NewGameFromShareCode:output_type -> tally.v1.NewGameFromShareCodeResponse
+	104, // [104:132] is the sub-list for method output_type
+	76,  // [76:104] is the sub-list for method input_type
+	76,  // [76:76] is the sub-list for extension type_name
+	76,  // [76:76] is the sub-list for extension extendee
+	0,   // [0:76] is the sub-list for field type_name
+}
+
+func init() { file_proto_tally_v1_board_proto_init() }
+func file_proto_tally_v1_board_proto_init() {
+	if File_proto_tally_v1_board_proto != nil {
+		return
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_proto_tally_v1_board_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -3811,8 +7578,296 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InternalDataHistory); i {
+		file_proto_tally_v1_board_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalDataHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalDataGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalShareCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Board); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Instruction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerInstruction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHintRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHintResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameFromTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameFromTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwipeBoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwipeBoardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coordinate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Indexes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3823,8 +7878,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InternalDataGame); i {
+		file_proto_tally_v1_board_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectionCoordinates); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3835,8 +7890,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Board); i {
+		file_proto_tally_v1_board_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineCellsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3847,8 +7902,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Instruction); i {
+		file_proto_tally_v1_board_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineCellsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3859,8 +7914,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHintRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapCellsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3871,8 +7926,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UndoRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapCellsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3883,8 +7938,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UndoResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsePowerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3895,8 +7950,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHintResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsePowerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3907,8 +7962,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSessionRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteBoardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3919,8 +7974,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartGameRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteBoardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3931,8 +7986,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGameRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Game); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3943,8 +7998,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGameFromTemplateRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3955,8 +8010,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartGameResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3967,8 +8022,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSessionResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3979,8 +8034,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGameResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListedGame); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3991,8 +8046,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGameFromTemplateResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeGameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4003,8 +8058,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwipeBoardRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeGameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4015,8 +8070,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwipeBoardResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4027,8 +8082,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Coordinate); i {
+		file_proto_tally_v1_board_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4039,8 +8094,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Indexes); i {
+		file_proto_tally_v1_board_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4051,8 +8106,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelectionCoordinates); i {
+		file_proto_tally_v1_board_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4063,8 +8118,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombineCellsRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4075,8 +8130,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombineCellsResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4087,8 +8142,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteBoardRequest); i {
+		file_proto_tally_v1_board_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UseLinkCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4099,8 +8154,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteBoardResponse); i {
+		file_proto_tally_v1_board_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UseLinkCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4111,8 +8166,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Game); i {
+		file_proto_tally_v1_board_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShareCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4123,8 +8178,8 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Session); i {
+		file_proto_tally_v1_board_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShareCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4135,7 +8190,31 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameFromShareCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameFromShareCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateGameRequest); i {
 			case 0:
 				return &v.state
@@ -4147,7 +8226,7 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateGameResponse); i {
 			case 0:
 				return &v.state
@@ -4159,7 +8238,43 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateGameStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratorProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateGameStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGameChallengesRequest); i {
 			case 0:
 				return &v.state
@@ -4171,7 +8286,7 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGameChallengesResponse); i {
 			case 0:
 				return &v.state
@@ -4183,7 +8298,7 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameChallenge); i {
 			case 0:
 				return &v.state
@@ -4195,7 +8310,139 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChallengeLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChallengeLeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyChallenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyChallengeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyChallengeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDailyChallengesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDailyChallengesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyLeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tally_v1_board_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateGameChallengeRequest); i {
 			case 0:
 				return &v.state
@@ -4207,7 +8454,7 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateGameChallengeResponse); i {
 			case 0:
 				return &v.state
@@ -4219,7 +8466,7 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameStats); i {
 			case 0:
 				return &v.state
@@ -4231,7 +8478,7 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SolutionStat); i {
 			case 0:
 				return &v.state
@@ -4243,7 +8490,7 @@ func file_proto_tally_v1_board_proto_init() {
 				return nil
 			}
 		}
-		file_proto_tally_v1_board_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_tally_v1_board_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstructionTag); i {
 			case 0:
 				return &v.state
@@ -4256,17 +8503,19 @@ func file_proto_tally_v1_board_proto_init() {
 			}
 		}
 	}
-	file_proto_tally_v1_board_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_proto_tally_v1_board_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*Instruction_Swipe)(nil),
 		(*Instruction_Combine)(nil),
 		(*Instruction_Bytes)(nil),
+		(*Instruction_Swap)(nil),
+		(*Instruction_Power)(nil),
 	}
-	file_proto_tally_v1_board_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_proto_tally_v1_board_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*NewGameRequest_Difficulty)(nil),
 		(*NewGameRequest_LevelIndex)(nil),
 		(*NewGameRequest_Id)(nil),
 	}
-	file_proto_tally_v1_board_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_proto_tally_v1_board_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*CombineCellsRequest_Indexes)(nil),
 		(*CombineCellsRequest_Coordinate)(nil),
 	}
@@ -4275,8 +8524,8 @@ func file_proto_tally_v1_board_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_tally_v1_board_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   38,
+			NumEnums:      11,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
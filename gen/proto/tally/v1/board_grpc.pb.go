@@ -26,14 +26,30 @@ type BoardServiceClient interface {
 	NewGameFromTemplate(ctx context.Context, in *NewGameFromTemplateRequest, opts ...grpc.CallOption) (*NewGameFromTemplateResponse, error)
 	GetHint(ctx context.Context, in *GetHintRequest, opts ...grpc.CallOption) (*GetHintResponse, error)
 	Undo(ctx context.Context, in *UndoRequest, opts ...grpc.CallOption) (*UndoResponse, error)
+	Redo(ctx context.Context, in *RedoRequest, opts ...grpc.CallOption) (*RedoResponse, error)
 	RestartGame(ctx context.Context, in *RestartGameRequest, opts ...grpc.CallOption) (*RestartGameResponse, error)
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*ResumeGameResponse, error)
 	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+	RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	CreateLinkCode(ctx context.Context, in *CreateLinkCodeRequest, opts ...grpc.CallOption) (*CreateLinkCodeResponse, error)
+	UseLinkCode(ctx context.Context, in *UseLinkCodeRequest, opts ...grpc.CallOption) (*UseLinkCodeResponse, error)
 	SwipeBoard(ctx context.Context, in *SwipeBoardRequest, opts ...grpc.CallOption) (*SwipeBoardResponse, error)
 	CombineCells(ctx context.Context, in *CombineCellsRequest, opts ...grpc.CallOption) (*CombineCellsResponse, error)
+	SwapCells(ctx context.Context, in *SwapCellsRequest, opts ...grpc.CallOption) (*SwapCellsResponse, error)
+	UsePower(ctx context.Context, in *UsePowerRequest, opts ...grpc.CallOption) (*UsePowerResponse, error)
 	GenerateGame(ctx context.Context, in *GenerateGameRequest, opts ...grpc.CallOption) (*GenerateGameResponse, error)
+	GenerateGameStream(ctx context.Context, in *GenerateGameStreamRequest, opts ...grpc.CallOption) (BoardService_GenerateGameStreamClient, error)
 	VoteBoard(ctx context.Context, in *VoteBoardRequest, opts ...grpc.CallOption) (*VoteBoardResponse, error)
 	GetGameChallenges(ctx context.Context, in *GetGameChallengesRequest, opts ...grpc.CallOption) (*GetGameChallengesResponse, error)
+	GetChallengeLeaderboard(ctx context.Context, in *GetChallengeLeaderboardRequest, opts ...grpc.CallOption) (*GetChallengeLeaderboardResponse, error)
+	GetDailyChallenge(ctx context.Context, in *GetDailyChallengeRequest, opts ...grpc.CallOption) (*GetDailyChallengeResponse, error)
+	ListDailyChallenges(ctx context.Context, in *ListDailyChallengesRequest, opts ...grpc.CallOption) (*ListDailyChallengesResponse, error)
+	GetDailyLeaderboard(ctx context.Context, in *GetDailyLeaderboardRequest, opts ...grpc.CallOption) (*GetDailyLeaderboardResponse, error)
 	CreateGameChallenge(ctx context.Context, in *CreateGameChallengeRequest, opts ...grpc.CallOption) (*CreateGameChallengeResponse, error)
+	CreateShareCode(ctx context.Context, in *CreateShareCodeRequest, opts ...grpc.CallOption) (*CreateShareCodeResponse, error)
+	NewGameFromShareCode(ctx context.Context, in *NewGameFromShareCodeRequest, opts ...grpc.CallOption) (*NewGameFromShareCodeResponse, error)
 }
 
 type boardServiceClient struct {
@@ -80,6 +96,15 @@ func (c *boardServiceClient) Undo(ctx context.Context, in *UndoRequest, opts ...
 	return out, nil
 }
 
+func (c *boardServiceClient) Redo(ctx context.Context, in *RedoRequest, opts ...grpc.CallOption) (*RedoResponse, error) {
+	out := new(RedoResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/Redo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) RestartGame(ctx context.Context, in *RestartGameRequest, opts ...grpc.CallOption) (*RestartGameResponse, error) {
 	out := new(RestartGameResponse)
 	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/RestartGame", in, out, opts...)
@@ -89,6 +114,24 @@ func (c *boardServiceClient) RestartGame(ctx context.Context, in *RestartGameReq
 	return out, nil
 }
 
+func (c *boardServiceClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/ListGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*ResumeGameResponse, error) {
+	out := new(ResumeGameResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/ResumeGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error) {
 	out := new(GetSessionResponse)
 	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/GetSession", in, out, opts...)
@@ -98,6 +141,42 @@ func (c *boardServiceClient) GetSession(ctx context.Context, in *GetSessionReque
 	return out, nil
 }
 
+func (c *boardServiceClient) RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error) {
+	out := new(RegisterUserResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/RegisterUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) CreateLinkCode(ctx context.Context, in *CreateLinkCodeRequest, opts ...grpc.CallOption) (*CreateLinkCodeResponse, error) {
+	out := new(CreateLinkCodeResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/CreateLinkCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) UseLinkCode(ctx context.Context, in *UseLinkCodeRequest, opts ...grpc.CallOption) (*UseLinkCodeResponse, error) {
+	out := new(UseLinkCodeResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/UseLinkCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) SwipeBoard(ctx context.Context, in *SwipeBoardRequest, opts ...grpc.CallOption) (*SwipeBoardResponse, error) {
 	out := new(SwipeBoardResponse)
 	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/SwipeBoard", in, out, opts...)
@@ -116,6 +195,24 @@ func (c *boardServiceClient) CombineCells(ctx context.Context, in *CombineCellsR
 	return out, nil
 }
 
+func (c *boardServiceClient) SwapCells(ctx context.Context, in *SwapCellsRequest, opts ...grpc.CallOption) (*SwapCellsResponse, error) {
+	out := new(SwapCellsResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/SwapCells", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) UsePower(ctx context.Context, in *UsePowerRequest, opts ...grpc.CallOption) (*UsePowerResponse, error) {
+	out := new(UsePowerResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/UsePower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) GenerateGame(ctx context.Context, in *GenerateGameRequest, opts ...grpc.CallOption) (*GenerateGameResponse, error) {
 	out := new(GenerateGameResponse)
 	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/GenerateGame", in, out, opts...)
@@ -125,6 +222,38 @@ func (c *boardServiceClient) GenerateGame(ctx context.Context, in *GenerateGameR
 	return out, nil
 }
 
+func (c *boardServiceClient) GenerateGameStream(ctx context.Context, in *GenerateGameStreamRequest, opts ...grpc.CallOption) (BoardService_GenerateGameStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BoardService_ServiceDesc.Streams[0], "/tally.v1.BoardService/GenerateGameStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardServiceGenerateGameStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BoardService_GenerateGameStreamClient interface {
+	Recv() (*GenerateGameStreamResponse, error)
+	grpc.ClientStream
+}
+
+type boardServiceGenerateGameStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardServiceGenerateGameStreamClient) Recv() (*GenerateGameStreamResponse, error) {
+	m := new(GenerateGameStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *boardServiceClient) VoteBoard(ctx context.Context, in *VoteBoardRequest, opts ...grpc.CallOption) (*VoteBoardResponse, error) {
 	out := new(VoteBoardResponse)
 	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/VoteBoard", in, out, opts...)
@@ -143,6 +272,42 @@ func (c *boardServiceClient) GetGameChallenges(ctx context.Context, in *GetGameC
 	return out, nil
 }
 
+func (c *boardServiceClient) GetChallengeLeaderboard(ctx context.Context, in *GetChallengeLeaderboardRequest, opts ...grpc.CallOption) (*GetChallengeLeaderboardResponse, error) {
+	out := new(GetChallengeLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/GetChallengeLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) GetDailyChallenge(ctx context.Context, in *GetDailyChallengeRequest, opts ...grpc.CallOption) (*GetDailyChallengeResponse, error) {
+	out := new(GetDailyChallengeResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/GetDailyChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) ListDailyChallenges(ctx context.Context, in *ListDailyChallengesRequest, opts ...grpc.CallOption) (*ListDailyChallengesResponse, error) {
+	out := new(ListDailyChallengesResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/ListDailyChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) GetDailyLeaderboard(ctx context.Context, in *GetDailyLeaderboardRequest, opts ...grpc.CallOption) (*GetDailyLeaderboardResponse, error) {
+	out := new(GetDailyLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/GetDailyLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) CreateGameChallenge(ctx context.Context, in *CreateGameChallengeRequest, opts ...grpc.CallOption) (*CreateGameChallengeResponse, error) {
 	out := new(CreateGameChallengeResponse)
 	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/CreateGameChallenge", in, out, opts...)
@@ -152,6 +317,24 @@ func (c *boardServiceClient) CreateGameChallenge(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *boardServiceClient) CreateShareCode(ctx context.Context, in *CreateShareCodeRequest, opts ...grpc.CallOption) (*CreateShareCodeResponse, error) {
+	out := new(CreateShareCodeResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/CreateShareCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) NewGameFromShareCode(ctx context.Context, in *NewGameFromShareCodeRequest, opts ...grpc.CallOption) (*NewGameFromShareCodeResponse, error) {
+	out := new(NewGameFromShareCodeResponse)
+	err := c.cc.Invoke(ctx, "/tally.v1.BoardService/NewGameFromShareCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BoardServiceServer is the server API for BoardService service.
 // All implementations should embed UnimplementedBoardServiceServer
 // for forward compatibility
@@ -160,14 +343,30 @@ type BoardServiceServer interface {
 	NewGameFromTemplate(context.Context, *NewGameFromTemplateRequest) (*NewGameFromTemplateResponse, error)
 	GetHint(context.Context, *GetHintRequest) (*GetHintResponse, error)
 	Undo(context.Context, *UndoRequest) (*UndoResponse, error)
+	Redo(context.Context, *RedoRequest) (*RedoResponse, error)
 	RestartGame(context.Context, *RestartGameRequest) (*RestartGameResponse, error)
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	ResumeGame(context.Context, *ResumeGameRequest) (*ResumeGameResponse, error)
 	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	CreateLinkCode(context.Context, *CreateLinkCodeRequest) (*CreateLinkCodeResponse, error)
+	UseLinkCode(context.Context, *UseLinkCodeRequest) (*UseLinkCodeResponse, error)
 	SwipeBoard(context.Context, *SwipeBoardRequest) (*SwipeBoardResponse, error)
 	CombineCells(context.Context, *CombineCellsRequest) (*CombineCellsResponse, error)
+	SwapCells(context.Context, *SwapCellsRequest) (*SwapCellsResponse, error)
+	UsePower(context.Context, *UsePowerRequest) (*UsePowerResponse, error)
 	GenerateGame(context.Context, *GenerateGameRequest) (*GenerateGameResponse, error)
+	GenerateGameStream(*GenerateGameStreamRequest, BoardService_GenerateGameStreamServer) error
 	VoteBoard(context.Context, *VoteBoardRequest) (*VoteBoardResponse, error)
 	GetGameChallenges(context.Context, *GetGameChallengesRequest) (*GetGameChallengesResponse, error)
+	GetChallengeLeaderboard(context.Context, *GetChallengeLeaderboardRequest) (*GetChallengeLeaderboardResponse, error)
+	GetDailyChallenge(context.Context, *GetDailyChallengeRequest) (*GetDailyChallengeResponse, error)
+	ListDailyChallenges(context.Context, *ListDailyChallengesRequest) (*ListDailyChallengesResponse, error)
+	GetDailyLeaderboard(context.Context, *GetDailyLeaderboardRequest) (*GetDailyLeaderboardResponse, error)
 	CreateGameChallenge(context.Context, *CreateGameChallengeRequest) (*CreateGameChallengeResponse, error)
+	CreateShareCode(context.Context, *CreateShareCodeRequest) (*CreateShareCodeResponse, error)
+	NewGameFromShareCode(context.Context, *NewGameFromShareCodeRequest) (*NewGameFromShareCodeResponse, error)
 }
 
 // UnimplementedBoardServiceServer should be embedded to have forward compatible implementations.
@@ -186,30 +385,78 @@ func (UnimplementedBoardServiceServer) GetHint(context.Context, *GetHintRequest)
 func (UnimplementedBoardServiceServer) Undo(context.Context, *UndoRequest) (*UndoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undo not implemented")
 }
+func (UnimplementedBoardServiceServer) Redo(context.Context, *RedoRequest) (*RedoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redo not implemented")
+}
 func (UnimplementedBoardServiceServer) RestartGame(context.Context, *RestartGameRequest) (*RestartGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartGame not implemented")
 }
+func (UnimplementedBoardServiceServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedBoardServiceServer) ResumeGame(context.Context, *ResumeGameRequest) (*ResumeGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeGame not implemented")
+}
 func (UnimplementedBoardServiceServer) GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
 }
+func (UnimplementedBoardServiceServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
+}
+func (UnimplementedBoardServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedBoardServiceServer) CreateLinkCode(context.Context, *CreateLinkCodeRequest) (*CreateLinkCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLinkCode not implemented")
+}
+func (UnimplementedBoardServiceServer) UseLinkCode(context.Context, *UseLinkCodeRequest) (*UseLinkCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UseLinkCode not implemented")
+}
 func (UnimplementedBoardServiceServer) SwipeBoard(context.Context, *SwipeBoardRequest) (*SwipeBoardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwipeBoard not implemented")
 }
 func (UnimplementedBoardServiceServer) CombineCells(context.Context, *CombineCellsRequest) (*CombineCellsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CombineCells not implemented")
 }
+func (UnimplementedBoardServiceServer) SwapCells(context.Context, *SwapCellsRequest) (*SwapCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapCells not implemented")
+}
+func (UnimplementedBoardServiceServer) UsePower(context.Context, *UsePowerRequest) (*UsePowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UsePower not implemented")
+}
 func (UnimplementedBoardServiceServer) GenerateGame(context.Context, *GenerateGameRequest) (*GenerateGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateGame not implemented")
 }
+func (UnimplementedBoardServiceServer) GenerateGameStream(*GenerateGameStreamRequest, BoardService_GenerateGameStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateGameStream not implemented")
+}
 func (UnimplementedBoardServiceServer) VoteBoard(context.Context, *VoteBoardRequest) (*VoteBoardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteBoard not implemented")
 }
 func (UnimplementedBoardServiceServer) GetGameChallenges(context.Context, *GetGameChallengesRequest) (*GetGameChallengesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGameChallenges not implemented")
 }
+func (UnimplementedBoardServiceServer) GetChallengeLeaderboard(context.Context, *GetChallengeLeaderboardRequest) (*GetChallengeLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallengeLeaderboard not implemented")
+}
+func (UnimplementedBoardServiceServer) GetDailyChallenge(context.Context, *GetDailyChallengeRequest) (*GetDailyChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyChallenge not implemented")
+}
+func (UnimplementedBoardServiceServer) ListDailyChallenges(context.Context, *ListDailyChallengesRequest) (*ListDailyChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDailyChallenges not implemented")
+}
+func (UnimplementedBoardServiceServer) GetDailyLeaderboard(context.Context, *GetDailyLeaderboardRequest) (*GetDailyLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyLeaderboard not implemented")
+}
 func (UnimplementedBoardServiceServer) CreateGameChallenge(context.Context, *CreateGameChallengeRequest) (*CreateGameChallengeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGameChallenge not implemented")
 }
+func (UnimplementedBoardServiceServer) CreateShareCode(context.Context, *CreateShareCodeRequest) (*CreateShareCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareCode not implemented")
+}
+func (UnimplementedBoardServiceServer) NewGameFromShareCode(context.Context, *NewGameFromShareCodeRequest) (*NewGameFromShareCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewGameFromShareCode not implemented")
+}
 
 // UnsafeBoardServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BoardServiceServer will
@@ -294,6 +541,24 @@ func _BoardService_Undo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_Redo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).Redo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/Redo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).Redo(ctx, req.(*RedoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_RestartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RestartGameRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +577,42 @@ func _BoardService_RestartGame_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/ListGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_ResumeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).ResumeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/ResumeGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).ResumeGame(ctx, req.(*ResumeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSessionRequest)
 	if err := dec(in); err != nil {
@@ -330,6 +631,78 @@ func _BoardService_GetSession_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/RegisterUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).RegisterUser(ctx, req.(*RegisterUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_CreateLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLinkCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).CreateLinkCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/CreateLinkCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).CreateLinkCode(ctx, req.(*CreateLinkCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_UseLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseLinkCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).UseLinkCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/UseLinkCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).UseLinkCode(ctx, req.(*UseLinkCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_SwipeBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SwipeBoardRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +739,42 @@ func _BoardService_CombineCells_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_SwapCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).SwapCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/SwapCells",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).SwapCells(ctx, req.(*SwapCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_UsePower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsePowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).UsePower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/UsePower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).UsePower(ctx, req.(*UsePowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_GenerateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateGameRequest)
 	if err := dec(in); err != nil {
@@ -384,6 +793,27 @@ func _BoardService_GenerateGame_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_GenerateGameStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateGameStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoardServiceServer).GenerateGameStream(m, &boardServiceGenerateGameStreamServer{stream})
+}
+
+type BoardService_GenerateGameStreamServer interface {
+	Send(*GenerateGameStreamResponse) error
+	grpc.ServerStream
+}
+
+type boardServiceGenerateGameStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardServiceGenerateGameStreamServer) Send(m *GenerateGameStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BoardService_VoteBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VoteBoardRequest)
 	if err := dec(in); err != nil {
@@ -420,6 +850,78 @@ func _BoardService_GetGameChallenges_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_GetChallengeLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).GetChallengeLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/GetChallengeLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).GetChallengeLeaderboard(ctx, req.(*GetChallengeLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_GetDailyChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).GetDailyChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/GetDailyChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).GetDailyChallenge(ctx, req.(*GetDailyChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_ListDailyChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDailyChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).ListDailyChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/ListDailyChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).ListDailyChallenges(ctx, req.(*ListDailyChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_GetDailyLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).GetDailyLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/GetDailyLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).GetDailyLeaderboard(ctx, req.(*GetDailyLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_CreateGameChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateGameChallengeRequest)
 	if err := dec(in); err != nil {
@@ -438,6 +940,42 @@ func _BoardService_CreateGameChallenge_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_CreateShareCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).CreateShareCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/CreateShareCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).CreateShareCode(ctx, req.(*CreateShareCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_NewGameFromShareCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewGameFromShareCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).NewGameFromShareCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tally.v1.BoardService/NewGameFromShareCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).NewGameFromShareCode(ctx, req.(*NewGameFromShareCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BoardService_ServiceDesc is the grpc.ServiceDesc for BoardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -461,14 +999,42 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Undo",
 			Handler:    _BoardService_Undo_Handler,
 		},
+		{
+			MethodName: "Redo",
+			Handler:    _BoardService_Redo_Handler,
+		},
 		{
 			MethodName: "RestartGame",
 			Handler:    _BoardService_RestartGame_Handler,
 		},
+		{
+			MethodName: "ListGames",
+			Handler:    _BoardService_ListGames_Handler,
+		},
+		{
+			MethodName: "ResumeGame",
+			Handler:    _BoardService_ResumeGame_Handler,
+		},
 		{
 			MethodName: "GetSession",
 			Handler:    _BoardService_GetSession_Handler,
 		},
+		{
+			MethodName: "RegisterUser",
+			Handler:    _BoardService_RegisterUser_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _BoardService_Login_Handler,
+		},
+		{
+			MethodName: "CreateLinkCode",
+			Handler:    _BoardService_CreateLinkCode_Handler,
+		},
+		{
+			MethodName: "UseLinkCode",
+			Handler:    _BoardService_UseLinkCode_Handler,
+		},
 		{
 			MethodName: "SwipeBoard",
 			Handler:    _BoardService_SwipeBoard_Handler,
@@ -477,6 +1043,14 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CombineCells",
 			Handler:    _BoardService_CombineCells_Handler,
 		},
+		{
+			MethodName: "SwapCells",
+			Handler:    _BoardService_SwapCells_Handler,
+		},
+		{
+			MethodName: "UsePower",
+			Handler:    _BoardService_UsePower_Handler,
+		},
 		{
 			MethodName: "GenerateGame",
 			Handler:    _BoardService_GenerateGame_Handler,
@@ -489,11 +1063,41 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGameChallenges",
 			Handler:    _BoardService_GetGameChallenges_Handler,
 		},
+		{
+			MethodName: "GetChallengeLeaderboard",
+			Handler:    _BoardService_GetChallengeLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetDailyChallenge",
+			Handler:    _BoardService_GetDailyChallenge_Handler,
+		},
+		{
+			MethodName: "ListDailyChallenges",
+			Handler:    _BoardService_ListDailyChallenges_Handler,
+		},
+		{
+			MethodName: "GetDailyLeaderboard",
+			Handler:    _BoardService_GetDailyLeaderboard_Handler,
+		},
 		{
 			MethodName: "CreateGameChallenge",
 			Handler:    _BoardService_CreateGameChallenge_Handler,
 		},
+		{
+			MethodName: "CreateShareCode",
+			Handler:    _BoardService_CreateShareCode_Handler,
+		},
+		{
+			MethodName: "NewGameFromShareCode",
+			Handler:    _BoardService_NewGameFromShareCode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateGameStream",
+			Handler:       _BoardService_GenerateGameStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/tally/v1/board.proto",
 }
@@ -31,14 +31,30 @@ type BoardServiceClient interface {
 	NewGameFromTemplate(context.Context, *connect_go.Request[v1.NewGameFromTemplateRequest]) (*connect_go.Response[v1.NewGameFromTemplateResponse], error)
 	GetHint(context.Context, *connect_go.Request[v1.GetHintRequest]) (*connect_go.Response[v1.GetHintResponse], error)
 	Undo(context.Context, *connect_go.Request[v1.UndoRequest]) (*connect_go.Response[v1.UndoResponse], error)
+	Redo(context.Context, *connect_go.Request[v1.RedoRequest]) (*connect_go.Response[v1.RedoResponse], error)
 	RestartGame(context.Context, *connect_go.Request[v1.RestartGameRequest]) (*connect_go.Response[v1.RestartGameResponse], error)
+	ListGames(context.Context, *connect_go.Request[v1.ListGamesRequest]) (*connect_go.Response[v1.ListGamesResponse], error)
+	ResumeGame(context.Context, *connect_go.Request[v1.ResumeGameRequest]) (*connect_go.Response[v1.ResumeGameResponse], error)
 	GetSession(context.Context, *connect_go.Request[v1.GetSessionRequest]) (*connect_go.Response[v1.GetSessionResponse], error)
+	RegisterUser(context.Context, *connect_go.Request[v1.RegisterUserRequest]) (*connect_go.Response[v1.RegisterUserResponse], error)
+	Login(context.Context, *connect_go.Request[v1.LoginRequest]) (*connect_go.Response[v1.LoginResponse], error)
+	CreateLinkCode(context.Context, *connect_go.Request[v1.CreateLinkCodeRequest]) (*connect_go.Response[v1.CreateLinkCodeResponse], error)
+	UseLinkCode(context.Context, *connect_go.Request[v1.UseLinkCodeRequest]) (*connect_go.Response[v1.UseLinkCodeResponse], error)
 	SwipeBoard(context.Context, *connect_go.Request[v1.SwipeBoardRequest]) (*connect_go.Response[v1.SwipeBoardResponse], error)
 	CombineCells(context.Context, *connect_go.Request[v1.CombineCellsRequest]) (*connect_go.Response[v1.CombineCellsResponse], error)
+	SwapCells(context.Context, *connect_go.Request[v1.SwapCellsRequest]) (*connect_go.Response[v1.SwapCellsResponse], error)
+	UsePower(context.Context, *connect_go.Request[v1.UsePowerRequest]) (*connect_go.Response[v1.UsePowerResponse], error)
 	GenerateGame(context.Context, *connect_go.Request[v1.GenerateGameRequest]) (*connect_go.Response[v1.GenerateGameResponse], error)
+	GenerateGameStream(context.Context, *connect_go.Request[v1.GenerateGameStreamRequest]) (*connect_go.ServerStreamForClient[v1.GenerateGameStreamResponse], error)
 	VoteBoard(context.Context, *connect_go.Request[v1.VoteBoardRequest]) (*connect_go.Response[v1.VoteBoardResponse], error)
 	GetGameChallenges(context.Context, *connect_go.Request[v1.GetGameChallengesRequest]) (*connect_go.Response[v1.GetGameChallengesResponse], error)
+	GetChallengeLeaderboard(context.Context, *connect_go.Request[v1.GetChallengeLeaderboardRequest]) (*connect_go.Response[v1.GetChallengeLeaderboardResponse], error)
+	GetDailyChallenge(context.Context, *connect_go.Request[v1.GetDailyChallengeRequest]) (*connect_go.Response[v1.GetDailyChallengeResponse], error)
+	ListDailyChallenges(context.Context, *connect_go.Request[v1.ListDailyChallengesRequest]) (*connect_go.Response[v1.ListDailyChallengesResponse], error)
+	GetDailyLeaderboard(context.Context, *connect_go.Request[v1.GetDailyLeaderboardRequest]) (*connect_go.Response[v1.GetDailyLeaderboardResponse], error)
 	CreateGameChallenge(context.Context, *connect_go.Request[v1.CreateGameChallengeRequest]) (*connect_go.Response[v1.CreateGameChallengeResponse], error)
+	CreateShareCode(context.Context, *connect_go.Request[v1.CreateShareCodeRequest]) (*connect_go.Response[v1.CreateShareCodeResponse], error)
+	NewGameFromShareCode(context.Context, *connect_go.Request[v1.NewGameFromShareCodeRequest]) (*connect_go.Response[v1.NewGameFromShareCodeResponse], error)
 }
 
 // NewBoardServiceClient constructs a client for the tally.v1.BoardService service. By default, it
@@ -71,16 +87,51 @@ func NewBoardServiceClient(httpClient connect_go.HTTPClient, baseURL string, opt
 			baseURL+"/tally.v1.BoardService/Undo",
 			opts...,
 		),
+		redo: connect_go.NewClient[v1.RedoRequest, v1.RedoResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/Redo",
+			opts...,
+		),
 		restartGame: connect_go.NewClient[v1.RestartGameRequest, v1.RestartGameResponse](
 			httpClient,
 			baseURL+"/tally.v1.BoardService/RestartGame",
 			opts...,
 		),
+		listGames: connect_go.NewClient[v1.ListGamesRequest, v1.ListGamesResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/ListGames",
+			opts...,
+		),
+		resumeGame: connect_go.NewClient[v1.ResumeGameRequest, v1.ResumeGameResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/ResumeGame",
+			opts...,
+		),
 		getSession: connect_go.NewClient[v1.GetSessionRequest, v1.GetSessionResponse](
 			httpClient,
 			baseURL+"/tally.v1.BoardService/GetSession",
 			opts...,
 		),
+		registerUser: connect_go.NewClient[v1.RegisterUserRequest, v1.RegisterUserResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/RegisterUser",
+			opts...,
+		),
+		login: connect_go.NewClient[v1.LoginRequest, v1.LoginResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/Login",
+			opts...,
+		),
+		createLinkCode: connect_go.NewClient[v1.CreateLinkCodeRequest, v1.CreateLinkCodeResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/CreateLinkCode",
+			opts...,
+		),
+		useLinkCode: connect_go.NewClient[v1.UseLinkCodeRequest, v1.UseLinkCodeResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/UseLinkCode",
+			opts...,
+		),
 		swipeBoard: connect_go.NewClient[v1.SwipeBoardRequest, v1.SwipeBoardResponse](
 			httpClient,
 			baseURL+"/tally.v1.BoardService/SwipeBoard",
@@ -91,11 +142,26 @@ func NewBoardServiceClient(httpClient connect_go.HTTPClient, baseURL string, opt
 			baseURL+"/tally.v1.BoardService/CombineCells",
 			opts...,
 		),
+		swapCells: connect_go.NewClient[v1.SwapCellsRequest, v1.SwapCellsResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/SwapCells",
+			opts...,
+		),
+		usePower: connect_go.NewClient[v1.UsePowerRequest, v1.UsePowerResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/UsePower",
+			opts...,
+		),
 		generateGame: connect_go.NewClient[v1.GenerateGameRequest, v1.GenerateGameResponse](
 			httpClient,
 			baseURL+"/tally.v1.BoardService/GenerateGame",
 			opts...,
 		),
+		generateGameStream: connect_go.NewClient[v1.GenerateGameStreamRequest, v1.GenerateGameStreamResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/GenerateGameStream",
+			opts...,
+		),
 		voteBoard: connect_go.NewClient[v1.VoteBoardRequest, v1.VoteBoardResponse](
 			httpClient,
 			baseURL+"/tally.v1.BoardService/VoteBoard",
@@ -106,28 +172,74 @@ func NewBoardServiceClient(httpClient connect_go.HTTPClient, baseURL string, opt
 			baseURL+"/tally.v1.BoardService/GetGameChallenges",
 			opts...,
 		),
+		getChallengeLeaderboard: connect_go.NewClient[v1.GetChallengeLeaderboardRequest, v1.GetChallengeLeaderboardResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/GetChallengeLeaderboard",
+			opts...,
+		),
+		getDailyChallenge: connect_go.NewClient[v1.GetDailyChallengeRequest, v1.GetDailyChallengeResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/GetDailyChallenge",
+			opts...,
+		),
+		listDailyChallenges: connect_go.NewClient[v1.ListDailyChallengesRequest, v1.ListDailyChallengesResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/ListDailyChallenges",
+			opts...,
+		),
+		getDailyLeaderboard: connect_go.NewClient[v1.GetDailyLeaderboardRequest, v1.GetDailyLeaderboardResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/GetDailyLeaderboard",
+			opts...,
+		),
 		createGameChallenge: connect_go.NewClient[v1.CreateGameChallengeRequest, v1.CreateGameChallengeResponse](
 			httpClient,
 			baseURL+"/tally.v1.BoardService/CreateGameChallenge",
 			opts...,
 		),
+		createShareCode: connect_go.NewClient[v1.CreateShareCodeRequest, v1.CreateShareCodeResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/CreateShareCode",
+			opts...,
+		),
+		newGameFromShareCode: connect_go.NewClient[v1.NewGameFromShareCodeRequest, v1.NewGameFromShareCodeResponse](
+			httpClient,
+			baseURL+"/tally.v1.BoardService/NewGameFromShareCode",
+			opts...,
+		),
 	}
 }
 
 // boardServiceClient implements BoardServiceClient.
 type boardServiceClient struct {
-	newGame             *connect_go.Client[v1.NewGameRequest, v1.NewGameResponse]
-	newGameFromTemplate *connect_go.Client[v1.NewGameFromTemplateRequest, v1.NewGameFromTemplateResponse]
-	getHint             *connect_go.Client[v1.GetHintRequest, v1.GetHintResponse]
-	undo                *connect_go.Client[v1.UndoRequest, v1.UndoResponse]
-	restartGame         *connect_go.Client[v1.RestartGameRequest, v1.RestartGameResponse]
-	getSession          *connect_go.Client[v1.GetSessionRequest, v1.GetSessionResponse]
-	swipeBoard          *connect_go.Client[v1.SwipeBoardRequest, v1.SwipeBoardResponse]
-	combineCells        *connect_go.Client[v1.CombineCellsRequest, v1.CombineCellsResponse]
-	generateGame        *connect_go.Client[v1.GenerateGameRequest, v1.GenerateGameResponse]
-	voteBoard           *connect_go.Client[v1.VoteBoardRequest, v1.VoteBoardResponse]
-	getGameChallenges   *connect_go.Client[v1.GetGameChallengesRequest, v1.GetGameChallengesResponse]
-	createGameChallenge *connect_go.Client[v1.CreateGameChallengeRequest, v1.CreateGameChallengeResponse]
+	newGame                 *connect_go.Client[v1.NewGameRequest, v1.NewGameResponse]
+	newGameFromTemplate     *connect_go.Client[v1.NewGameFromTemplateRequest, v1.NewGameFromTemplateResponse]
+	getHint                 *connect_go.Client[v1.GetHintRequest, v1.GetHintResponse]
+	undo                    *connect_go.Client[v1.UndoRequest, v1.UndoResponse]
+	redo                    *connect_go.Client[v1.RedoRequest, v1.RedoResponse]
+	restartGame             *connect_go.Client[v1.RestartGameRequest, v1.RestartGameResponse]
+	listGames               *connect_go.Client[v1.ListGamesRequest, v1.ListGamesResponse]
+	resumeGame              *connect_go.Client[v1.ResumeGameRequest, v1.ResumeGameResponse]
+	getSession              *connect_go.Client[v1.GetSessionRequest, v1.GetSessionResponse]
+	registerUser            *connect_go.Client[v1.RegisterUserRequest, v1.RegisterUserResponse]
+	login                   *connect_go.Client[v1.LoginRequest, v1.LoginResponse]
+	createLinkCode          *connect_go.Client[v1.CreateLinkCodeRequest, v1.CreateLinkCodeResponse]
+	useLinkCode             *connect_go.Client[v1.UseLinkCodeRequest, v1.UseLinkCodeResponse]
+	swipeBoard              *connect_go.Client[v1.SwipeBoardRequest, v1.SwipeBoardResponse]
+	combineCells            *connect_go.Client[v1.CombineCellsRequest, v1.CombineCellsResponse]
+	swapCells               *connect_go.Client[v1.SwapCellsRequest, v1.SwapCellsResponse]
+	usePower                *connect_go.Client[v1.UsePowerRequest, v1.UsePowerResponse]
+	generateGame            *connect_go.Client[v1.GenerateGameRequest, v1.GenerateGameResponse]
+	generateGameStream      *connect_go.Client[v1.GenerateGameStreamRequest, v1.GenerateGameStreamResponse]
+	voteBoard               *connect_go.Client[v1.VoteBoardRequest, v1.VoteBoardResponse]
+	getGameChallenges       *connect_go.Client[v1.GetGameChallengesRequest, v1.GetGameChallengesResponse]
+	getChallengeLeaderboard *connect_go.Client[v1.GetChallengeLeaderboardRequest, v1.GetChallengeLeaderboardResponse]
+	getDailyChallenge       *connect_go.Client[v1.GetDailyChallengeRequest, v1.GetDailyChallengeResponse]
+	listDailyChallenges     *connect_go.Client[v1.ListDailyChallengesRequest, v1.ListDailyChallengesResponse]
+	getDailyLeaderboard     *connect_go.Client[v1.GetDailyLeaderboardRequest, v1.GetDailyLeaderboardResponse]
+	createGameChallenge     *connect_go.Client[v1.CreateGameChallengeRequest, v1.CreateGameChallengeResponse]
+	createShareCode         *connect_go.Client[v1.CreateShareCodeRequest, v1.CreateShareCodeResponse]
+	newGameFromShareCode    *connect_go.Client[v1.NewGameFromShareCodeRequest, v1.NewGameFromShareCodeResponse]
 }
 
 // NewGame calls tally.v1.BoardService.NewGame.
@@ -150,16 +262,51 @@ func (c *boardServiceClient) Undo(ctx context.Context, req *connect_go.Request[v
 	return c.undo.CallUnary(ctx, req)
 }
 
+// Redo calls tally.v1.BoardService.Redo.
+func (c *boardServiceClient) Redo(ctx context.Context, req *connect_go.Request[v1.RedoRequest]) (*connect_go.Response[v1.RedoResponse], error) {
+	return c.redo.CallUnary(ctx, req)
+}
+
 // RestartGame calls tally.v1.BoardService.RestartGame.
 func (c *boardServiceClient) RestartGame(ctx context.Context, req *connect_go.Request[v1.RestartGameRequest]) (*connect_go.Response[v1.RestartGameResponse], error) {
 	return c.restartGame.CallUnary(ctx, req)
 }
 
+// ListGames calls tally.v1.BoardService.ListGames.
+func (c *boardServiceClient) ListGames(ctx context.Context, req *connect_go.Request[v1.ListGamesRequest]) (*connect_go.Response[v1.ListGamesResponse], error) {
+	return c.listGames.CallUnary(ctx, req)
+}
+
+// ResumeGame calls tally.v1.BoardService.ResumeGame.
+func (c *boardServiceClient) ResumeGame(ctx context.Context, req *connect_go.Request[v1.ResumeGameRequest]) (*connect_go.Response[v1.ResumeGameResponse], error) {
+	return c.resumeGame.CallUnary(ctx, req)
+}
+
 // GetSession calls tally.v1.BoardService.GetSession.
 func (c *boardServiceClient) GetSession(ctx context.Context, req *connect_go.Request[v1.GetSessionRequest]) (*connect_go.Response[v1.GetSessionResponse], error) {
 	return c.getSession.CallUnary(ctx, req)
 }
 
+// RegisterUser calls tally.v1.BoardService.RegisterUser.
+func (c *boardServiceClient) RegisterUser(ctx context.Context, req *connect_go.Request[v1.RegisterUserRequest]) (*connect_go.Response[v1.RegisterUserResponse], error) {
+	return c.registerUser.CallUnary(ctx, req)
+}
+
+// Login calls tally.v1.BoardService.Login.
+func (c *boardServiceClient) Login(ctx context.Context, req *connect_go.Request[v1.LoginRequest]) (*connect_go.Response[v1.LoginResponse], error) {
+	return c.login.CallUnary(ctx, req)
+}
+
+// CreateLinkCode calls tally.v1.BoardService.CreateLinkCode.
+func (c *boardServiceClient) CreateLinkCode(ctx context.Context, req *connect_go.Request[v1.CreateLinkCodeRequest]) (*connect_go.Response[v1.CreateLinkCodeResponse], error) {
+	return c.createLinkCode.CallUnary(ctx, req)
+}
+
+// UseLinkCode calls tally.v1.BoardService.UseLinkCode.
+func (c *boardServiceClient) UseLinkCode(ctx context.Context, req *connect_go.Request[v1.UseLinkCodeRequest]) (*connect_go.Response[v1.UseLinkCodeResponse], error) {
+	return c.useLinkCode.CallUnary(ctx, req)
+}
+
 // SwipeBoard calls tally.v1.BoardService.SwipeBoard.
 func (c *boardServiceClient) SwipeBoard(ctx context.Context, req *connect_go.Request[v1.SwipeBoardRequest]) (*connect_go.Response[v1.SwipeBoardResponse], error) {
 	return c.swipeBoard.CallUnary(ctx, req)
@@ -170,11 +317,26 @@ func (c *boardServiceClient) CombineCells(ctx context.Context, req *connect_go.R
 	return c.combineCells.CallUnary(ctx, req)
 }
 
+// SwapCells calls tally.v1.BoardService.SwapCells.
+func (c *boardServiceClient) SwapCells(ctx context.Context, req *connect_go.Request[v1.SwapCellsRequest]) (*connect_go.Response[v1.SwapCellsResponse], error) {
+	return c.swapCells.CallUnary(ctx, req)
+}
+
+// UsePower calls tally.v1.BoardService.UsePower.
+func (c *boardServiceClient) UsePower(ctx context.Context, req *connect_go.Request[v1.UsePowerRequest]) (*connect_go.Response[v1.UsePowerResponse], error) {
+	return c.usePower.CallUnary(ctx, req)
+}
+
 // GenerateGame calls tally.v1.BoardService.GenerateGame.
 func (c *boardServiceClient) GenerateGame(ctx context.Context, req *connect_go.Request[v1.GenerateGameRequest]) (*connect_go.Response[v1.GenerateGameResponse], error) {
 	return c.generateGame.CallUnary(ctx, req)
 }
 
+// GenerateGameStream calls tally.v1.BoardService.GenerateGameStream.
+func (c *boardServiceClient) GenerateGameStream(ctx context.Context, req *connect_go.Request[v1.GenerateGameStreamRequest]) (*connect_go.ServerStreamForClient[v1.GenerateGameStreamResponse], error) {
+	return c.generateGameStream.CallServerStream(ctx, req)
+}
+
 // VoteBoard calls tally.v1.BoardService.VoteBoard.
 func (c *boardServiceClient) VoteBoard(ctx context.Context, req *connect_go.Request[v1.VoteBoardRequest]) (*connect_go.Response[v1.VoteBoardResponse], error) {
 	return c.voteBoard.CallUnary(ctx, req)
@@ -185,25 +347,71 @@ func (c *boardServiceClient) GetGameChallenges(ctx context.Context, req *connect
 	return c.getGameChallenges.CallUnary(ctx, req)
 }
 
+// GetChallengeLeaderboard calls tally.v1.BoardService.GetChallengeLeaderboard.
+func (c *boardServiceClient) GetChallengeLeaderboard(ctx context.Context, req *connect_go.Request[v1.GetChallengeLeaderboardRequest]) (*connect_go.Response[v1.GetChallengeLeaderboardResponse], error) {
+	return c.getChallengeLeaderboard.CallUnary(ctx, req)
+}
+
+// GetDailyChallenge calls tally.v1.BoardService.GetDailyChallenge.
+func (c *boardServiceClient) GetDailyChallenge(ctx context.Context, req *connect_go.Request[v1.GetDailyChallengeRequest]) (*connect_go.Response[v1.GetDailyChallengeResponse], error) {
+	return c.getDailyChallenge.CallUnary(ctx, req)
+}
+
+// ListDailyChallenges calls tally.v1.BoardService.ListDailyChallenges.
+func (c *boardServiceClient) ListDailyChallenges(ctx context.Context, req *connect_go.Request[v1.ListDailyChallengesRequest]) (*connect_go.Response[v1.ListDailyChallengesResponse], error) {
+	return c.listDailyChallenges.CallUnary(ctx, req)
+}
+
+// GetDailyLeaderboard calls tally.v1.BoardService.GetDailyLeaderboard.
+func (c *boardServiceClient) GetDailyLeaderboard(ctx context.Context, req *connect_go.Request[v1.GetDailyLeaderboardRequest]) (*connect_go.Response[v1.GetDailyLeaderboardResponse], error) {
+	return c.getDailyLeaderboard.CallUnary(ctx, req)
+}
+
 // CreateGameChallenge calls tally.v1.BoardService.CreateGameChallenge.
 func (c *boardServiceClient) CreateGameChallenge(ctx context.Context, req *connect_go.Request[v1.CreateGameChallengeRequest]) (*connect_go.Response[v1.CreateGameChallengeResponse], error) {
 	return c.createGameChallenge.CallUnary(ctx, req)
 }
 
+// CreateShareCode calls tally.v1.BoardService.CreateShareCode.
+func (c *boardServiceClient) CreateShareCode(ctx context.Context, req *connect_go.Request[v1.CreateShareCodeRequest]) (*connect_go.Response[v1.CreateShareCodeResponse], error) {
+	return c.createShareCode.CallUnary(ctx, req)
+}
+
+// NewGameFromShareCode calls tally.v1.BoardService.NewGameFromShareCode.
+func (c *boardServiceClient) NewGameFromShareCode(ctx context.Context, req *connect_go.Request[v1.NewGameFromShareCodeRequest]) (*connect_go.Response[v1.NewGameFromShareCodeResponse], error) {
+	return c.newGameFromShareCode.CallUnary(ctx, req)
+}
+
 // BoardServiceHandler is an implementation of the tally.v1.BoardService service.
 type BoardServiceHandler interface {
 	NewGame(context.Context, *connect_go.Request[v1.NewGameRequest]) (*connect_go.Response[v1.NewGameResponse], error)
 	NewGameFromTemplate(context.Context, *connect_go.Request[v1.NewGameFromTemplateRequest]) (*connect_go.Response[v1.NewGameFromTemplateResponse], error)
 	GetHint(context.Context, *connect_go.Request[v1.GetHintRequest]) (*connect_go.Response[v1.GetHintResponse], error)
 	Undo(context.Context, *connect_go.Request[v1.UndoRequest]) (*connect_go.Response[v1.UndoResponse], error)
+	Redo(context.Context, *connect_go.Request[v1.RedoRequest]) (*connect_go.Response[v1.RedoResponse], error)
 	RestartGame(context.Context, *connect_go.Request[v1.RestartGameRequest]) (*connect_go.Response[v1.RestartGameResponse], error)
+	ListGames(context.Context, *connect_go.Request[v1.ListGamesRequest]) (*connect_go.Response[v1.ListGamesResponse], error)
+	ResumeGame(context.Context, *connect_go.Request[v1.ResumeGameRequest]) (*connect_go.Response[v1.ResumeGameResponse], error)
 	GetSession(context.Context, *connect_go.Request[v1.GetSessionRequest]) (*connect_go.Response[v1.GetSessionResponse], error)
+	RegisterUser(context.Context, *connect_go.Request[v1.RegisterUserRequest]) (*connect_go.Response[v1.RegisterUserResponse], error)
+	Login(context.Context, *connect_go.Request[v1.LoginRequest]) (*connect_go.Response[v1.LoginResponse], error)
+	CreateLinkCode(context.Context, *connect_go.Request[v1.CreateLinkCodeRequest]) (*connect_go.Response[v1.CreateLinkCodeResponse], error)
+	UseLinkCode(context.Context, *connect_go.Request[v1.UseLinkCodeRequest]) (*connect_go.Response[v1.UseLinkCodeResponse], error)
 	SwipeBoard(context.Context, *connect_go.Request[v1.SwipeBoardRequest]) (*connect_go.Response[v1.SwipeBoardResponse], error)
 	CombineCells(context.Context, *connect_go.Request[v1.CombineCellsRequest]) (*connect_go.Response[v1.CombineCellsResponse], error)
+	SwapCells(context.Context, *connect_go.Request[v1.SwapCellsRequest]) (*connect_go.Response[v1.SwapCellsResponse], error)
+	UsePower(context.Context, *connect_go.Request[v1.UsePowerRequest]) (*connect_go.Response[v1.UsePowerResponse], error)
 	GenerateGame(context.Context, *connect_go.Request[v1.GenerateGameRequest]) (*connect_go.Response[v1.GenerateGameResponse], error)
+	GenerateGameStream(context.Context, *connect_go.Request[v1.GenerateGameStreamRequest], *connect_go.ServerStream[v1.GenerateGameStreamResponse]) error
 	VoteBoard(context.Context, *connect_go.Request[v1.VoteBoardRequest]) (*connect_go.Response[v1.VoteBoardResponse], error)
 	GetGameChallenges(context.Context, *connect_go.Request[v1.GetGameChallengesRequest]) (*connect_go.Response[v1.GetGameChallengesResponse], error)
+	GetChallengeLeaderboard(context.Context, *connect_go.Request[v1.GetChallengeLeaderboardRequest]) (*connect_go.Response[v1.GetChallengeLeaderboardResponse], error)
+	GetDailyChallenge(context.Context, *connect_go.Request[v1.GetDailyChallengeRequest]) (*connect_go.Response[v1.GetDailyChallengeResponse], error)
+	ListDailyChallenges(context.Context, *connect_go.Request[v1.ListDailyChallengesRequest]) (*connect_go.Response[v1.ListDailyChallengesResponse], error)
+	GetDailyLeaderboard(context.Context, *connect_go.Request[v1.GetDailyLeaderboardRequest]) (*connect_go.Response[v1.GetDailyLeaderboardResponse], error)
 	CreateGameChallenge(context.Context, *connect_go.Request[v1.CreateGameChallengeRequest]) (*connect_go.Response[v1.CreateGameChallengeResponse], error)
+	CreateShareCode(context.Context, *connect_go.Request[v1.CreateShareCodeRequest]) (*connect_go.Response[v1.CreateShareCodeResponse], error)
+	NewGameFromShareCode(context.Context, *connect_go.Request[v1.NewGameFromShareCodeRequest]) (*connect_go.Response[v1.NewGameFromShareCodeResponse], error)
 }
 
 // NewBoardServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -233,16 +441,51 @@ func NewBoardServiceHandler(svc BoardServiceHandler, opts ...connect_go.HandlerO
 		svc.Undo,
 		opts...,
 	))
+	mux.Handle("/tally.v1.BoardService/Redo", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/Redo",
+		svc.Redo,
+		opts...,
+	))
 	mux.Handle("/tally.v1.BoardService/RestartGame", connect_go.NewUnaryHandler(
 		"/tally.v1.BoardService/RestartGame",
 		svc.RestartGame,
 		opts...,
 	))
+	mux.Handle("/tally.v1.BoardService/ListGames", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/ListGames",
+		svc.ListGames,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/ResumeGame", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/ResumeGame",
+		svc.ResumeGame,
+		opts...,
+	))
 	mux.Handle("/tally.v1.BoardService/GetSession", connect_go.NewUnaryHandler(
 		"/tally.v1.BoardService/GetSession",
 		svc.GetSession,
 		opts...,
 	))
+	mux.Handle("/tally.v1.BoardService/RegisterUser", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/RegisterUser",
+		svc.RegisterUser,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/Login", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/Login",
+		svc.Login,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/CreateLinkCode", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/CreateLinkCode",
+		svc.CreateLinkCode,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/UseLinkCode", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/UseLinkCode",
+		svc.UseLinkCode,
+		opts...,
+	))
 	mux.Handle("/tally.v1.BoardService/SwipeBoard", connect_go.NewUnaryHandler(
 		"/tally.v1.BoardService/SwipeBoard",
 		svc.SwipeBoard,
@@ -253,11 +496,26 @@ func NewBoardServiceHandler(svc BoardServiceHandler, opts ...connect_go.HandlerO
 		svc.CombineCells,
 		opts...,
 	))
+	mux.Handle("/tally.v1.BoardService/SwapCells", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/SwapCells",
+		svc.SwapCells,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/UsePower", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/UsePower",
+		svc.UsePower,
+		opts...,
+	))
 	mux.Handle("/tally.v1.BoardService/GenerateGame", connect_go.NewUnaryHandler(
 		"/tally.v1.BoardService/GenerateGame",
 		svc.GenerateGame,
 		opts...,
 	))
+	mux.Handle("/tally.v1.BoardService/GenerateGameStream", connect_go.NewServerStreamHandler(
+		"/tally.v1.BoardService/GenerateGameStream",
+		svc.GenerateGameStream,
+		opts...,
+	))
 	mux.Handle("/tally.v1.BoardService/VoteBoard", connect_go.NewUnaryHandler(
 		"/tally.v1.BoardService/VoteBoard",
 		svc.VoteBoard,
@@ -268,11 +526,41 @@ func NewBoardServiceHandler(svc BoardServiceHandler, opts ...connect_go.HandlerO
 		svc.GetGameChallenges,
 		opts...,
 	))
+	mux.Handle("/tally.v1.BoardService/GetChallengeLeaderboard", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/GetChallengeLeaderboard",
+		svc.GetChallengeLeaderboard,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/GetDailyChallenge", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/GetDailyChallenge",
+		svc.GetDailyChallenge,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/ListDailyChallenges", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/ListDailyChallenges",
+		svc.ListDailyChallenges,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/GetDailyLeaderboard", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/GetDailyLeaderboard",
+		svc.GetDailyLeaderboard,
+		opts...,
+	))
 	mux.Handle("/tally.v1.BoardService/CreateGameChallenge", connect_go.NewUnaryHandler(
 		"/tally.v1.BoardService/CreateGameChallenge",
 		svc.CreateGameChallenge,
 		opts...,
 	))
+	mux.Handle("/tally.v1.BoardService/CreateShareCode", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/CreateShareCode",
+		svc.CreateShareCode,
+		opts...,
+	))
+	mux.Handle("/tally.v1.BoardService/NewGameFromShareCode", connect_go.NewUnaryHandler(
+		"/tally.v1.BoardService/NewGameFromShareCode",
+		svc.NewGameFromShareCode,
+		opts...,
+	))
 	return "/tally.v1.BoardService/", mux
 }
 
@@ -295,14 +583,42 @@ func (UnimplementedBoardServiceHandler) Undo(context.Context, *connect_go.Reques
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.Undo is not implemented"))
 }
 
+func (UnimplementedBoardServiceHandler) Redo(context.Context, *connect_go.Request[v1.RedoRequest]) (*connect_go.Response[v1.RedoResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.Redo is not implemented"))
+}
+
 func (UnimplementedBoardServiceHandler) RestartGame(context.Context, *connect_go.Request[v1.RestartGameRequest]) (*connect_go.Response[v1.RestartGameResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.RestartGame is not implemented"))
 }
 
+func (UnimplementedBoardServiceHandler) ListGames(context.Context, *connect_go.Request[v1.ListGamesRequest]) (*connect_go.Response[v1.ListGamesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.ListGames is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) ResumeGame(context.Context, *connect_go.Request[v1.ResumeGameRequest]) (*connect_go.Response[v1.ResumeGameResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.ResumeGame is not implemented"))
+}
+
 func (UnimplementedBoardServiceHandler) GetSession(context.Context, *connect_go.Request[v1.GetSessionRequest]) (*connect_go.Response[v1.GetSessionResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.GetSession is not implemented"))
 }
 
+func (UnimplementedBoardServiceHandler) RegisterUser(context.Context, *connect_go.Request[v1.RegisterUserRequest]) (*connect_go.Response[v1.RegisterUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.RegisterUser is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) Login(context.Context, *connect_go.Request[v1.LoginRequest]) (*connect_go.Response[v1.LoginResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.Login is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) CreateLinkCode(context.Context, *connect_go.Request[v1.CreateLinkCodeRequest]) (*connect_go.Response[v1.CreateLinkCodeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.CreateLinkCode is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) UseLinkCode(context.Context, *connect_go.Request[v1.UseLinkCodeRequest]) (*connect_go.Response[v1.UseLinkCodeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.UseLinkCode is not implemented"))
+}
+
 func (UnimplementedBoardServiceHandler) SwipeBoard(context.Context, *connect_go.Request[v1.SwipeBoardRequest]) (*connect_go.Response[v1.SwipeBoardResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.SwipeBoard is not implemented"))
 }
@@ -311,10 +627,22 @@ func (UnimplementedBoardServiceHandler) CombineCells(context.Context, *connect_g
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.CombineCells is not implemented"))
 }
 
+func (UnimplementedBoardServiceHandler) SwapCells(context.Context, *connect_go.Request[v1.SwapCellsRequest]) (*connect_go.Response[v1.SwapCellsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.SwapCells is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) UsePower(context.Context, *connect_go.Request[v1.UsePowerRequest]) (*connect_go.Response[v1.UsePowerResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.UsePower is not implemented"))
+}
+
 func (UnimplementedBoardServiceHandler) GenerateGame(context.Context, *connect_go.Request[v1.GenerateGameRequest]) (*connect_go.Response[v1.GenerateGameResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.GenerateGame is not implemented"))
 }
 
+func (UnimplementedBoardServiceHandler) GenerateGameStream(context.Context, *connect_go.Request[v1.GenerateGameStreamRequest], *connect_go.ServerStream[v1.GenerateGameStreamResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.GenerateGameStream is not implemented"))
+}
+
 func (UnimplementedBoardServiceHandler) VoteBoard(context.Context, *connect_go.Request[v1.VoteBoardRequest]) (*connect_go.Response[v1.VoteBoardResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.VoteBoard is not implemented"))
 }
@@ -323,6 +651,30 @@ func (UnimplementedBoardServiceHandler) GetGameChallenges(context.Context, *conn
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.GetGameChallenges is not implemented"))
 }
 
+func (UnimplementedBoardServiceHandler) GetChallengeLeaderboard(context.Context, *connect_go.Request[v1.GetChallengeLeaderboardRequest]) (*connect_go.Response[v1.GetChallengeLeaderboardResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.GetChallengeLeaderboard is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) GetDailyChallenge(context.Context, *connect_go.Request[v1.GetDailyChallengeRequest]) (*connect_go.Response[v1.GetDailyChallengeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.GetDailyChallenge is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) ListDailyChallenges(context.Context, *connect_go.Request[v1.ListDailyChallengesRequest]) (*connect_go.Response[v1.ListDailyChallengesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.ListDailyChallenges is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) GetDailyLeaderboard(context.Context, *connect_go.Request[v1.GetDailyLeaderboardRequest]) (*connect_go.Response[v1.GetDailyLeaderboardResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.GetDailyLeaderboard is not implemented"))
+}
+
 func (UnimplementedBoardServiceHandler) CreateGameChallenge(context.Context, *connect_go.Request[v1.CreateGameChallengeRequest]) (*connect_go.Response[v1.CreateGameChallengeResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.CreateGameChallenge is not implemented"))
 }
+
+func (UnimplementedBoardServiceHandler) CreateShareCode(context.Context, *connect_go.Request[v1.CreateShareCodeRequest]) (*connect_go.Response[v1.CreateShareCodeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.CreateShareCode is not implemented"))
+}
+
+func (UnimplementedBoardServiceHandler) NewGameFromShareCode(context.Context, *connect_go.Request[v1.NewGameFromShareCodeRequest]) (*connect_go.Response[v1.NewGameFromShareCodeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tally.v1.BoardService.NewGameFromShareCode is not implemented"))
+}
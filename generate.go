@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/runar-rkmedia/gotally/randomizer"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+	gamegenerator_target_cell "github.com/runar-rkmedia/gotally/tallylogic/gameGeneratorTargetCell"
+	"github.com/runar-rkmedia/skiver/utils"
+)
+
+const (
+	// The original generator, which generates random boards, and attempts to solve them
+	algorithmRandom = "random"
+	// Generates boards in reverse, from the target-cell
+	algorithmTargetCell = "target-cell"
+)
+
+const defaultGeneratorConfig = "./generator-config.toml"
+
+// Options for the generate-command. These can be set in the toml-file,
+// and overridden with flags.
+type generateOptions struct {
+	Algorithm                                                string
+	Rows, Columns, TargetCellValue, MaxBricks, MinBricks     int
+	MinMoves, MaxMoves, Concurrency, MaxIterations, MinGames int
+	OutDir                                                   string
+	Timeout                                                  time.Duration
+}
+
+func (op generateOptions) validate() error {
+	if op.Algorithm != algorithmRandom && op.Algorithm != algorithmTargetCell {
+		return fmt.Errorf("%w: algorithm must be one of %s, %s", errUsage, algorithmRandom, algorithmTargetCell)
+	}
+	if op.Rows <= 0 || op.Columns <= 0 {
+		return fmt.Errorf("%w: rows and columns must be positive", errUsage)
+	}
+	if op.TargetCellValue <= 0 {
+		return fmt.Errorf("%w: target must be positive", errUsage)
+	}
+	if op.MaxMoves <= 0 {
+		return fmt.Errorf("%w: max-moves must be positive", errUsage)
+	}
+	if op.MinGames <= 0 {
+		return fmt.Errorf("%w: min-games must be positive", errUsage)
+	}
+	if op.OutDir == "" {
+		return fmt.Errorf("%w: out must be set", errUsage)
+	}
+	return nil
+}
+
+func runGenerate(args []string) error {
+	op := generateOptions{
+		Algorithm:     algorithmRandom,
+		Rows:          3,
+		Columns:       3,
+		MaxMoves:      7,
+		MinGames:      1,
+		Concurrency:   1,
+		MaxIterations: 100_000,
+		OutDir:        path.Join(".", "generated", "games"),
+	}
+	fs := newFlagSet("generate", "[flags]\n\nFlags override the values from the config-file.")
+	configPath := fs.String("config", defaultGeneratorConfig, "Path to toml-file with generator-options. It is optional, unless set explicitly")
+	pprofAddress := fs.String("pprof", "", "Start pprof on this address, for instance localhost:6060")
+	fs.StringVar(&op.Algorithm, "algorithm", op.Algorithm, fmt.Sprintf("Generator-algorithm to use (%s, %s)", algorithmRandom, algorithmTargetCell))
+	fs.IntVar(&op.Rows, "rows", op.Rows, "Number of rows on the board")
+	fs.IntVar(&op.Columns, "columns", op.Columns, "Number of columns on the board")
+	fs.IntVar(&op.TargetCellValue, "target", op.TargetCellValue, "Value of the cell the player must reach")
+	fs.IntVar(&op.MaxBricks, "max-bricks", op.MaxBricks, "Maximum number of non-empty cells on the board")
+	fs.IntVar(&op.MinBricks, "min-bricks", op.MinBricks, "Minimum number of non-empty cells on the board")
+	fs.IntVar(&op.MinMoves, "min-moves", op.MinMoves, "Minimum moves required to solve the game")
+	fs.IntVar(&op.MaxMoves, "max-moves", op.MaxMoves, "Maximum moves allowed to solve the game")
+	fs.IntVar(&op.Concurrency, "concurrency", op.Concurrency, "Number of concurrent solvers (random-algorithm only)")
+	fs.IntVar(&op.MaxIterations, "max-iterations", op.MaxIterations, "Maximum number of boards to attempt")
+	fs.IntVar(&op.MinGames, "min-games", op.MinGames, "Number of solvable games to generate")
+	fs.StringVar(&op.OutDir, "out", op.OutDir, "Directory to write the generated games to")
+	fs.DurationVar(&op.Timeout, "timeout", op.Timeout, "Stop generating after this duration. 0 means no timeout")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	configSet := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "config" {
+			configSet = true
+		}
+	})
+	if err := readGenerateConfig(*configPath, configSet, &op); err != nil {
+		return err
+	}
+	// Parse the flags again, so that they override the values from the config
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := op.validate(); err != nil {
+		return err
+	}
+	startPprof(*pprofAddress)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	if op.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, op.Timeout)
+		defer cancel()
+	}
+	var err error
+	switch op.Algorithm {
+	case algorithmTargetCell:
+		err = generateTargetCell(ctx, op)
+	default:
+		err = generateRandom(ctx, op)
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		log.Warn().Err(err).Msg("Stopped before all games were generated")
+		return nil
+	}
+	return err
+}
+
+func readGenerateConfig(p string, required bool, op *generateOptions) error {
+	f, err := os.ReadFile(p)
+	if err != nil {
+		if !required && errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := toml.Unmarshal(f, op); err != nil {
+		return fmt.Errorf("failed to parse config %s: %w", p, err)
+	}
+	return nil
+}
+
+func generateRandom(ctx context.Context, op generateOptions) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	gameCh := make(chan tallylogic.SolvableGame)
+	stop := make(chan struct{})
+	done := make(chan error)
+
+	// The generator blocks until the game is read from the channel,
+	// so the channel is drained even if writing fails.
+	go func() {
+		var firstErr error
+		for {
+			select {
+			case <-stop:
+				done <- firstErr
+				return
+			case sg := <-gameCh:
+				if firstErr != nil {
+					continue
+				}
+				if _, err := writeGeneratedGame(op.OutDir, sg); err != nil {
+					firstErr = err
+					cancel()
+				}
+			}
+		}
+	}()
+
+	gb, err := tallylogic.NewGameGenerator(tallylogic.GameGeneratorOptions{
+		GameSolutionChannel: gameCh,
+		Rows:                op.Rows,
+		Columns:             op.Columns,
+		GoalChecker: tallylogic.GoalCheckLargestCell{
+			TargetCellValue: uint64(op.TargetCellValue),
+		},
+		TargetCellValue: uint64(op.TargetCellValue),
+		MaxBricks:       op.MaxBricks,
+		MinBricks:       op.MinBricks,
+		MaxMoves:        op.MaxMoves,
+		MinMoves:        op.MinMoves,
+		MaxIterations:   op.MaxIterations,
+		MinGames:        op.MinGames,
+		Concurrency:     op.Concurrency,
+		Randomizer:      randomizer.NewSeededRandomizer(),
+	})
+	if err != nil {
+		close(stop)
+		<-done
+		return fmt.Errorf("failed to create generator: %w", err)
+	}
+	game, solutions, err := gb.GenerateGame(ctx)
+	close(stop)
+	if writeErr := <-done; writeErr != nil {
+		return writeErr
+	}
+	if err != nil {
+		return err
+	}
+	log.Info().Str("game", game.Print()).Int("solutions", len(solutions)).Msg("Generated a board with solutions")
+	return nil
+}
+
+// Returned from the event-handler, to stop the generator when enough games are generated
+var errEnoughGames = errors.New("enough games")
+
+func generateTargetCell(ctx context.Context, op generateOptions) error {
+	gen, err := gamegenerator_target_cell.NewGameGeneratorForTargetCell(gamegenerator_target_cell.GameGeneratorTargetCellOptions{
+		TargetCell: uint64(op.TargetCellValue),
+		MaxCells:   op.MaxBricks,
+		Rows:       op.Rows,
+		Columns:    op.Columns,
+		MaxMoves:   op.MaxMoves,
+		MinMoves:   op.MinMoves,
+		Randomizer: randomizer.NewSeededRandomizer(),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: failed to create generator: %v", errUsage, err)
+	}
+	err = gen.GenerateGames(ctx, func(event tallylogic.GeneratorEvent) error {
+		p := event.Progress
+		if event.Candidate == nil {
+			log.Info().
+				Int("iterations", p.Iterations).
+				Int("solverVisits", p.SolverVisits).
+				Int("candidates", p.Candidates).
+				Interface("rejections", p.Rejections).
+				Msg("progress")
+			if op.MaxIterations > 0 && p.Iterations >= op.MaxIterations {
+				return fmt.Errorf("no more than %d games found after %d iterations", p.Candidates, p.Iterations)
+			}
+			return nil
+		}
+		fp, err := writeGeneratedGame(op.OutDir, *event.Candidate)
+		if err != nil {
+			return err
+		}
+		log.Info().Str("path", fp).Str("game", event.Candidate.Print()).Msg("Generated a board with solutions")
+		if p.Candidates >= op.MinGames {
+			return errEnoughGames
+		}
+		return nil
+	})
+	if errors.Is(err, errEnoughGames) {
+		return nil
+	}
+	return err
+}
+
+// Writes the solvable game to a toml-file within the directory, and returns the path
+func writeGeneratedGame(dir string, sg tallylogic.SolvableGame) (string, error) {
+	hashName, err := utils.GetRandomName()
+	if err != nil {
+		return "", fmt.Errorf("failed to create name: %w", err)
+	}
+	out, err := tallylogic.NewGeneratedGame(sg, hashName)
+	if err != nil {
+		return "", err
+	}
+	buf := bytes.Buffer{}
+	if err := toml.NewEncoder(&buf).Encode(out); err != nil {
+		return "", fmt.Errorf("failed to encode game: %w", err)
+	}
+	o := sg.GeneratorOptions
+	dir = path.Join(
+		dir,
+		fmt.Sprintf("%dx%d-target-%d-moves-%d", o.Columns, o.Rows, o.TargetCellValue, out.Solutions[0].Moves),
+	)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	fp := path.Join(dir, hashName+"_"+out.Hash+".toml")
+	if err := os.WriteFile(fp, buf.Bytes(), 0644); err != nil {
+		return "", err
+	}
+	return fp, nil
+}
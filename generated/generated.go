@@ -34,27 +34,14 @@ func ReadGeneratedBoardsFromDisk(options ...Options) error {
 		if info.IsDir() {
 			return nil
 		}
-		b, err := fs.ReadFile(GenDir, p)
-		if err != nil {
-			return err
-		}
-		var gen tallylogic.GeneratedGame
-		err = toml.Unmarshal(b, &gen)
+		gen, err := ReadGeneratedGame(GenDir, p)
 		if err != nil {
 			return err
 		}
 		// if gen.GeneratorOptions.Rows != 3 {
 		// 	return nil
 		// }
-		var description string
-		if len(gen.Solutions) > 0 {
-			description = fmt.Sprintf("Get at least one cell to a value of %d. This game can be solved in %d moves, with the highest cell at %d", gen.GeneratorOptions.TargetCellValue, gen.Solutions[0].Moves, gen.Solutions[0].HighestCellValue)
-
-		}
-		template := tallylogic.NewGameTemplate(tallylogic.GameModeRandomChallenge, gen.Hash, gen.Name, description, gen.GeneratorOptions.Columns, gen.GeneratorOptions.Rows).
-			SetGoalCheckerLargestValue(gen.GeneratorOptions.TargetCellValue).
-			SetMaxMoves(gen.GeneratorOptions.MaxMoves).
-			SetStartingLayout(gen.Cells...)
+		template := TemplateFromGeneratedGame(gen)
 
 		GeneratedTemplates = append(GeneratedTemplates, *template)
 		if o.MaxItems > 0 && len(GeneratedTemplates) >= o.MaxItems {
@@ -68,3 +55,27 @@ func ReadGeneratedBoardsFromDisk(options ...Options) error {
 	return nil
 
 }
+
+// Creates a challenge-template for the generated game
+func TemplateFromGeneratedGame(gen tallylogic.GeneratedGame) *tallylogic.GameTemplate {
+	var description string
+	if len(gen.Solutions) > 0 {
+		description = fmt.Sprintf("Get at least one cell to a value of %d. This game can be solved in %d moves, with the highest cell at %d", gen.GeneratorOptions.TargetCellValue, gen.Solutions[0].Moves, gen.Solutions[0].HighestCellValue)
+
+	}
+	return tallylogic.NewGameTemplate(tallylogic.GameModeRandomChallenge, gen.Hash, gen.Name, description, gen.GeneratorOptions.Rows, gen.GeneratorOptions.Columns).
+		SetGoalCheckerLargestValue(gen.GeneratorOptions.TargetCellValue).
+		SetMaxMoves(gen.GeneratorOptions.MaxMoves).
+		SetStartingLayout(gen.Cells...)
+}
+
+// Reads a single generated game from a file
+func ReadGeneratedGame(fsys fs.FS, p string) (tallylogic.GeneratedGame, error) {
+	var gen tallylogic.GeneratedGame
+	b, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return gen, err
+	}
+	err = toml.Unmarshal(b, &gen)
+	return gen, err
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/runar-rkmedia/go-common/logger"
+	"github.com/runar-rkmedia/gotally/generated"
+	"github.com/runar-rkmedia/gotally/storage"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func runImport(args []string) error {
+	flags := newFlagSet("import", "[flags] <generated-game.toml | directory>...\n\nDirectories are searched recursively for toml-files.")
+	dsn := flags.String("dsn", "sqlite:./data/db.sqlite", "The database connection-string (DSN) to import into")
+	createdBy := flags.String("created-by", "generator", "User-ID to set as the creator of the game-templates")
+	challengeStart := flags.Int("challenge-number", 0, "If set, the templates are given challenge-numbers, starting at this number")
+	dryRun := flags.Bool("dry-run", false, "Validate the games without writing to the database")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return fmt.Errorf("%w: at least one file or directory must be given", errUsage)
+	}
+	files, err := findGeneratedGameFiles(flags.Args())
+	if err != nil {
+		return err
+	}
+	payloads := make([]types.CreateGameTemplatePayload, len(files))
+	for i, p := range files {
+		gen, err := readGeneratedGame(p)
+		if err != nil {
+			return fmt.Errorf("failed to read generated game %s: %w", p, err)
+		}
+		payloads[i], err = templatePayloadFromGeneratedGame(gen, *createdBy)
+		if err != nil {
+			return fmt.Errorf("invalid generated game %s: %w", p, err)
+		}
+		if *challengeStart > 0 {
+			n := *challengeStart + i
+			payloads[i].ChallengeNumber = &n
+		}
+	}
+	if *dryRun {
+		log.Info().Int("count", len(payloads)).Msg("All games are valid, nothing was imported (dry-run)")
+		return nil
+	}
+	db, err := storage.NewStorage(logger.GetLogger("database"), *dsn)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	ctx := context.Background()
+	for i, payload := range payloads {
+		template, err := db.CreateGameTemplate(ctx, payload)
+		if err != nil {
+			return fmt.Errorf("failed to import %s (imported %d of %d): %w", files[i], i, len(payloads), err)
+		}
+		log.Info().Str("id", template.ID).Str("name", template.Name).Str("path", files[i]).Msg("Imported game")
+	}
+	log.Info().Int("count", len(payloads)).Msg("Imported games")
+	return nil
+}
+
+// Returns the paths to the files, and all toml-files within the directories
+func findGeneratedGameFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		err := filepath.WalkDir(p, func(fp string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			// Explicitly given files are always included
+			if fp == p || strings.HasSuffix(fp, ".toml") {
+				files = append(files, fp)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find generated games in %s: %w", p, err)
+		}
+	}
+	return files, nil
+}
+
+func templatePayloadFromGeneratedGame(gen tallylogic.GeneratedGame, createdBy string) (types.CreateGameTemplatePayload, error) {
+	o := gen.GeneratorOptions
+	template := generated.TemplateFromGeneratedGame(gen)
+	payload := types.CreateGameTemplatePayload{
+		ID:          gonanoid.Must(),
+		CreatedAt:   time.Now(),
+		CreatedByID: createdBy,
+		Description: template.Description,
+		Name:        gen.Name,
+		Cells:       make([]cell.Cell, len(gen.Cells)),
+		Rules: types.Rules{
+			CreatedAt:       time.Now(),
+			Mode:            types.RuleModeChallenge,
+			TargetCellValue: o.TargetCellValue,
+			MaxMoves:        uint64(o.MaxMoves),
+			Rows:            uint8(o.Rows),
+			Columns:         uint8(o.Columns),
+		},
+	}
+	if len(gen.Solutions) > 0 {
+		payload.IdealMoves = gen.Solutions[0].Moves
+		payload.IdealScore = int(gen.Solutions[0].Score)
+	}
+	for i, v := range gen.Cells {
+		payload.Cells[i] = cell.NewCell(v, 0)
+	}
+	return payload, payload.Validate()
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/runar-rkmedia/gotally/generated"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+)
+
+func runInspect(args []string) error {
+	fs := newFlagSet("inspect", "[flags] <generated-game.toml>...")
+	visual := fs.Bool("visual", false, "Print every step of each solution")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("%w: at least one file must be given", errUsage)
+	}
+	for i, p := range fs.Args() {
+		gen, err := readGeneratedGame(p)
+		if err != nil {
+			return fmt.Errorf("failed to read generated game %s: %w", p, err)
+		}
+		if i > 0 {
+			fmt.Println()
+		}
+		o := gen.GeneratorOptions
+		fmt.Printf("%s (%s)\n", gen.Name, p)
+		fmt.Printf("Hash:    %s\n", gen.Hash)
+		fmt.Printf("Board:   %dx%d, target %d, moves %d-%d\n", o.Columns, o.Rows, o.TargetCellValue, o.MinMoves, o.MaxMoves)
+		fmt.Println(gen.Preview)
+		fmt.Printf("Cells:   %d non-empty of %d\n", gen.Stats.WithValueCount, gen.Stats.CellCount)
+		fmt.Printf("Hints:   %d (%d unique)\n", len(gen.Stats.Hints), gen.Stats.UniqueHints)
+		fmt.Printf("Factors: %d unique, %d duplicate\n", len(gen.Stats.UniqueFactors), gen.Stats.DuplicateFactors)
+		s := gen.SolutionStats
+		fmt.Printf("Ideal:   %d moves for %d points (solution %d)\n", s.IdealMoves, s.ScoreOnIdeal, s.IdealMovesSolutionIndex)
+		fmt.Printf("Best:    %d points (solution %d)\n", s.MaxScore, s.MaxScoreSolutionIndex)
+		fmt.Printf("Solutions (%d):\n", len(gen.Solutions))
+		printSolutions(gen.Solutions, *visual)
+	}
+	return nil
+}
+
+// Reads a generated game from the path, which may be absolute
+func readGeneratedGame(p string) (tallylogic.GeneratedGame, error) {
+	return generated.ReadGeneratedGame(os.DirFS(filepath.Dir(p)), filepath.Base(p))
+}
@@ -1,173 +1,123 @@
 package main
 
 import (
-	"bytes"
-	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
-	"path"
 
-	"github.com/pelletier/go-toml/v2"
 	"github.com/runar-rkmedia/go-common/logger"
-	"github.com/runar-rkmedia/gotally/randomizer"
-	"github.com/runar-rkmedia/gotally/tallylogic"
-	"github.com/runar-rkmedia/skiver/utils"
 )
 
 var log logger.AppLogger
 
-// used to generate games and save them to disk.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	// Same exit-code as the flag-package uses for invalid arguments
+	exitUsage = 2
+)
+
+// Returned by commands when the arguments are invalid
+var errUsage = errors.New("invalid usage")
+
+type command struct {
+	name, description string
+	run               func(args []string) error
+}
+
+var commands = []command{
+	{"generate", "Generate solvable games, and save them to disk", runGenerate},
+	{"solve", "Read a board, and print its solutions", runSolve},
+	{"inspect", "Pretty-print a generated game", runInspect},
+	{"import", "Import generated games into the database as game-templates", runImport},
+}
+
+// Tool for generating games offline, and working with the generated games.
 func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	logLevel := "info"
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		logLevel = v
+	}
 	logger.InitLogger(logger.LogConfig{
-		Level:      "debug",
+		Level:      logLevel,
 		Format:     "human",
 		WithCaller: true,
 	})
 	log = logger.GetLogger("main")
 
-	go func() {
-		address := "localhost:6060"
-		log.Info().Str("address", address).Msg("pprof available")
-		log.Fatal().
-			Str("address", address).
-			Err(http.ListenAndServe(address, nil)).
-			Msg(("failed setting up listener"))
-	}()
-	generateGame()
-}
-
-func generateGame() {
-	type options struct {
-		Rows, Columns, TargetCellValue, MaxBricks, MinBricks, MinMoves, MaxMoves, Concurrency, MaxIterations, MinGames int
-	}
-	f, err := os.ReadFile("./generator-config.toml")
-	if err != nil {
-		panic(err)
+	if len(args) == 0 {
+		printUsage()
+		return exitUsage
 	}
-	var op options
-	err = toml.Unmarshal(f, &op)
-	if err != nil {
-		panic(err)
+	name := args[0]
+	if name == "help" || name == "-h" || name == "--help" {
+		printUsage()
+		return exitOK
 	}
-
-	gameCh := make(chan tallylogic.SolvableGame, 8)
-	quit := make(chan struct{})
-
-	go func() {
-		log.Info().Msg("listening for games")
-		for {
-			select {
-			case <-quit:
-				log.Info().Msg("quitting")
-				return
-			case sg := <-gameCh:
-				cells := sg.Cells()
-				hashName, _ := utils.GetRandomName()
-				out := tallylogic.GeneratedGame{
-					GeneratorOptions: sg.GeneratorOptions,
-					Solutions:        make([]tallylogic.GeneratedSolution, len(sg.Solutions)),
-					Name:             hashName,
-					Preview:          sg.Print(),
-					Hash:             sg.Hash(),
-					Cells:            make([]int64, len(cells)),
-				}
-				stats, err := sg.Stats()
-				if err != nil {
-					panic("Failed to generate gamestats: " + err.Error())
-				}
-				out.Stats = stats
-				solutionStats, err := tallylogic.NewSolutionsStats(sg.Game, sg.Solutions)
-				if err != nil {
-					panic("Failed to generate solutionStats: " + err.Error())
-				}
-				out.SolutionStats = solutionStats
-				for i, c := range cells {
-					out.Cells[i] = c.Value()
-				}
-				for i, s := range sg.Solutions {
-					out.Solutions[i] = tallylogic.GeneratedSolution{
-						History:          s.History,
-						HighestCellValue: s.HighestCellValue(),
-						Score:            s.Score(),
-						Moves:            s.Moves(),
-					}
-					gameCopy := sg.Copy()
-					history, err := s.History.All()
-					if err != nil {
-						panic(fmt.Sprintf("failed to iterate of history: %v", err))
-					}
-					for _, ins := range history {
-						gameCopy.Instruct(ins)
-						switch {
-						case ins.IsPath:
-							s := "\n" + gameCopy.DescribePath(ins.Path)
-							s += gameCopy.PrintForSelectionNoColor(ins.Path) + "\n"
-							out.Solutions[i].VisualSolution += s
-						case ins.IsSwipe:
-							out.Solutions[i].VisualSolution += "\n" + string(ins.Direction) + gameCopy.Print() + "\n"
-						default:
-							panic(fmt.Sprintf("NotImplemented: %#v", ins))
-						}
-					}
-					out.Solutions[i].VisualSolution += "\nEnd: \n" + gameCopy.Print()
-				}
-				buf := bytes.Buffer{}
-				e := toml.NewEncoder(&buf)
-
-				err = e.Encode(out)
-				if err != nil {
-					panic(err)
-				}
-				dir := path.Join(
-					"./",
-					"generated",
-					"games",
-					fmt.Sprintf("%dx%d-target-%d-moves-%d", op.Columns, op.Rows, op.TargetCellValue, sg.Solutions[0].Moves()),
-				)
-				err = os.MkdirAll(dir, 0755)
-				if err != nil {
-					panic(err)
-				}
-				fp := path.Join(dir, hashName+"_"+sg.Game.Hash()+".toml")
-				err = os.WriteFile(fp, buf.Bytes(), 0755)
-				if err != nil {
-					panic(err)
-				}
-
-			}
-
+	for _, c := range commands {
+		if c.name != name {
+			continue
 		}
-	}()
+		err := c.run(args[1:])
+		switch {
+		case err == nil, errors.Is(err, flag.ErrHelp):
+			return exitOK
+		case errors.Is(err, errUsage):
+			fmt.Fprintf(os.Stderr, "%s: %v\n", c.name, err)
+			return exitUsage
+		}
+		log.Error().Err(err).Str("command", c.name).Msg("command failed")
+		return exitFailure
+	}
+	fmt.Fprintf(os.Stderr, "unknown command: %s\n\n", name)
+	printUsage()
+	return exitUsage
+}
 
-	gb, err := tallylogic.NewGameGenerator(tallylogic.GameGeneratorOptions{
-		GameSolutionChannel: gameCh,
-		Rows:                op.Rows,
-		Columns:             op.Columns,
-		GoalChecker: tallylogic.GoalCheckLargestCell{
-			TargetCellValue: uint64(op.TargetCellValue),
-		},
-		TargetCellValue: uint64(op.TargetCellValue),
-		MaxBricks:       op.MaxBricks,
-		MinBricks:       op.MinBricks,
-		MaxMoves:        op.MaxMoves,
-		MinMoves:        op.MinMoves,
-		MaxIterations:   op.MaxIterations,
-		MinGames:        op.MinGames,
-		Concurrency:     op.Concurrency,
-		// CellGenerator: nil,
-		Randomizer: randomizer.NewSeededRandomizer(),
-	})
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [flags]\n\nCommands:\n", os.Args[0])
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", c.name, c.description)
+	}
+	fmt.Fprintf(os.Stderr, "\nUse '%s <command> -h' for the flags of a command\n", os.Args[0])
+}
 
-	if err != nil {
-		panic("gb: " + err.Error())
+// Creates a flag-set for the command, which returns errors instead of exiting.
+func newFlagSet(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s %s\n\n", name, usage)
+		fs.PrintDefaults()
 	}
-	game, solutions, err := gb.GenerateGame(context.TODO())
-	quit <- struct{}{}
-	if err != nil {
-		panic(err.Error())
+	return fs
+}
+
+// Parses the flags, and wraps any error as a usage-error
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	err := fs.Parse(args)
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return err
 	}
-	log.Info().Str("game", game.Print()).Int("solutions", len(solutions)).Msg("Generated a board with solutions")
+	return fmt.Errorf("%w: %v", errUsage, err)
+}
 
+// Starts pprof on the address, unless it is empty
+func startPprof(address string) {
+	if address == "" {
+		return
+	}
+	go func() {
+		log.Info().Str("address", address).Msg("pprof available")
+		log.Error().
+			Str("address", address).
+			Err(http.ListenAndServe(address, nil)).
+			Msg(("failed setting up listener"))
+	}()
 }
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+)
+
+func TestRun_ExitCodes(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config.toml")
+	err := os.WriteFile(config, []byte("Algorithm = \"target-cell\"\nRows = 4\nColumns = 4\nTargetCellValue = 96\nMaxMoves = 20\n"), 0644)
+	testza.AssertNoError(t, err)
+	out := filepath.Join(dir, "games")
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"Should fail without a command", []string{}, exitUsage},
+		{"Should fail on unknown command", []string{"unknown"}, exitUsage},
+		{"Should print help", []string{"help"}, exitOK},
+		{"Should fail on unknown flag", []string{"solve", "-unknown"}, exitUsage},
+		{"Should fail on invalid algorithm", []string{"generate", "-config", config, "-algorithm", "nope"}, exitUsage},
+		{"Should fail on missing config", []string{"generate", "-config", filepath.Join(dir, "missing.toml")}, exitFailure},
+		{"Should fail on invalid cells", []string{"solve", "-cells", "1,2", "-target", "4"}, exitUsage},
+		{"Should solve cells", []string{"solve", "-cells", "2,2,0,0,0,0,0,0,0", "-target", "4", "-max-moves", "2"}, exitOK},
+		{"Should solve cells in parallel", []string{"solve", "-parallel", "-workers", "2", "-cells", "2,2,0,0,0,0,0,0,0", "-target", "4", "-max-moves", "2"}, exitOK},
+		{"Should generate from config, with overrides", []string{"generate", "-config", config, "-min-games", "2", "-out", out}, exitOK},
+		{"Should fail to inspect without files", []string{"inspect"}, exitUsage},
+		{"Should fail to inspect a directory", []string{"inspect", out}, exitFailure},
+		{"Should validate generated games for import", []string{"import", "-dry-run", out}, exitOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testza.AssertEqual(t, tt.want, run(tt.args))
+		})
+	}
+	files, err := findGeneratedGameFiles([]string{out})
+	testza.AssertNoError(t, err)
+	testza.AssertLen(t, files, 2)
+	testza.AssertEqual(t, exitOK, run(append([]string{"inspect"}, files...)))
+	testza.AssertEqual(t, exitOK, run([]string{"solve", files[0]}))
+}
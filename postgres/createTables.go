@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+)
+
+var (
+	//go:embed schema.sql
+	schema string
+)
+
+// Columns added to tables after they were first created.
+// The schema only creates missing tables, so existing databases get these columns from migrateColumns.
+var addedColumns = []struct {
+	table, column, definition string
+}{
+	{"game_template", "daily_date", "varchar(10)"},
+	{"rule", "max_swaps", "bigint not null default 0"},
+	{"rule", "swap_neighbours_only", "BOOLEAN not null default false"},
+	{"rule", "max_powers", "bigint not null default 0"},
+	{"rule", "with_subtraction", "BOOLEAN not null default false"},
+	{"rule", "with_division", "BOOLEAN not null default false"},
+	{"rule", "with_mixed_operators", "BOOLEAN not null default false"},
+	{"rule", "topology", "bigint not null default 0"},
+	{"session", "replaced_by", "varchar(21)"},
+}
+
+func (q *Queries) InitializeDatabase(ctx context.Context) (sql.Result, error) {
+	// The schema creates indexes on the added columns, so these must exist first
+	if err := q.migrateColumns(ctx); err != nil {
+		return nil, err
+	}
+	return q.db.ExecContext(ctx, schema)
+
+}
+
+// Adds the columns in addedColumns to existing tables that do not have them.
+// Tables that do not exist yet are left for the schema to create.
+func (q *Queries) migrateColumns(ctx context.Context) error {
+	for _, c := range addedColumns {
+		_, err := q.db.ExecContext(ctx, fmt.Sprintf("alter table if exists %s add column if not exists %s %s", c.table, c.column, c.definition))
+		if err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %w", c.table, c.column, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,460 @@
+-- name: InsertUser :one
+INSERT INTO users
+    (id, created_at, updated_at, username, active_game_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: InsertSession :one
+INSERT INTO session
+    (id, created_at, updated_at, invalid_after, user_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: InsertLinkCode :one
+INSERT INTO link_code
+    (code, created_at, invalid_after, user_id)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+-- name: InsertGame :one
+INSERT INTO game
+(id, created_at, updated_at, name, description, user_id, rule_id, score, moves, play_state, data, data_at_start, history, template_id, based_on_game)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+RETURNING *;
+-- name: UpsertGameCheckpoint :one
+-- Replaces any existing checkpoint at the same depth
+INSERT INTO game_checkpoint
+    (game_id, depth, created_at, score, data)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (game_id, depth) DO UPDATE
+    SET created_at = excluded.created_at,
+        score = excluded.score,
+        data = excluded.data
+RETURNING *;
+-- name: InsertRule :one
+INSERT INTO rule
+(id, slug, created_at, updated_at, description, mode, size_x, size_y, recreate_on_swipe, no_reswipe, no_multiply, no_addition, no_super_powers, max_moves, target_cell_value, target_score, max_swaps, swap_neighbours_only, max_powers, with_subtraction, with_division, with_mixed_operators, topology)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
+RETURNING *;
+-- name: InserTemplate :one
+INSERT INTO game_template
+(id, created_at, rule_id, created_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+-- name: InsertVote :one
+INSERT INTO vote
+(id, created_at, user_id, game_id, template_id, fun_vote)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetGameTemplate :one
+select * from game_template
+where id = $1;
+-- name: GetGameTemplateByChallengeNumber :one
+select * from game_template
+where challenge_number = $1;
+-- name: GetGameChallengesTemplates :many
+select * from game_template
+where challenge_number is not null
+order by challenge_number;
+-- name: GetGameTemplateByDailyDate :one
+select * from game_template
+where daily_date = $1;
+-- name: GetDailyTemplates :many
+-- The templates of the daily challenges, the most recent day first
+select * from game_template
+where daily_date is not null
+order by daily_date desc;
+
+-- name: GetChallengeStatsForUser :many
+SELECT
+	g.id as game_id
+	, g.template_id
+	, g.score
+	, g.moves
+	, u.id as user_id
+	, u.username
+FROM
+	game AS g
+	JOIN users AS u ON u.id = g.user_id
+WHERE
+	template_id IS NOT NULL
+  AND user_id = $1
+	;
+-- name: GetChallengeLeaderboard :many
+-- The best won game (play_state 1) of each user for the template, best first:
+-- fewest moves, then highest score, then whoever won first. Users with equal
+-- moves, score and time share the same rank. With first_attempt_only, only the
+-- first game of each user is considered, as for the daily challenges.
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = sqlc.arg(template_id)
+	)
+	, best AS (
+		SELECT
+			a.id
+			, a.user_id
+			, a.score
+			, a.moves
+			, a.created_at
+			, a.updated_at
+			, ROW_NUMBER() OVER (
+				PARTITION BY a.user_id
+				ORDER BY a.moves, a.score DESC, COALESCE(a.updated_at, a.created_at)
+			) AS best_attempt
+		FROM
+			attempts AS a
+		WHERE
+			a.play_state = 1
+			AND (NOT sqlc.arg(first_attempt_only)::boolean OR a.attempt = 1)
+	)
+	, ranked AS (
+		SELECT
+			b.id
+			, b.user_id
+			, b.score
+			, b.moves
+			, b.created_at
+			, b.updated_at
+			, RANK() OVER (
+				ORDER BY b.moves, b.score DESC, COALESCE(b.updated_at, b.created_at)
+			) AS rank
+		FROM
+			best AS b
+		WHERE
+			b.best_attempt = 1
+	)
+SELECT
+	r.id as game_id
+	, r.score
+	, r.moves
+	, r.created_at
+	, r.updated_at
+	, u.id as user_id
+	, u.username
+	, r.rank
+FROM
+	ranked AS r
+	JOIN users AS u ON u.id = r.user_id
+ORDER BY
+	r.rank
+	, r.user_id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset)
+	;
+-- name: GetChallengeLeaderboardForUser :one
+-- The entry of a single user in the GetChallengeLeaderboard-query
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = sqlc.arg(template_id)
+	)
+	, best AS (
+		SELECT
+			a.id
+			, a.user_id
+			, a.score
+			, a.moves
+			, a.created_at
+			, a.updated_at
+			, ROW_NUMBER() OVER (
+				PARTITION BY a.user_id
+				ORDER BY a.moves, a.score DESC, COALESCE(a.updated_at, a.created_at)
+			) AS best_attempt
+		FROM
+			attempts AS a
+		WHERE
+			a.play_state = 1
+			AND (NOT sqlc.arg(first_attempt_only)::boolean OR a.attempt = 1)
+	)
+	, ranked AS (
+		SELECT
+			b.id
+			, b.user_id
+			, b.score
+			, b.moves
+			, b.created_at
+			, b.updated_at
+			, RANK() OVER (
+				ORDER BY b.moves, b.score DESC, COALESCE(b.updated_at, b.created_at)
+			) AS rank
+		FROM
+			best AS b
+		WHERE
+			b.best_attempt = 1
+	)
+SELECT
+	r.id as game_id
+	, r.score
+	, r.moves
+	, r.created_at
+	, r.updated_at
+	, u.id as user_id
+	, u.username
+	, r.rank
+FROM
+	ranked AS r
+	JOIN users AS u ON u.id = r.user_id
+WHERE
+	r.user_id = sqlc.arg(user_id)
+	;
+-- name: CountChallengeLeaderboard :one
+-- Number of users on the GetChallengeLeaderboard-query
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = sqlc.arg(template_id)
+	)
+SELECT
+	COUNT(DISTINCT a.user_id)
+FROM
+	attempts AS a
+WHERE
+	a.play_state = 1
+	AND (NOT sqlc.arg(first_attempt_only)::boolean OR a.attempt = 1)
+	;
+-- name: GetAttemptsForTemplate :many
+-- All games for the template, in the order they were started
+SELECT
+	g.id as game_id
+	, g.score
+	, g.moves
+	, g.play_state
+	, g.created_at
+	, g.updated_at
+	, u.id as user_id
+	, u.username
+FROM
+	game AS g
+	JOIN users AS u ON u.id = g.user_id
+WHERE
+	g.template_id = $1
+ORDER BY
+	g.created_at
+	, g.id
+	;
+-- name: GetVoteForTemplateByUser :one
+select * from vote
+where user_id = $1 and template_id = $2;
+-- name: GetVoteForGameByUser :one
+select * from vote
+where user_id = $1 and game_id = $2;
+-- name: GetVoteStatsForTemplates :many
+SELECT
+	template_id
+	, COUNT(*) AS votes
+	, CAST(COALESCE(SUM(fun_vote), 0) AS BIGINT) AS fun_vote_total
+FROM
+	vote
+WHERE
+	template_id IS NOT NULL
+GROUP BY
+	template_id
+	;
+-- name: GetRule :one
+select * from rule
+where id = $1 or slug = $2;
+-- name: GetGame :one
+select * from game
+where id = $1;
+-- name: GetGamesForUser :many
+-- The users games, the most recently created first
+select * from game
+where user_id = $1
+order by created_at desc;
+-- name: GetOriginalGame :one
+SELECT o.*
+  FROM game AS g
+    JOIN game o ON o.id = g.based_on_game
+ WHERE g.id = $1;
+-- name: GetGameCheckpoint :one
+-- The latest checkpoint at or before the depth
+SELECT * FROM game_checkpoint
+WHERE game_id = $1 AND depth <= $2
+ORDER BY depth DESC
+LIMIT 1;
+-- name: GetUser :one
+select * from users
+where id = $1;
+-- name: GetRegisteredUserByUsername :one
+select * from users
+where username = $1 and password_hash IS NOT NULL;
+-- name: GetUserBySessionID :one
+SELECT
+       session.id session_id,
+       session.created_at session_created_at,
+       session.invalid_after session_invalid_after,
+
+       users.id user_id,
+       users.created_at user_created_at,
+       users.updated_at user_updated_at,
+       users.username,
+       users.password_hash,
+
+       game.id game_id,
+       game.created_at game_created_at,
+       game.updated_at game_updated_at,
+       game.description game_description,
+       game.name game_Name,
+       game.data game_data,
+       game.history game_history,
+       game.play_state game_play_state,
+       game.score game_score,
+       game.moves game_moves,
+       game.rule_id rule_id
+
+FROM session session
+         INNER JOIN users users on users.id = session.user_id
+         INNER JOIN game game on users.active_game_id = game.id
+WHERE session.id = $1 LIMIT 1;
+
+-- name: GetAllGames :many
+SELECT * from game;
+-- name: GetAllRules :many
+SELECT * from rule;
+-- name: GetAllSessions :many
+SELECT * from session;
+-- name: GetAllUsers :many
+SELECT * from users;
+-- name: GetAllTemplates :many
+SELECT * from game_template;
+-- name: GetAllVotes :many
+SELECT * from vote;
+-- name: UpdateGame :one
+UPDATE game
+SET updated_at = $1,
+    user_id    = $2,
+    rule_id    = $3,
+    score      = $4,
+    moves      = $5,
+    play_state = $6,
+    data       = $7,
+    history    = $8
+WHERE id = $9
+RETURNING *;
+-- name: SetPlayStateForGame :one
+UPDATE game
+SET updated_at = $1,
+    play_state = $2
+WHERE id = $3
+RETURNING *;
+-- name: UpdateUser :one
+UPDATE users
+SET updated_at = $1,
+    username = $2,
+    active_game_id = $3
+WHERE id = $4
+RETURNING *;
+-- name: RegisterUser :one
+UPDATE users
+SET updated_at = $1,
+    username = $2,
+    password_hash = $3
+WHERE id = $4 AND password_hash IS NULL
+RETURNING *;
+-- name: UseLinkCode :one
+-- Marks the code as used, if it is still valid
+UPDATE link_code
+SET used_at = $1
+WHERE code = $2 AND used_at IS NULL AND invalid_after > $3
+RETURNING *;
+-- name: RefreshSession :one
+UPDATE session
+SET updated_at = $1,
+    invalid_after = $2
+WHERE id = $3
+RETURNING *;
+-- name: GetSession :one
+SELECT * from session
+WHERE id = $1;
+-- name: ReplaceSession :one
+-- Marks the session as replaced by another, unless it already is
+UPDATE session
+SET updated_at = $1,
+    replaced_by = $2
+WHERE id = $3 AND replaced_by IS NULL
+RETURNING *;
+-- name: DeleteExpiredSessions :execrows
+DELETE FROM session
+WHERE invalid_after < $1;
+-- name: DeleteExpiredLinkCodes :execrows
+DELETE FROM link_code
+WHERE invalid_after < $1;
+-- name: DeleteGameCheckpointsAfter :execrows
+DELETE FROM game_checkpoint
+WHERE game_id = $1 AND depth > $2;
+-- name: DeleteGamesForOrphanedUsers :execrows
+-- Orphaned users are guests without any sessions, that never made a move.
+-- Their games are removed first, since every user has an active game.
+DELETE FROM game
+WHERE user_id IN (
+    SELECT u.id FROM users u
+    WHERE u.password_hash IS NULL
+      AND NOT EXISTS (SELECT 1 FROM session s WHERE s.user_id = u.id)
+      AND NOT EXISTS (SELECT 1 FROM game g WHERE g.user_id = u.id AND g.moves > 0)
+      AND NOT EXISTS (SELECT 1 FROM game_template t WHERE t.created_by = u.id OR t.updated_by = u.id)
+      AND NOT EXISTS (SELECT 1 FROM vote v WHERE v.user_id = u.id)
+      AND NOT EXISTS (SELECT 1 FROM link_code l WHERE l.user_id = u.id)
+);
+-- name: DeleteOrphanedUsers :execrows
+DELETE FROM users
+WHERE password_hash IS NULL
+  AND NOT EXISTS (SELECT 1 FROM session s WHERE s.user_id = users.id)
+  AND NOT EXISTS (SELECT 1 FROM game g WHERE g.user_id = users.id);
+-- name: UpdateVote :one
+UPDATE vote
+SET updated_at = $1,
+    fun_vote = $2
+WHERE id = $3
+RETURNING *;
+-- name: SetActiveGameFormUser :one
+UPDATE users
+SET updated_at = $1,
+    active_game_id = $2
+WHERE id = $3
+RETURNING *;
+-- name: Stats :one
+SELECT (SELECT COUNT(*) FROM users) AS users
+     , (SELECT COUNT(*) FROM session where session.invalid_after > $1) AS session
+     , (SELECT COUNT(*) FROM game) AS games
+     , (SELECT COUNT(*) FROM game where game.play_state = 1) AS games_won
+     , (SELECT COUNT(*) FROM game where game.play_state = 2) AS games_lost
+     , (SELECT COUNT(*) FROM game where game.play_state = 3) AS games_abandoned
+     , (SELECT COUNT(*) FROM game where game.play_state = 4) AS games_current
+     , (SELECT CAST(COALESCE(max(game.moves), 0) AS BIGINT) FROM game where game.play_state = 4) AS longest_game
+     , (SELECT CAST(COALESCE(max(game.score), 0) AS BIGINT) FROM game where game.play_state = 4) AS highest_score
+     , (SELECT CAST(COALESCE(AVG(length(history)*length(history)) - AVG(length(history))*AVG(length(history)), 0) as FLOAT) from game) as history_variance
+     , (SELECT CAST(avg(length(history)) AS FLOAT) from game) as history_avg
+     , (SELECT CAST(COALESCE(max(length(history)), 0) AS BIGINT) from game) as history_max
+     , (SELECT CAST(COALESCE(min(length(history)), 0) AS BIGINT) from game) as history_min
+     , (SELECT CAST(COALESCE(sum(length(history)), 0) AS BIGINT) from game) as history_total;
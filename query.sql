@@ -8,20 +8,40 @@ INSERT INTO session
     (id, created_at, updated_at, invalid_after, user_id)
 VALUES (?, ?, ?, ?, ?)
 RETURNING *;
+-- name: InsertLinkCode :one
+INSERT INTO link_code
+    (code, created_at, invalid_after, user_id)
+VALUES (?, ?, ?, ?)
+RETURNING *;
 -- name: InsertGame :one
 INSERT INTO game
 (id, created_at, updated_at, name, description, user_id, rule_id, score, moves, play_state, data, data_at_start, history, template_id, based_on_game)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
+-- name: UpsertGameCheckpoint :one
+-- Replaces any existing checkpoint at the same depth
+INSERT INTO game_checkpoint
+    (game_id, depth, created_at, score, data)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (game_id, depth) DO UPDATE
+    SET created_at = excluded.created_at,
+        score = excluded.score,
+        data = excluded.data
+RETURNING *;
 -- name: InsertRule :one
 INSERT INTO rule
-(id, slug, created_at, updated_at, description, mode, size_x, size_y, recreate_on_swipe, no_reswipe, no_multiply, no_addition, max_moves, target_cell_value, target_score)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+(id, slug, created_at, updated_at, description, mode, size_x, size_y, recreate_on_swipe, no_reswipe, no_multiply, no_addition, no_super_powers, max_moves, target_cell_value, target_score, max_swaps, swap_neighbours_only, max_powers, with_subtraction, with_division, with_mixed_operators, topology)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 -- name: InserTemplate :one
 INSERT INTO game_template
-(id, created_at, rule_id, created_by, name, description, challenge_number, ideal_moves, ideal_score, data)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+(id, created_at, rule_id, created_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+-- name: InsertVote :one
+INSERT INTO vote
+(id, created_at, user_id, game_id, template_id, fun_vote)
+VALUES (?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetGameTemplate :one
@@ -34,6 +54,14 @@ where challenge_number = ?;
 select * from game_template
 where challenge_number is not null
 order by challenge_number;
+-- name: GetGameTemplateByDailyDate :one
+select * from game_template
+where daily_date = ?;
+-- name: GetDailyTemplates :many
+-- The templates of the daily challenges, the most recent day first
+select * from game_template
+where daily_date is not null
+order by daily_date desc;
 
 -- name: GetChallengeStatsForUser :many
 SELECT
@@ -50,20 +78,237 @@ WHERE
 	template_id IS NOT NULL
   AND user_id = ?
 	;
+-- name: GetChallengeLeaderboard :many
+-- The best won game (play_state 1) of each user for the template, best first:
+-- fewest moves, then highest score, then whoever won first. Users with equal
+-- moves, score and time share the same rank. With first_attempt_only, only the
+-- first game of each user is considered, as for the daily challenges.
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = sqlc.arg(template_id)
+	)
+	, best AS (
+		SELECT
+			a.id
+			, a.user_id
+			, a.score
+			, a.moves
+			, a.created_at
+			, a.updated_at
+			, ROW_NUMBER() OVER (
+				PARTITION BY a.user_id
+				ORDER BY a.moves, a.score DESC, COALESCE(a.updated_at, a.created_at)
+			) AS best_attempt
+		FROM
+			attempts AS a
+		WHERE
+			a.play_state = 1
+			AND (NOT sqlc.arg(first_attempt_only) OR a.attempt = 1)
+	)
+	, ranked AS (
+		SELECT
+			b.id
+			, b.user_id
+			, b.score
+			, b.moves
+			, b.created_at
+			, b.updated_at
+			, RANK() OVER (
+				ORDER BY b.moves, b.score DESC, COALESCE(b.updated_at, b.created_at)
+			) AS rank
+		FROM
+			best AS b
+		WHERE
+			b.best_attempt = 1
+	)
+SELECT
+	r.id as game_id
+	, r.score
+	, r.moves
+	, r.created_at
+	, r.updated_at
+	, u.id as user_id
+	, u.username
+	, r.rank
+FROM
+	ranked AS r
+	JOIN user AS u ON u.id = r.user_id
+ORDER BY
+	r.rank
+	, r.user_id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset)
+	;
+-- name: GetChallengeLeaderboardForUser :one
+-- The entry of a single user in the GetChallengeLeaderboard-query
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = sqlc.arg(template_id)
+	)
+	, best AS (
+		SELECT
+			a.id
+			, a.user_id
+			, a.score
+			, a.moves
+			, a.created_at
+			, a.updated_at
+			, ROW_NUMBER() OVER (
+				PARTITION BY a.user_id
+				ORDER BY a.moves, a.score DESC, COALESCE(a.updated_at, a.created_at)
+			) AS best_attempt
+		FROM
+			attempts AS a
+		WHERE
+			a.play_state = 1
+			AND (NOT sqlc.arg(first_attempt_only) OR a.attempt = 1)
+	)
+	, ranked AS (
+		SELECT
+			b.id
+			, b.user_id
+			, b.score
+			, b.moves
+			, b.created_at
+			, b.updated_at
+			, RANK() OVER (
+				ORDER BY b.moves, b.score DESC, COALESCE(b.updated_at, b.created_at)
+			) AS rank
+		FROM
+			best AS b
+		WHERE
+			b.best_attempt = 1
+	)
+SELECT
+	r.id as game_id
+	, r.score
+	, r.moves
+	, r.created_at
+	, r.updated_at
+	, u.id as user_id
+	, u.username
+	, r.rank
+FROM
+	ranked AS r
+	JOIN user AS u ON u.id = r.user_id
+WHERE
+	r.user_id = sqlc.arg(user_id)
+	;
+-- name: CountChallengeLeaderboard :one
+-- Number of users on the GetChallengeLeaderboard-query
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = sqlc.arg(template_id)
+	)
+SELECT
+	COUNT(DISTINCT a.user_id)
+FROM
+	attempts AS a
+WHERE
+	a.play_state = 1
+	AND (NOT sqlc.arg(first_attempt_only) OR a.attempt = 1)
+	;
+-- name: GetAttemptsForTemplate :many
+-- All games for the template, in the order they were started
+SELECT
+	g.id as game_id
+	, g.score
+	, g.moves
+	, g.play_state
+	, g.created_at
+	, g.updated_at
+	, u.id as user_id
+	, u.username
+FROM
+	game AS g
+	JOIN user AS u ON u.id = g.user_id
+WHERE
+	g.template_id = ?
+ORDER BY
+	g.created_at
+	, g.id
+	;
+-- name: GetVoteForTemplateByUser :one
+select * from vote
+where user_id = ? and template_id = ?;
+-- name: GetVoteForGameByUser :one
+select * from vote
+where user_id = ? and game_id = ?;
+-- name: GetVoteStatsForTemplates :many
+SELECT
+	template_id
+	, COUNT(*) AS votes
+	, CAST(TOTAL(fun_vote) AS INT) AS fun_vote_total
+FROM
+	vote
+WHERE
+	template_id IS NOT NULL
+GROUP BY
+	template_id
+	;
 -- name: GetRule :one
 select * from rule
 where id == ? or slug == ?;
 -- name: GetGame :one
 select * from game
 where id == ?;
+-- name: GetGamesForUser :many
+-- The users games, the most recently created first
+select * from game
+where user_id == ?
+order by created_at desc;
 -- name: GetOriginalGame :one
 SELECT o.*
   FROM game AS g 
     JOIN game o ON o.id == g.based_on_game
- WHERE g.id = '?';
+ WHERE g.id = ?;
+-- name: GetGameCheckpoint :one
+-- The latest checkpoint at or before the depth
+SELECT * FROM game_checkpoint
+WHERE game_id == ? AND depth <= ?
+ORDER BY depth DESC
+LIMIT 1;
 -- name: GetUser :one
 select * from user
 where id == ?;
+-- name: GetRegisteredUserByUsername :one
+select * from user
+where username == ? and password_hash IS NOT NULL;
 -- name: GetUserBySessionID :one
 SELECT
        session.id session_id,
@@ -74,6 +319,7 @@ SELECT
        user.created_at user_created_at,
        user.updated_at user_updated_at,
        user.username,
+       user.password_hash,
 
        game.id game_id,
        game.created_at game_created_at,
@@ -102,6 +348,8 @@ SELECT * from session;
 SELECT * from user;
 -- name: GetAllTemplates :many
 SELECT * from game_template;
+-- name: GetAllVotes :many
+SELECT * from vote;
 -- name: UpdateGame :one
 UPDATE game
 SET updated_at = ?,
@@ -127,6 +375,68 @@ SET updated_at = ?,
     active_game_id = ?
 WHERE id = ?
 RETURNING *;
+-- name: RegisterUser :one
+UPDATE user
+SET updated_at = ?,
+    username = ?,
+    password_hash = ?
+WHERE id = ? AND password_hash IS NULL
+RETURNING *;
+-- name: UseLinkCode :one
+-- Marks the code as used, if it is still valid
+UPDATE link_code
+SET used_at = ?
+WHERE code = ? AND used_at IS NULL AND invalid_after > ?
+RETURNING *;
+-- name: RefreshSession :one
+UPDATE session
+SET updated_at = ?,
+    invalid_after = ?
+WHERE id = ?
+RETURNING *;
+-- name: GetSession :one
+SELECT * from session
+WHERE id = ?;
+-- name: ReplaceSession :one
+-- Marks the session as replaced by another, unless it already is
+UPDATE session
+SET updated_at = ?,
+    replaced_by = ?
+WHERE id = ? AND replaced_by IS NULL
+RETURNING *;
+-- name: DeleteExpiredSessions :execrows
+DELETE FROM session
+WHERE invalid_after < ?;
+-- name: DeleteExpiredLinkCodes :execrows
+DELETE FROM link_code
+WHERE invalid_after < ?;
+-- name: DeleteGameCheckpointsAfter :execrows
+DELETE FROM game_checkpoint
+WHERE game_id == ? AND depth > ?;
+-- name: DeleteGamesForOrphanedUsers :execrows
+-- Orphaned users are guests without any sessions, that never made a move.
+-- Their games are removed first, since every user has an active game.
+DELETE FROM game
+WHERE user_id IN (
+    SELECT u.id FROM user u
+    WHERE u.password_hash IS NULL
+      AND NOT EXISTS (SELECT 1 FROM session s WHERE s.user_id = u.id)
+      AND NOT EXISTS (SELECT 1 FROM game g WHERE g.user_id = u.id AND g.moves > 0)
+      AND NOT EXISTS (SELECT 1 FROM game_template t WHERE t.created_by = u.id OR t.updated_by = u.id)
+      AND NOT EXISTS (SELECT 1 FROM vote v WHERE v.user_id = u.id)
+      AND NOT EXISTS (SELECT 1 FROM link_code l WHERE l.user_id = u.id)
+);
+-- name: DeleteOrphanedUsers :execrows
+DELETE FROM user
+WHERE password_hash IS NULL
+  AND NOT EXISTS (SELECT 1 FROM session s WHERE s.user_id = user.id)
+  AND NOT EXISTS (SELECT 1 FROM game g WHERE g.user_id = user.id);
+-- name: UpdateVote :one
+UPDATE vote
+SET updated_at = ?,
+    fun_vote = ?
+WHERE id = ?
+RETURNING *;
 -- name: SetActiveGameFormUser :one
 UPDATE user
 SET updated_at = ?,
@@ -135,7 +445,7 @@ WHERE id = ?
 RETURNING *;
 -- name: Stats :one
 SELECT (SELECT COUNT(*) FROM user) AS users
-     , (SELECT COUNT(*) FROM session) AS session
+     , (SELECT COUNT(*) FROM session where session.invalid_after > ?) AS session
      , (SELECT COUNT(*) FROM game) AS games
      , (SELECT COUNT(*) FROM game where game.play_state = 1) AS games_won
      , (SELECT COUNT(*) FROM game where game.play_state = 2) AS games_lost
@@ -147,4 +457,4 @@ SELECT (SELECT COUNT(*) FROM user) AS users
      , (SELECT avg(length(history)) from game) as history_avg
      , (SELECT max(length(history)) from game) as history_max
      , (SELECT min(length(history)) from game) as history_min
-     , (SELECT CAST(total(length(history)) as INT) from game where kind = 2) as history_total
+     , (SELECT CAST(total(length(history)) as INT) from game) as history_total
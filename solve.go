@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/runar-rkmedia/gotally/generated"
+	"github.com/runar-rkmedia/gotally/tallylogic"
+)
+
+func runSolve(args []string) error {
+	fs := newFlagSet("solve", "[flags] [generated-game.toml]\n\nThe board is either read from a generated game, or from the cells-flag.")
+	cells := fs.String("cells", "", "Comma-separated cell-values for the board, row by row. Used if no file is given")
+	rows := fs.Int("rows", 3, "Number of rows on the board, when using cells")
+	columns := fs.Int("columns", 3, "Number of columns on the board, when using cells")
+	target := fs.Uint64("target", 0, "Value of the cell the player must reach, when using cells")
+	minMoves := fs.Int("min-moves", 0, "Minimum moves for a solution")
+	maxMoves := fs.Int("max-moves", 0, "Maximum moves for a solution. Defaults to the max-moves of the game")
+	maxSolutions := fs.Int("max-solutions", 10, "Stop after finding this many solutions")
+	timeout := fs.Duration("timeout", 10*time.Second, "Stop solving after this duration")
+	visual := fs.Bool("visual", false, "Print every step of each solution")
+	parallel := fs.Bool("parallel", false, "Spread the solving across multiple workers")
+	workers := fs.Int("workers", 0, "Number of workers, when solving in parallel. Defaults to the number of CPUs")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var game tallylogic.Game
+	var err error
+	switch {
+	case fs.NArg() > 1:
+		return fmt.Errorf("%w: only a single file can be solved at a time", errUsage)
+	case fs.NArg() == 1:
+		var gen tallylogic.GeneratedGame
+		gen, err = readGeneratedGame(fs.Arg(0))
+		if err != nil {
+			return fmt.Errorf("failed to read generated game: %w", err)
+		}
+		game, err = tallylogic.NewGame(tallylogic.GameModeRandomChallenge, generated.TemplateFromGeneratedGame(gen))
+	case *cells != "":
+		game, err = gameFromCells(*cells, *rows, *columns, *target, *maxMoves)
+	default:
+		return fmt.Errorf("%w: either a file or cells must be set", errUsage)
+	}
+	if err != nil {
+		return err
+	}
+	if *maxMoves == 0 {
+		*maxMoves = int(game.Rules.MaxMoves)
+	}
+	fmt.Println(game.Print())
+
+	// Interrupting prints the solutions found so far
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	start := time.Now()
+	options := tallylogic.SolveOptions{
+		MinMoves:     *minMoves,
+		MaxMoves:     *maxMoves,
+		MaxSolutions: *maxSolutions,
+		MaxTime:      *timeout,
+	}
+	var solutions []tallylogic.Game
+	if *parallel {
+		solver := tallylogic.NewParallelSolver(options, *workers)
+		solutions, err = solver.SolveGame(ctx, game)
+	} else {
+		solutions, err = tallylogic.SolveGame(ctx, options, game)
+	}
+	if err != nil {
+		if len(solutions) == 0 {
+			return fmt.Errorf("failed to solve game: %w", err)
+		}
+		log.Warn().Err(err).Msg("Solver stopped early, the solutions may not be complete")
+	}
+	if len(solutions) == 0 {
+		fmt.Printf("No solutions found in %s\n", time.Since(start).Round(time.Millisecond))
+		return nil
+	}
+	out, err := tallylogic.NewGeneratedGame(tallylogic.SolvableGame{Game: game, Solutions: solutions}, game.Name)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Found %d solutions in %s, best first:\n", len(solutions), time.Since(start).Round(time.Millisecond))
+	printSolutions(out.Solutions, *visual)
+	return nil
+}
+
+// Creates a challenge-game from the comma-separated cell-values
+func gameFromCells(s string, rows, columns int, target uint64, maxMoves int) (tallylogic.Game, error) {
+	if target == 0 {
+		return tallylogic.Game{}, fmt.Errorf("%w: target must be set when using cells", errUsage)
+	}
+	fields := strings.Split(s, ",")
+	if len(fields) != rows*columns {
+		return tallylogic.Game{}, fmt.Errorf("%w: expected %d cells for a %dx%d board, but got %d", errUsage, rows*columns, columns, rows, len(fields))
+	}
+	values := make([]int64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseInt(strings.TrimSpace(f), 10, 64)
+		if err != nil {
+			return tallylogic.Game{}, fmt.Errorf("%w: invalid cell-value at index %d: %v", errUsage, i, err)
+		}
+		values[i] = v
+	}
+	template := tallylogic.NewGameTemplate(tallylogic.GameModeRandomChallenge, "", "cli", "", rows, columns).
+		SetGoalCheckerLargestValue(target).
+		SetStartingLayout(values...)
+	if maxMoves > 0 {
+		template.SetMaxMoves(maxMoves)
+	}
+	return tallylogic.NewGame(tallylogic.GameModeRandomChallenge, template)
+}
+
+func printSolutions(solutions []tallylogic.GeneratedSolution, visual bool) {
+	for i, s := range solutions {
+		fmt.Printf("%3d: %2d moves, score %d, highest cell %d\n", i, s.Moves, s.Score, s.HighestCellValue)
+		if visual {
+			fmt.Println(s.VisualSolution)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"fmt"
 )
 
 var (
@@ -11,7 +12,64 @@ var (
 	schema string
 )
 
+// Columns added to tables after they were first created.
+// The schema only creates missing tables, so existing databases get these columns from migrateColumns.
+var addedColumns = []struct {
+	table, column, definition string
+}{
+	{"rule", "no_super_powers", "BOOLEAN not null default false"},
+	{"rule", "max_swaps", "int not null default 0"},
+	{"rule", "swap_neighbours_only", "BOOLEAN not null default false"},
+	{"rule", "max_powers", "int not null default 0"},
+	{"rule", "with_subtraction", "BOOLEAN not null default false"},
+	{"rule", "with_division", "BOOLEAN not null default false"},
+	{"rule", "with_mixed_operators", "BOOLEAN not null default false"},
+	{"rule", "topology", "int not null default 0"},
+	{"user", "password_hash", "varchar(200)"},
+	{"game_template", "daily_date", "varchar(10)"},
+	{"session", "replaced_by", "varchar(21)"},
+}
+
 func (q *Queries) InitializeDatabase(ctx context.Context) (sql.Result, error) {
+	// The schema creates indexes on the added columns, so these must exist first
+	if err := q.migrateColumns(ctx); err != nil {
+		return nil, err
+	}
 	return q.db.ExecContext(ctx, schema)
 
 }
+
+// Adds the columns in addedColumns to existing tables that do not have them.
+// Tables that do not exist yet are left for the schema to create.
+func (q *Queries) migrateColumns(ctx context.Context) error {
+	for _, c := range addedColumns {
+		rows, err := q.db.QueryContext(ctx, "select name from pragma_table_info(?)", c.table)
+		if err != nil {
+			return fmt.Errorf("failed to read columns of table %s: %w", c.table, err)
+		}
+		tableExists, hasColumn := false, false
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to read columns of table %s: %w", c.table, err)
+			}
+			tableExists = true
+			if name == c.column {
+				hasColumn = true
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to read columns of table %s: %w", c.table, err)
+		}
+		if !tableExists || hasColumn {
+			continue
+		}
+		_, err = q.db.ExecContext(ctx, fmt.Sprintf("alter table %s add column %s %s", c.table, c.column, c.definition))
+		if err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %w", c.table, c.column, err)
+		}
+	}
+	return nil
+}
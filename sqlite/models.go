@@ -27,6 +27,14 @@ type Game struct {
 	History     []byte
 }
 
+type GameCheckpoint struct {
+	GameID    string
+	Depth     int64
+	CreatedAt time.Time
+	Score     int64
+	Data      []byte
+}
+
 type GameTemplate struct {
 	ID              string
 	CreatedAt       time.Time
@@ -40,24 +48,41 @@ type GameTemplate struct {
 	IdealMoves      sql.NullInt64
 	IdealScore      sql.NullInt64
 	Data            []byte
+	DailyDate       sql.NullString
+}
+
+type LinkCode struct {
+	Code         string
+	CreatedAt    time.Time
+	InvalidAfter time.Time
+	UsedAt       sql.NullTime
+	UserID       string
 }
 
 type Rule struct {
-	ID              string
-	Slug            string
-	CreatedAt       time.Time
-	UpdatedAt       sql.NullTime
-	Mode            int64
-	Description     sql.NullString
-	SizeX           int64
-	SizeY           int64
-	MaxMoves        sql.NullInt64
-	TargetCellValue sql.NullInt64
-	TargetScore     sql.NullInt64
-	RecreateOnSwipe bool
-	NoReswipe       bool
-	NoMultiply      bool
-	NoAddition      bool
+	ID                 string
+	Slug               string
+	CreatedAt          time.Time
+	UpdatedAt          sql.NullTime
+	Mode               int64
+	Description        sql.NullString
+	SizeX              int64
+	SizeY              int64
+	MaxMoves           sql.NullInt64
+	TargetCellValue    sql.NullInt64
+	TargetScore        sql.NullInt64
+	RecreateOnSwipe    bool
+	NoReswipe          bool
+	NoMultiply         bool
+	NoAddition         bool
+	NoSuperPowers      bool
+	MaxSwaps           int64
+	SwapNeighboursOnly bool
+	MaxPowers          int64
+	WithSubtraction    bool
+	WithDivision       bool
+	WithMixedOperators bool
+	Topology           int64
 }
 
 type Session struct {
@@ -66,6 +91,7 @@ type Session struct {
 	UpdatedAt    sql.NullTime
 	InvalidAfter time.Time
 	UserID       string
+	ReplacedBy   sql.NullString
 }
 
 type User struct {
@@ -74,4 +100,15 @@ type User struct {
 	UpdatedAt    sql.NullTime
 	Username     string
 	ActiveGameID string
+	PasswordHash sql.NullString
+}
+
+type Vote struct {
+	ID         string
+	CreatedAt  time.Time
+	UpdatedAt  sql.NullTime
+	UserID     string
+	GameID     sql.NullString
+	TemplateID sql.NullString
+	FunVote    int64
 }
@@ -11,6 +11,127 @@ import (
 	"time"
 )
 
+const countChallengeLeaderboard = `-- name: CountChallengeLeaderboard :one
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = ?
+	)
+SELECT
+	COUNT(DISTINCT a.user_id)
+FROM
+	attempts AS a
+WHERE
+	a.play_state = 1
+	AND (NOT ? OR a.attempt = 1)
+`
+
+type CountChallengeLeaderboardParams struct {
+	TemplateID       sql.NullString
+	FirstAttemptOnly bool
+}
+
+// Number of users on the GetChallengeLeaderboard-query
+func (q *Queries) CountChallengeLeaderboard(ctx context.Context, arg CountChallengeLeaderboardParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countChallengeLeaderboard, arg.TemplateID, arg.FirstAttemptOnly)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteExpiredLinkCodes = `-- name: DeleteExpiredLinkCodes :execrows
+DELETE FROM link_code
+WHERE invalid_after < ?
+`
+
+func (q *Queries) DeleteExpiredLinkCodes(ctx context.Context, invalidAfter time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredLinkCodes, invalidAfter)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredSessions = `-- name: DeleteExpiredSessions :execrows
+DELETE FROM session
+WHERE invalid_after < ?
+`
+
+func (q *Queries) DeleteExpiredSessions(ctx context.Context, invalidAfter time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredSessions, invalidAfter)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteGameCheckpointsAfter = `-- name: DeleteGameCheckpointsAfter :execrows
+DELETE FROM game_checkpoint
+WHERE game_id == ? AND depth > ?
+`
+
+type DeleteGameCheckpointsAfterParams struct {
+	GameID string
+	Depth  int64
+}
+
+func (q *Queries) DeleteGameCheckpointsAfter(ctx context.Context, arg DeleteGameCheckpointsAfterParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteGameCheckpointsAfter, arg.GameID, arg.Depth)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteGamesForOrphanedUsers = `-- name: DeleteGamesForOrphanedUsers :execrows
+DELETE FROM game
+WHERE user_id IN (
+    SELECT u.id FROM user u
+    WHERE u.password_hash IS NULL
+      AND NOT EXISTS (SELECT 1 FROM session s WHERE s.user_id = u.id)
+      AND NOT EXISTS (SELECT 1 FROM game g WHERE g.user_id = u.id AND g.moves > 0)
+      AND NOT EXISTS (SELECT 1 FROM game_template t WHERE t.created_by = u.id OR t.updated_by = u.id)
+      AND NOT EXISTS (SELECT 1 FROM vote v WHERE v.user_id = u.id)
+      AND NOT EXISTS (SELECT 1 FROM link_code l WHERE l.user_id = u.id)
+)
+`
+
+// Orphaned users are guests without any sessions, that never made a move.
+// Their games are removed first, since every user has an active game.
+func (q *Queries) DeleteGamesForOrphanedUsers(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteGamesForOrphanedUsers)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteOrphanedUsers = `-- name: DeleteOrphanedUsers :execrows
+DELETE FROM user
+WHERE password_hash IS NULL
+  AND NOT EXISTS (SELECT 1 FROM session s WHERE s.user_id = user.id)
+  AND NOT EXISTS (SELECT 1 FROM game g WHERE g.user_id = user.id)
+`
+
+func (q *Queries) DeleteOrphanedUsers(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOrphanedUsers)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getAllGames = `-- name: GetAllGames :many
 SELECT id, created_at, updated_at, name, description, user_id, rule_id, based_on_game, template_id, score, moves, play_state, data, data_at_start, history from game
 `
@@ -55,7 +176,7 @@ func (q *Queries) GetAllGames(ctx context.Context) ([]Game, error) {
 }
 
 const getAllRules = `-- name: GetAllRules :many
-SELECT id, slug, created_at, updated_at, mode, description, size_x, size_y, max_moves, target_cell_value, target_score, recreate_on_swipe, no_reswipe, no_multiply, no_addition from rule
+SELECT id, slug, created_at, updated_at, mode, description, size_x, size_y, max_moves, target_cell_value, target_score, recreate_on_swipe, no_reswipe, no_multiply, no_addition, no_super_powers, max_swaps, swap_neighbours_only, max_powers, with_subtraction, with_division, with_mixed_operators, topology from rule
 `
 
 func (q *Queries) GetAllRules(ctx context.Context) ([]Rule, error) {
@@ -83,6 +204,14 @@ func (q *Queries) GetAllRules(ctx context.Context) ([]Rule, error) {
 			&i.NoReswipe,
 			&i.NoMultiply,
 			&i.NoAddition,
+			&i.NoSuperPowers,
+			&i.MaxSwaps,
+			&i.SwapNeighboursOnly,
+			&i.MaxPowers,
+			&i.WithSubtraction,
+			&i.WithDivision,
+			&i.WithMixedOperators,
+			&i.Topology,
 		); err != nil {
 			return nil, err
 		}
@@ -98,7 +227,7 @@ func (q *Queries) GetAllRules(ctx context.Context) ([]Rule, error) {
 }
 
 const getAllSessions = `-- name: GetAllSessions :many
-SELECT id, created_at, updated_at, invalid_after, user_id from session
+SELECT id, created_at, updated_at, invalid_after, user_id, replaced_by from session
 `
 
 func (q *Queries) GetAllSessions(ctx context.Context) ([]Session, error) {
@@ -116,6 +245,7 @@ func (q *Queries) GetAllSessions(ctx context.Context) ([]Session, error) {
 			&i.UpdatedAt,
 			&i.InvalidAfter,
 			&i.UserID,
+			&i.ReplacedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -131,7 +261,7 @@ func (q *Queries) GetAllSessions(ctx context.Context) ([]Session, error) {
 }
 
 const getAllTemplates = `-- name: GetAllTemplates :many
-SELECT id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data from game_template
+SELECT id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date from game_template
 `
 
 func (q *Queries) GetAllTemplates(ctx context.Context) ([]GameTemplate, error) {
@@ -156,6 +286,7 @@ func (q *Queries) GetAllTemplates(ctx context.Context) ([]GameTemplate, error) {
 			&i.IdealMoves,
 			&i.IdealScore,
 			&i.Data,
+			&i.DailyDate,
 		); err != nil {
 			return nil, err
 		}
@@ -171,7 +302,7 @@ func (q *Queries) GetAllTemplates(ctx context.Context) ([]GameTemplate, error) {
 }
 
 const getAllUsers = `-- name: GetAllUsers :many
-SELECT id, created_at, updated_at, username, active_game_id from user
+SELECT id, created_at, updated_at, username, active_game_id, password_hash from user
 `
 
 func (q *Queries) GetAllUsers(ctx context.Context) ([]User, error) {
@@ -189,6 +320,234 @@ func (q *Queries) GetAllUsers(ctx context.Context) ([]User, error) {
 			&i.UpdatedAt,
 			&i.Username,
 			&i.ActiveGameID,
+			&i.PasswordHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllVotes = `-- name: GetAllVotes :many
+SELECT id, created_at, updated_at, user_id, game_id, template_id, fun_vote from vote
+`
+
+func (q *Queries) GetAllVotes(ctx context.Context) ([]Vote, error) {
+	rows, err := q.db.QueryContext(ctx, getAllVotes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Vote
+	for rows.Next() {
+		var i Vote
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserID,
+			&i.GameID,
+			&i.TemplateID,
+			&i.FunVote,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAttemptsForTemplate = `-- name: GetAttemptsForTemplate :many
+SELECT
+	g.id as game_id
+	, g.score
+	, g.moves
+	, g.play_state
+	, g.created_at
+	, g.updated_at
+	, u.id as user_id
+	, u.username
+FROM
+	game AS g
+	JOIN user AS u ON u.id = g.user_id
+WHERE
+	g.template_id = ?
+ORDER BY
+	g.created_at
+	, g.id
+`
+
+type GetAttemptsForTemplateRow struct {
+	GameID    string
+	Score     int64
+	Moves     int64
+	PlayState int64
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+	UserID    string
+	Username  string
+}
+
+// All games for the template, in the order they were started
+func (q *Queries) GetAttemptsForTemplate(ctx context.Context, templateID sql.NullString) ([]GetAttemptsForTemplateRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAttemptsForTemplate, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAttemptsForTemplateRow
+	for rows.Next() {
+		var i GetAttemptsForTemplateRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.Score,
+			&i.Moves,
+			&i.PlayState,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserID,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChallengeLeaderboard = `-- name: GetChallengeLeaderboard :many
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = ?
+	)
+	, best AS (
+		SELECT
+			a.id
+			, a.user_id
+			, a.score
+			, a.moves
+			, a.created_at
+			, a.updated_at
+			, ROW_NUMBER() OVER (
+				PARTITION BY a.user_id
+				ORDER BY a.moves, a.score DESC, COALESCE(a.updated_at, a.created_at)
+			) AS best_attempt
+		FROM
+			attempts AS a
+		WHERE
+			a.play_state = 1
+			AND (NOT ? OR a.attempt = 1)
+	)
+	, ranked AS (
+		SELECT
+			b.id
+			, b.user_id
+			, b.score
+			, b.moves
+			, b.created_at
+			, b.updated_at
+			, RANK() OVER (
+				ORDER BY b.moves, b.score DESC, COALESCE(b.updated_at, b.created_at)
+			) AS rank
+		FROM
+			best AS b
+		WHERE
+			b.best_attempt = 1
+	)
+SELECT
+	r.id as game_id
+	, r.score
+	, r.moves
+	, r.created_at
+	, r.updated_at
+	, u.id as user_id
+	, u.username
+	, r.rank
+FROM
+	ranked AS r
+	JOIN user AS u ON u.id = r.user_id
+ORDER BY
+	r.rank
+	, r.user_id
+LIMIT ? OFFSET ?
+`
+
+type GetChallengeLeaderboardParams struct {
+	TemplateID       sql.NullString
+	FirstAttemptOnly bool
+	RowLimit         int64
+	RowOffset        int64
+}
+
+type GetChallengeLeaderboardRow struct {
+	GameID    string
+	Score     int64
+	Moves     int64
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+	UserID    string
+	Username  string
+	Rank      int64
+}
+
+// The best won game (play_state 1) of each user for the template, best first:
+// fewest moves, then highest score, then whoever won first. Users with equal
+// moves, score and time share the same rank. With first_attempt_only, only the
+// first game of each user is considered, as for the daily challenges.
+func (q *Queries) GetChallengeLeaderboard(ctx context.Context, arg GetChallengeLeaderboardParams) ([]GetChallengeLeaderboardRow, error) {
+	rows, err := q.db.QueryContext(ctx, getChallengeLeaderboard,
+		arg.TemplateID,
+		arg.FirstAttemptOnly,
+		arg.RowLimit,
+		arg.RowOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetChallengeLeaderboardRow
+	for rows.Next() {
+		var i GetChallengeLeaderboardRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.Score,
+			&i.Moves,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserID,
+			&i.Username,
+			&i.Rank,
 		); err != nil {
 			return nil, err
 		}
@@ -203,6 +562,107 @@ func (q *Queries) GetAllUsers(ctx context.Context) ([]User, error) {
 	return items, nil
 }
 
+const getChallengeLeaderboardForUser = `-- name: GetChallengeLeaderboardForUser :one
+WITH
+	attempts AS (
+		SELECT
+			g.id
+			, g.user_id
+			, g.score
+			, g.moves
+			, g.play_state
+			, g.created_at
+			, g.updated_at
+			, ROW_NUMBER() OVER (PARTITION BY g.user_id ORDER BY g.created_at, g.id) AS attempt
+		FROM
+			game AS g
+		WHERE
+			g.template_id = ?
+	)
+	, best AS (
+		SELECT
+			a.id
+			, a.user_id
+			, a.score
+			, a.moves
+			, a.created_at
+			, a.updated_at
+			, ROW_NUMBER() OVER (
+				PARTITION BY a.user_id
+				ORDER BY a.moves, a.score DESC, COALESCE(a.updated_at, a.created_at)
+			) AS best_attempt
+		FROM
+			attempts AS a
+		WHERE
+			a.play_state = 1
+			AND (NOT ? OR a.attempt = 1)
+	)
+	, ranked AS (
+		SELECT
+			b.id
+			, b.user_id
+			, b.score
+			, b.moves
+			, b.created_at
+			, b.updated_at
+			, RANK() OVER (
+				ORDER BY b.moves, b.score DESC, COALESCE(b.updated_at, b.created_at)
+			) AS rank
+		FROM
+			best AS b
+		WHERE
+			b.best_attempt = 1
+	)
+SELECT
+	r.id as game_id
+	, r.score
+	, r.moves
+	, r.created_at
+	, r.updated_at
+	, u.id as user_id
+	, u.username
+	, r.rank
+FROM
+	ranked AS r
+	JOIN user AS u ON u.id = r.user_id
+WHERE
+	r.user_id = ?
+`
+
+type GetChallengeLeaderboardForUserParams struct {
+	TemplateID       sql.NullString
+	FirstAttemptOnly bool
+	UserID           string
+}
+
+type GetChallengeLeaderboardForUserRow struct {
+	GameID    string
+	Score     int64
+	Moves     int64
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+	UserID    string
+	Username  string
+	Rank      int64
+}
+
+// The entry of a single user in the GetChallengeLeaderboard-query
+func (q *Queries) GetChallengeLeaderboardForUser(ctx context.Context, arg GetChallengeLeaderboardForUserParams) (GetChallengeLeaderboardForUserRow, error) {
+	row := q.db.QueryRowContext(ctx, getChallengeLeaderboardForUser, arg.TemplateID, arg.FirstAttemptOnly, arg.UserID)
+	var i GetChallengeLeaderboardForUserRow
+	err := row.Scan(
+		&i.GameID,
+		&i.Score,
+		&i.Moves,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UserID,
+		&i.Username,
+		&i.Rank,
+	)
+	return i, err
+}
+
 const getChallengeStatsForUser = `-- name: GetChallengeStatsForUser :many
 SELECT
 	g.id as game_id
@@ -258,6 +718,50 @@ func (q *Queries) GetChallengeStatsForUser(ctx context.Context, userID string) (
 	return items, nil
 }
 
+const getDailyTemplates = `-- name: GetDailyTemplates :many
+select id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date from game_template
+where daily_date is not null
+order by daily_date desc
+`
+
+// The templates of the daily challenges, the most recent day first
+func (q *Queries) GetDailyTemplates(ctx context.Context) ([]GameTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, getDailyTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameTemplate
+	for rows.Next() {
+		var i GameTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RuleID,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.Name,
+			&i.Description,
+			&i.ChallengeNumber,
+			&i.IdealMoves,
+			&i.IdealScore,
+			&i.Data,
+			&i.DailyDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getGame = `-- name: GetGame :one
 select id, created_at, updated_at, name, description, user_id, rule_id, based_on_game, template_id, score, moves, play_state, data, data_at_start, history from game
 where id == ?
@@ -287,7 +791,7 @@ func (q *Queries) GetGame(ctx context.Context, id string) (Game, error) {
 }
 
 const getGameChallengesTemplates = `-- name: GetGameChallengesTemplates :many
-select id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data from game_template
+select id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date from game_template
 where challenge_number is not null
 order by challenge_number
 `
@@ -314,6 +818,7 @@ func (q *Queries) GetGameChallengesTemplates(ctx context.Context) ([]GameTemplat
 			&i.IdealMoves,
 			&i.IdealScore,
 			&i.Data,
+			&i.DailyDate,
 		); err != nil {
 			return nil, err
 		}
@@ -328,8 +833,34 @@ func (q *Queries) GetGameChallengesTemplates(ctx context.Context) ([]GameTemplat
 	return items, nil
 }
 
+const getGameCheckpoint = `-- name: GetGameCheckpoint :one
+SELECT game_id, depth, created_at, score, data FROM game_checkpoint
+WHERE game_id == ? AND depth <= ?
+ORDER BY depth DESC
+LIMIT 1
+`
+
+type GetGameCheckpointParams struct {
+	GameID string
+	Depth  int64
+}
+
+// The latest checkpoint at or before the depth
+func (q *Queries) GetGameCheckpoint(ctx context.Context, arg GetGameCheckpointParams) (GameCheckpoint, error) {
+	row := q.db.QueryRowContext(ctx, getGameCheckpoint, arg.GameID, arg.Depth)
+	var i GameCheckpoint
+	err := row.Scan(
+		&i.GameID,
+		&i.Depth,
+		&i.CreatedAt,
+		&i.Score,
+		&i.Data,
+	)
+	return i, err
+}
+
 const getGameTemplate = `-- name: GetGameTemplate :one
-select id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data from game_template
+select id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date from game_template
 where id = ?
 `
 
@@ -349,12 +880,13 @@ func (q *Queries) GetGameTemplate(ctx context.Context, id string) (GameTemplate,
 		&i.IdealMoves,
 		&i.IdealScore,
 		&i.Data,
+		&i.DailyDate,
 	)
 	return i, err
 }
 
 const getGameTemplateByChallengeNumber = `-- name: GetGameTemplateByChallengeNumber :one
-select id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data from game_template
+select id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date from game_template
 where challenge_number = ?
 `
 
@@ -374,19 +906,92 @@ func (q *Queries) GetGameTemplateByChallengeNumber(ctx context.Context, challeng
 		&i.IdealMoves,
 		&i.IdealScore,
 		&i.Data,
+		&i.DailyDate,
+	)
+	return i, err
+}
+
+const getGameTemplateByDailyDate = `-- name: GetGameTemplateByDailyDate :one
+select id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date from game_template
+where daily_date = ?
+`
+
+func (q *Queries) GetGameTemplateByDailyDate(ctx context.Context, dailyDate sql.NullString) (GameTemplate, error) {
+	row := q.db.QueryRowContext(ctx, getGameTemplateByDailyDate, dailyDate)
+	var i GameTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RuleID,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.Name,
+		&i.Description,
+		&i.ChallengeNumber,
+		&i.IdealMoves,
+		&i.IdealScore,
+		&i.Data,
+		&i.DailyDate,
 	)
 	return i, err
 }
 
+const getGamesForUser = `-- name: GetGamesForUser :many
+select id, created_at, updated_at, name, description, user_id, rule_id, based_on_game, template_id, score, moves, play_state, data, data_at_start, history from game
+where user_id == ?
+order by created_at desc
+`
+
+// The users games, the most recently created first
+func (q *Queries) GetGamesForUser(ctx context.Context, userID string) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, getGamesForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.Description,
+			&i.UserID,
+			&i.RuleID,
+			&i.BasedOnGame,
+			&i.TemplateID,
+			&i.Score,
+			&i.Moves,
+			&i.PlayState,
+			&i.Data,
+			&i.DataAtStart,
+			&i.History,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOriginalGame = `-- name: GetOriginalGame :one
 SELECT o.id, o.created_at, o.updated_at, o.name, o.description, o.user_id, o.rule_id, o.based_on_game, o.template_id, o.score, o.moves, o.play_state, o.data, o.data_at_start, o.history
   FROM game AS g 
     JOIN game o ON o.id == g.based_on_game
- WHERE g.id = '?'
+ WHERE g.id = ?
 `
 
-func (q *Queries) GetOriginalGame(ctx context.Context) (Game, error) {
-	row := q.db.QueryRowContext(ctx, getOriginalGame)
+func (q *Queries) GetOriginalGame(ctx context.Context, id string) (Game, error) {
+	row := q.db.QueryRowContext(ctx, getOriginalGame, id)
 	var i Game
 	err := row.Scan(
 		&i.ID,
@@ -408,9 +1013,28 @@ func (q *Queries) GetOriginalGame(ctx context.Context) (Game, error) {
 	return i, err
 }
 
+const getRegisteredUserByUsername = `-- name: GetRegisteredUserByUsername :one
+select id, created_at, updated_at, username, active_game_id, password_hash from user
+where username == ? and password_hash IS NOT NULL
+`
+
+func (q *Queries) GetRegisteredUserByUsername(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getRegisteredUserByUsername, username)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Username,
+		&i.ActiveGameID,
+		&i.PasswordHash,
+	)
+	return i, err
+}
+
 const getRule = `-- name: GetRule :one
 ;
-select id, slug, created_at, updated_at, mode, description, size_x, size_y, max_moves, target_cell_value, target_score, recreate_on_swipe, no_reswipe, no_multiply, no_addition from rule
+select id, slug, created_at, updated_at, mode, description, size_x, size_y, max_moves, target_cell_value, target_score, recreate_on_swipe, no_reswipe, no_multiply, no_addition, no_super_powers, max_swaps, swap_neighbours_only, max_powers, with_subtraction, with_division, with_mixed_operators, topology from rule
 where id == ? or slug == ?
 `
 
@@ -438,12 +1062,39 @@ func (q *Queries) GetRule(ctx context.Context, arg GetRuleParams) (Rule, error)
 		&i.NoReswipe,
 		&i.NoMultiply,
 		&i.NoAddition,
+		&i.NoSuperPowers,
+		&i.MaxSwaps,
+		&i.SwapNeighboursOnly,
+		&i.MaxPowers,
+		&i.WithSubtraction,
+		&i.WithDivision,
+		&i.WithMixedOperators,
+		&i.Topology,
+	)
+	return i, err
+}
+
+const getSession = `-- name: GetSession :one
+SELECT id, created_at, updated_at, invalid_after, user_id, replaced_by from session
+WHERE id = ?
+`
+
+func (q *Queries) GetSession(ctx context.Context, id string) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSession, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InvalidAfter,
+		&i.UserID,
+		&i.ReplacedBy,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-select id, created_at, updated_at, username, active_game_id from user
+select id, created_at, updated_at, username, active_game_id, password_hash from user
 where id == ?
 `
 
@@ -456,6 +1107,7 @@ func (q *Queries) GetUser(ctx context.Context, id string) (User, error) {
 		&i.UpdatedAt,
 		&i.Username,
 		&i.ActiveGameID,
+		&i.PasswordHash,
 	)
 	return i, err
 }
@@ -470,6 +1122,7 @@ SELECT
        user.created_at user_created_at,
        user.updated_at user_updated_at,
        user.username,
+       user.password_hash,
 
        game.id game_id,
        game.created_at game_created_at,
@@ -497,6 +1150,7 @@ type GetUserBySessionIDRow struct {
 	CreatedAt_2  time.Time
 	UpdatedAt    sql.NullTime
 	Username     string
+	PasswordHash sql.NullString
 	ID_3         string
 	CreatedAt_3  time.Time
 	UpdatedAt_2  sql.NullTime
@@ -521,6 +1175,7 @@ func (q *Queries) GetUserBySessionID(ctx context.Context, id string) (GetUserByS
 		&i.CreatedAt_2,
 		&i.UpdatedAt,
 		&i.Username,
+		&i.PasswordHash,
 		&i.ID_3,
 		&i.CreatedAt_3,
 		&i.UpdatedAt_2,
@@ -536,11 +1191,104 @@ func (q *Queries) GetUserBySessionID(ctx context.Context, id string) (GetUserByS
 	return i, err
 }
 
+const getVoteForGameByUser = `-- name: GetVoteForGameByUser :one
+select id, created_at, updated_at, user_id, game_id, template_id, fun_vote from vote
+where user_id = ? and game_id = ?
+`
+
+type GetVoteForGameByUserParams struct {
+	UserID string
+	GameID sql.NullString
+}
+
+func (q *Queries) GetVoteForGameByUser(ctx context.Context, arg GetVoteForGameByUserParams) (Vote, error) {
+	row := q.db.QueryRowContext(ctx, getVoteForGameByUser, arg.UserID, arg.GameID)
+	var i Vote
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UserID,
+		&i.GameID,
+		&i.TemplateID,
+		&i.FunVote,
+	)
+	return i, err
+}
+
+const getVoteForTemplateByUser = `-- name: GetVoteForTemplateByUser :one
+;
+select id, created_at, updated_at, user_id, game_id, template_id, fun_vote from vote
+where user_id = ? and template_id = ?
+`
+
+type GetVoteForTemplateByUserParams struct {
+	UserID     string
+	TemplateID sql.NullString
+}
+
+func (q *Queries) GetVoteForTemplateByUser(ctx context.Context, arg GetVoteForTemplateByUserParams) (Vote, error) {
+	row := q.db.QueryRowContext(ctx, getVoteForTemplateByUser, arg.UserID, arg.TemplateID)
+	var i Vote
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UserID,
+		&i.GameID,
+		&i.TemplateID,
+		&i.FunVote,
+	)
+	return i, err
+}
+
+const getVoteStatsForTemplates = `-- name: GetVoteStatsForTemplates :many
+SELECT
+	template_id
+	, COUNT(*) AS votes
+	, CAST(TOTAL(fun_vote) AS INT) AS fun_vote_total
+FROM
+	vote
+WHERE
+	template_id IS NOT NULL
+GROUP BY
+	template_id
+`
+
+type GetVoteStatsForTemplatesRow struct {
+	TemplateID   sql.NullString
+	Votes        int64
+	FunVoteTotal interface{}
+}
+
+func (q *Queries) GetVoteStatsForTemplates(ctx context.Context) ([]GetVoteStatsForTemplatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getVoteStatsForTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetVoteStatsForTemplatesRow
+	for rows.Next() {
+		var i GetVoteStatsForTemplatesRow
+		if err := rows.Scan(&i.TemplateID, &i.Votes, &i.FunVoteTotal); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const inserTemplate = `-- name: InserTemplate :one
 INSERT INTO game_template
-(id, created_at, rule_id, created_by, name, description, challenge_number, ideal_moves, ideal_score, data)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data
+(id, created_at, rule_id, created_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, created_at, updated_at, rule_id, created_by, updated_by, name, description, challenge_number, ideal_moves, ideal_score, data, daily_date
 `
 
 type InserTemplateParams struct {
@@ -554,6 +1302,7 @@ type InserTemplateParams struct {
 	IdealMoves      sql.NullInt64
 	IdealScore      sql.NullInt64
 	Data            []byte
+	DailyDate       sql.NullString
 }
 
 func (q *Queries) InserTemplate(ctx context.Context, arg InserTemplateParams) (GameTemplate, error) {
@@ -568,6 +1317,7 @@ func (q *Queries) InserTemplate(ctx context.Context, arg InserTemplateParams) (G
 		arg.IdealMoves,
 		arg.IdealScore,
 		arg.Data,
+		arg.DailyDate,
 	)
 	var i GameTemplate
 	err := row.Scan(
@@ -583,6 +1333,7 @@ func (q *Queries) InserTemplate(ctx context.Context, arg InserTemplateParams) (G
 		&i.IdealMoves,
 		&i.IdealScore,
 		&i.Data,
+		&i.DailyDate,
 	)
 	return i, err
 }
@@ -651,29 +1402,69 @@ func (q *Queries) InsertGame(ctx context.Context, arg InsertGameParams) (Game, e
 	return i, err
 }
 
+const insertLinkCode = `-- name: InsertLinkCode :one
+INSERT INTO link_code
+    (code, created_at, invalid_after, user_id)
+VALUES (?, ?, ?, ?)
+RETURNING code, created_at, invalid_after, used_at, user_id
+`
+
+type InsertLinkCodeParams struct {
+	Code         string
+	CreatedAt    time.Time
+	InvalidAfter time.Time
+	UserID       string
+}
+
+func (q *Queries) InsertLinkCode(ctx context.Context, arg InsertLinkCodeParams) (LinkCode, error) {
+	row := q.db.QueryRowContext(ctx, insertLinkCode,
+		arg.Code,
+		arg.CreatedAt,
+		arg.InvalidAfter,
+		arg.UserID,
+	)
+	var i LinkCode
+	err := row.Scan(
+		&i.Code,
+		&i.CreatedAt,
+		&i.InvalidAfter,
+		&i.UsedAt,
+		&i.UserID,
+	)
+	return i, err
+}
+
 const insertRule = `-- name: InsertRule :one
 INSERT INTO rule
-(id, slug, created_at, updated_at, description, mode, size_x, size_y, recreate_on_swipe, no_reswipe, no_multiply, no_addition, max_moves, target_cell_value, target_score)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-RETURNING id, slug, created_at, updated_at, mode, description, size_x, size_y, max_moves, target_cell_value, target_score, recreate_on_swipe, no_reswipe, no_multiply, no_addition
+(id, slug, created_at, updated_at, description, mode, size_x, size_y, recreate_on_swipe, no_reswipe, no_multiply, no_addition, no_super_powers, max_moves, target_cell_value, target_score, max_swaps, swap_neighbours_only, max_powers, with_subtraction, with_division, with_mixed_operators, topology)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, slug, created_at, updated_at, mode, description, size_x, size_y, max_moves, target_cell_value, target_score, recreate_on_swipe, no_reswipe, no_multiply, no_addition, no_super_powers, max_swaps, swap_neighbours_only, max_powers, with_subtraction, with_division, with_mixed_operators, topology
 `
 
 type InsertRuleParams struct {
-	ID              string
-	Slug            string
-	CreatedAt       time.Time
-	UpdatedAt       sql.NullTime
-	Description     sql.NullString
-	Mode            int64
-	SizeX           int64
-	SizeY           int64
-	RecreateOnSwipe bool
-	NoReswipe       bool
-	NoMultiply      bool
-	NoAddition      bool
-	MaxMoves        sql.NullInt64
-	TargetCellValue sql.NullInt64
-	TargetScore     sql.NullInt64
+	ID                 string
+	Slug               string
+	CreatedAt          time.Time
+	UpdatedAt          sql.NullTime
+	Description        sql.NullString
+	Mode               int64
+	SizeX              int64
+	SizeY              int64
+	RecreateOnSwipe    bool
+	NoReswipe          bool
+	NoMultiply         bool
+	NoAddition         bool
+	NoSuperPowers      bool
+	MaxMoves           sql.NullInt64
+	TargetCellValue    sql.NullInt64
+	TargetScore        sql.NullInt64
+	MaxSwaps           int64
+	SwapNeighboursOnly bool
+	MaxPowers          int64
+	WithSubtraction    bool
+	WithDivision       bool
+	WithMixedOperators bool
+	Topology           int64
 }
 
 func (q *Queries) InsertRule(ctx context.Context, arg InsertRuleParams) (Rule, error) {
@@ -690,9 +1481,17 @@ func (q *Queries) InsertRule(ctx context.Context, arg InsertRuleParams) (Rule, e
 		arg.NoReswipe,
 		arg.NoMultiply,
 		arg.NoAddition,
+		arg.NoSuperPowers,
 		arg.MaxMoves,
 		arg.TargetCellValue,
 		arg.TargetScore,
+		arg.MaxSwaps,
+		arg.SwapNeighboursOnly,
+		arg.MaxPowers,
+		arg.WithSubtraction,
+		arg.WithDivision,
+		arg.WithMixedOperators,
+		arg.Topology,
 	)
 	var i Rule
 	err := row.Scan(
@@ -711,6 +1510,14 @@ func (q *Queries) InsertRule(ctx context.Context, arg InsertRuleParams) (Rule, e
 		&i.NoReswipe,
 		&i.NoMultiply,
 		&i.NoAddition,
+		&i.NoSuperPowers,
+		&i.MaxSwaps,
+		&i.SwapNeighboursOnly,
+		&i.MaxPowers,
+		&i.WithSubtraction,
+		&i.WithDivision,
+		&i.WithMixedOperators,
+		&i.Topology,
 	)
 	return i, err
 }
@@ -719,7 +1526,7 @@ const insertSession = `-- name: InsertSession :one
 INSERT INTO session
     (id, created_at, updated_at, invalid_after, user_id)
 VALUES (?, ?, ?, ?, ?)
-RETURNING id, created_at, updated_at, invalid_after, user_id
+RETURNING id, created_at, updated_at, invalid_after, user_id, replaced_by
 `
 
 type InsertSessionParams struct {
@@ -745,6 +1552,7 @@ func (q *Queries) InsertSession(ctx context.Context, arg InsertSessionParams) (S
 		&i.UpdatedAt,
 		&i.InvalidAfter,
 		&i.UserID,
+		&i.ReplacedBy,
 	)
 	return i, err
 }
@@ -753,7 +1561,7 @@ const insertUser = `-- name: InsertUser :one
 INSERT INTO user
     (id, created_at, updated_at, username, active_game_id)
 VALUES (?, ?, ?, ?, ?)
-RETURNING id, created_at, updated_at, username, active_game_id
+RETURNING id, created_at, updated_at, username, active_game_id, password_hash
 `
 
 type InsertUserParams struct {
@@ -779,6 +1587,137 @@ func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (User, e
 		&i.UpdatedAt,
 		&i.Username,
 		&i.ActiveGameID,
+		&i.PasswordHash,
+	)
+	return i, err
+}
+
+const insertVote = `-- name: InsertVote :one
+INSERT INTO vote
+(id, created_at, user_id, game_id, template_id, fun_vote)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, created_at, updated_at, user_id, game_id, template_id, fun_vote
+`
+
+type InsertVoteParams struct {
+	ID         string
+	CreatedAt  time.Time
+	UserID     string
+	GameID     sql.NullString
+	TemplateID sql.NullString
+	FunVote    int64
+}
+
+func (q *Queries) InsertVote(ctx context.Context, arg InsertVoteParams) (Vote, error) {
+	row := q.db.QueryRowContext(ctx, insertVote,
+		arg.ID,
+		arg.CreatedAt,
+		arg.UserID,
+		arg.GameID,
+		arg.TemplateID,
+		arg.FunVote,
+	)
+	var i Vote
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UserID,
+		&i.GameID,
+		&i.TemplateID,
+		&i.FunVote,
+	)
+	return i, err
+}
+
+const refreshSession = `-- name: RefreshSession :one
+UPDATE session
+SET updated_at = ?,
+    invalid_after = ?
+WHERE id = ?
+RETURNING id, created_at, updated_at, invalid_after, user_id, replaced_by
+`
+
+type RefreshSessionParams struct {
+	UpdatedAt    sql.NullTime
+	InvalidAfter time.Time
+	ID           string
+}
+
+func (q *Queries) RefreshSession(ctx context.Context, arg RefreshSessionParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, refreshSession, arg.UpdatedAt, arg.InvalidAfter, arg.ID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InvalidAfter,
+		&i.UserID,
+		&i.ReplacedBy,
+	)
+	return i, err
+}
+
+const registerUser = `-- name: RegisterUser :one
+UPDATE user
+SET updated_at = ?,
+    username = ?,
+    password_hash = ?
+WHERE id = ? AND password_hash IS NULL
+RETURNING id, created_at, updated_at, username, active_game_id, password_hash
+`
+
+type RegisterUserParams struct {
+	UpdatedAt    sql.NullTime
+	Username     string
+	PasswordHash sql.NullString
+	ID           string
+}
+
+func (q *Queries) RegisterUser(ctx context.Context, arg RegisterUserParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, registerUser,
+		arg.UpdatedAt,
+		arg.Username,
+		arg.PasswordHash,
+		arg.ID,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Username,
+		&i.ActiveGameID,
+		&i.PasswordHash,
+	)
+	return i, err
+}
+
+const replaceSession = `-- name: ReplaceSession :one
+UPDATE session
+SET updated_at = ?,
+    replaced_by = ?
+WHERE id = ? AND replaced_by IS NULL
+RETURNING id, created_at, updated_at, invalid_after, user_id, replaced_by
+`
+
+type ReplaceSessionParams struct {
+	UpdatedAt  sql.NullTime
+	ReplacedBy sql.NullString
+	ID         string
+}
+
+// Marks the session as replaced by another, unless it already is
+func (q *Queries) ReplaceSession(ctx context.Context, arg ReplaceSessionParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, replaceSession, arg.UpdatedAt, arg.ReplacedBy, arg.ID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InvalidAfter,
+		&i.UserID,
+		&i.ReplacedBy,
 	)
 	return i, err
 }
@@ -788,7 +1727,7 @@ UPDATE user
 SET updated_at = ?,
     active_game_id = ?
 WHERE id = ?
-RETURNING id, created_at, updated_at, username, active_game_id
+RETURNING id, created_at, updated_at, username, active_game_id, password_hash
 `
 
 type SetActiveGameFormUserParams struct {
@@ -806,6 +1745,7 @@ func (q *Queries) SetActiveGameFormUser(ctx context.Context, arg SetActiveGameFo
 		&i.UpdatedAt,
 		&i.Username,
 		&i.ActiveGameID,
+		&i.PasswordHash,
 	)
 	return i, err
 }
@@ -849,7 +1789,7 @@ func (q *Queries) SetPlayStateForGame(ctx context.Context, arg SetPlayStateForGa
 
 const stats = `-- name: Stats :one
 SELECT (SELECT COUNT(*) FROM user) AS users
-     , (SELECT COUNT(*) FROM session) AS session
+     , (SELECT COUNT(*) FROM session where session.invalid_after > ?) AS session
      , (SELECT COUNT(*) FROM game) AS games
      , (SELECT COUNT(*) FROM game where game.play_state = 1) AS games_won
      , (SELECT COUNT(*) FROM game where game.play_state = 2) AS games_lost
@@ -861,7 +1801,7 @@ SELECT (SELECT COUNT(*) FROM user) AS users
      , (SELECT avg(length(history)) from game) as history_avg
      , (SELECT max(length(history)) from game) as history_max
      , (SELECT min(length(history)) from game) as history_min
-     , (SELECT CAST(total(length(history)) as INT) from game where kind = 2) as history_total
+     , (SELECT CAST(total(length(history)) as INT) from game) as history_total
 `
 
 type StatsRow struct {
@@ -881,8 +1821,8 @@ type StatsRow struct {
 	HistoryTotal    interface{}
 }
 
-func (q *Queries) Stats(ctx context.Context) (StatsRow, error) {
-	row := q.db.QueryRowContext(ctx, stats)
+func (q *Queries) Stats(ctx context.Context, invalidAfter time.Time) (StatsRow, error) {
+	row := q.db.QueryRowContext(ctx, stats, invalidAfter)
 	var i StatsRow
 	err := row.Scan(
 		&i.Users,
@@ -968,7 +1908,7 @@ SET updated_at = ?,
     username = ?,
     active_game_id = ?
 WHERE id = ?
-RETURNING id, created_at, updated_at, username, active_game_id
+RETURNING id, created_at, updated_at, username, active_game_id, password_hash
 `
 
 type UpdateUserParams struct {
@@ -992,6 +1932,102 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.UpdatedAt,
 		&i.Username,
 		&i.ActiveGameID,
+		&i.PasswordHash,
+	)
+	return i, err
+}
+
+const updateVote = `-- name: UpdateVote :one
+UPDATE vote
+SET updated_at = ?,
+    fun_vote = ?
+WHERE id = ?
+RETURNING id, created_at, updated_at, user_id, game_id, template_id, fun_vote
+`
+
+type UpdateVoteParams struct {
+	UpdatedAt sql.NullTime
+	FunVote   int64
+	ID        string
+}
+
+func (q *Queries) UpdateVote(ctx context.Context, arg UpdateVoteParams) (Vote, error) {
+	row := q.db.QueryRowContext(ctx, updateVote, arg.UpdatedAt, arg.FunVote, arg.ID)
+	var i Vote
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UserID,
+		&i.GameID,
+		&i.TemplateID,
+		&i.FunVote,
+	)
+	return i, err
+}
+
+const upsertGameCheckpoint = `-- name: UpsertGameCheckpoint :one
+INSERT INTO game_checkpoint
+    (game_id, depth, created_at, score, data)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (game_id, depth) DO UPDATE
+    SET created_at = excluded.created_at,
+        score = excluded.score,
+        data = excluded.data
+RETURNING game_id, depth, created_at, score, data
+`
+
+type UpsertGameCheckpointParams struct {
+	GameID    string
+	Depth     int64
+	CreatedAt time.Time
+	Score     int64
+	Data      []byte
+}
+
+// Replaces any existing checkpoint at the same depth
+func (q *Queries) UpsertGameCheckpoint(ctx context.Context, arg UpsertGameCheckpointParams) (GameCheckpoint, error) {
+	row := q.db.QueryRowContext(ctx, upsertGameCheckpoint,
+		arg.GameID,
+		arg.Depth,
+		arg.CreatedAt,
+		arg.Score,
+		arg.Data,
+	)
+	var i GameCheckpoint
+	err := row.Scan(
+		&i.GameID,
+		&i.Depth,
+		&i.CreatedAt,
+		&i.Score,
+		&i.Data,
+	)
+	return i, err
+}
+
+const useLinkCode = `-- name: UseLinkCode :one
+UPDATE link_code
+SET used_at = ?
+WHERE code = ? AND used_at IS NULL AND invalid_after > ?
+RETURNING code, created_at, invalid_after, used_at, user_id
+`
+
+type UseLinkCodeParams struct {
+	UsedAt       sql.NullTime
+	Code         string
+	InvalidAfter time.Time
+}
+
+// Marks the code as used, if it is still valid
+func (q *Queries) UseLinkCode(ctx context.Context, arg UseLinkCodeParams) (LinkCode, error) {
+	row := q.db.QueryRowContext(ctx, useLinkCode, arg.UsedAt, arg.Code, arg.InvalidAfter)
+	var i LinkCode
+	err := row.Scan(
+		&i.Code,
+		&i.CreatedAt,
+		&i.InvalidAfter,
+		&i.UsedAt,
+		&i.UserID,
 	)
 	return i, err
 }
@@ -18,6 +18,18 @@ create table if not exists rule
     no_reswipe        BOOLEAN          not null,
     no_multiply       BOOLEAN          not null,
     no_addition       BOOLEAN          not null,
+    no_super_powers   BOOLEAN          not null default false,
+    -- The number of times cells can be swapped. Swaps are not allowed if zero
+    max_swaps         int              not null default 0,
+    swap_neighbours_only BOOLEAN       not null default false,
+    -- The number of super-powers that can be used. Super-powers are not allowed if zero
+    max_powers        int              not null default 0,
+    -- Evaluation-methods in addition to addition and multiplication
+    with_subtraction  BOOLEAN          not null default false,
+    with_division     BOOLEAN          not null default false,
+    with_mixed_operators BOOLEAN       not null default false,
+    -- Decides which cells are neighbours. 0: orthogonal, 1: eight-way, 2: toroidal
+    topology          int              not null default 0,
     primary key (id),
     constraint my_uniq_id
         unique (slug, description)
@@ -54,6 +66,8 @@ create table if not exists user
     updated_at     datetime,
     username       varchar(21) not null,
     active_game_id varchar(21) not null,
+    -- Only set for registered users. Guest-users are only reachable from their sessions.
+    password_hash  varchar(200),
     primary key (id),
     foreign key (active_game_id) references game
 );
@@ -66,9 +80,37 @@ create table if not exists session
     updated_at    datetime,
     invalid_after datetime     not null,
     user_id       varchar(21) not null,
+    -- Set when the session has expired, to the session that the user was moved to
+    replaced_by   varchar(21),
     primary key (id),
     foreign key (user_id) references user
 );
+-- One-time codes used to attach a new session to an existing user,
+-- for instance when the user switches to a different device.
+create table if not exists link_code
+(
+    code          varchar(21) not null,
+    created_at    datetime     not null,
+    invalid_after datetime     not null,
+    used_at       datetime,
+    user_id       varchar(21) not null,
+    primary key (code),
+    foreign key (user_id) references user
+);
+-- Snapshots of games, so that undo can replay the history from the latest
+-- checkpoint, instead of from the start of the game.
+create table if not exists game_checkpoint
+(
+    game_id    varchar(21) not null,
+    -- The number of moves in effect (not undone) when the snapshot was taken
+    depth      int         not null,
+    created_at datetime    not null,
+    score      int         not null,
+    -- The cells and the state of the randomizer, like game.data
+    data       blob        not null,
+    primary key (game_id, depth),
+    foreign key (game_id) references game
+);
 create table if not exists game_template
 (
     id            varchar(21) not null,
@@ -83,19 +125,46 @@ create table if not exists game_template
     ideal_moves INT,
     ideal_score INT,
     data       blob  not null,
+    -- Set for the daily challenges, formatted as YYYY-MM-DD
+    daily_date varchar(10),
     UNIQUE(challenge_number),
+    UNIQUE(daily_date),
     primary key (id),
     foreign key (rule_id) references rule,
     foreign key (created_by) references user,
     foreign key (updated_by) references user
 );
 
+create table if not exists vote
+(
+    id            varchar(21) not null,
+    created_at    datetime     not null,
+    updated_at    datetime,
+    user_id       varchar(21) not null,
+    game_id       varchar(21),
+    template_id   varchar(21),
+    fun_vote      INT          not null,
+    primary key (id),
+    foreign key (user_id) references user,
+    foreign key (game_id) references game,
+    foreign key (template_id) references game_template
+);
+
 
 create unique index if not exists active_game_id
     on user (active_game_id);
 
+-- Guest-users may share the generated names, but registered users must be unique
+create unique index if not exists user_username_registered
+    on user (username) where password_hash is not null;
+
 create unique index if not exists slug
     on rule (slug);
 create unique index if not exists game_template_challenge_number
     on game_template (challenge_number);
-
+create unique index if not exists game_template_daily_date
+    on game_template (daily_date);
+create unique index if not exists vote_user_template
+    on vote (user_id, template_id);
+create unique index if not exists vote_user_game
+    on vote (user_id, game_id);
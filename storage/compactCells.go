@@ -15,7 +15,11 @@ import (
 )
 
 // Packs the cells into a structure slice where the first half
-// is for the cells base-value, while the last half is for their 'twoPow'-value
+// is for the cells base-value, while the last half is for their 'twoPow'-value.
+// Blocked cells are packed with their base-value, cell.BlockedBaseValue
+//
+// Both values are stored as-is, so any int64 base-value and 'twoPow'-value
+// round-trip through UnpackCells, and there is no limit on the number of cells.
 func PackCells(cells []cell.Cell) []int64 {
 	length := len(cells)
 	n := make([]int64, length*2)
@@ -66,6 +70,7 @@ func UnpackCells(m []int64) []cell.Cell {
 	for i := 0; i < cellCount; i++ {
 		base := m[i]
 		twoPow := m[i+cellCount]
+		// Blocked cells are unpacked as they were packed, see cell.NewBlockedCell
 		cells[i] = cell.NewCell(base, int(twoPow))
 	}
 	return cells
@@ -111,13 +116,17 @@ func UnmarshalInternalDataGame(ctx context.Context, b []byte) (cells []cell.Cell
 	_, span := tracerMysql.Start(ctx, "UnmarshalInternalDataGame")
 	defer span.End()
 	var j protomodel.InternalDataGame
-	err = unmarshalCompressedProto(b, &j)
+	err = unmarshalCompressedProto(b, &j, 0)
 	if err != nil {
 		return []cell.Cell{}, 0, 0, err
 	}
 	return UnpackCells(j.Cells), j.Seed, j.State, nil
 }
-func unmarshalCompressedProto(b []byte, j proto.Message) error {
+
+// Decompresses and unmarshals b into j. If maxSize is above 0, payloads that
+// decompress to more than maxSize bytes are rejected, so that small untrusted
+// payloads cannot expand into huge ones.
+func unmarshalCompressedProto(b []byte, j proto.Message, maxSize int64) error {
 	rb := bytes.NewReader(b)
 	r := bytes.Buffer{}
 	z, err := zlib.NewReader(rb)
@@ -125,13 +134,17 @@ func unmarshalCompressedProto(b []byte, j proto.Message) error {
 		return fmt.Errorf("failed to create zlib-reader: %w", err)
 	}
 	defer z.Close()
-	_, err = io.Copy(&r, z)
-	if err != nil {
-		return fmt.Errorf("failed to copy zlib-reader: %w", err)
+	var zr io.Reader = z
+	if maxSize > 0 {
+		zr = io.LimitReader(z, maxSize+1)
 	}
+	n, err := io.Copy(&r, zr)
 	if err != nil {
 		return fmt.Errorf("failed to read with zlib: %w", err)
 	}
+	if maxSize > 0 && n > maxSize {
+		return fmt.Errorf("decompressed size exceeds %d bytes", maxSize)
+	}
 	zb := r.Bytes()
 
 	err = proto.Unmarshal(zb, j)
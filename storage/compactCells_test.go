@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"math"
 	"math/rand"
 	"reflect"
 	"testing"
@@ -9,6 +10,52 @@ import (
 	"github.com/runar-rkmedia/gotally/tallylogic/cell"
 )
 
+func TestPackCells(t *testing.T) {
+	cells := []cell.Cell{
+		cell.NewCell(3, 4),
+		cell.NewBlockedCell(),
+		cell.NewEmptyCell(),
+		cell.NewCell(1, 0),
+	}
+	unpacked := UnpackCells(PackCells(cells))
+	if !reflect.DeepEqual(unpacked, cells) {
+		t.Errorf("UnpackCells() = %v, want %v", unpacked, cells)
+	}
+	if !unpacked[1].IsBlocked() {
+		t.Errorf("UnpackCells() should keep blocked cells, got %v", unpacked[1])
+	}
+}
+
+func TestPackCells_RoundTrip(t *testing.T) {
+	extremes := []int64{math.MinInt64, cell.BlockedBaseValue, 0, 1, math.MaxInt32 + 1, math.MaxInt64}
+	rnd := rand.New(rand.NewSource(1008))
+	for _, size := range [][2]int{{1, 1}, {3, 3}, {5, 5}, {16, 16}, {23, 23}, {255, 255}} {
+		count := size[0] * size[1]
+		cells := make([]cell.Cell, count)
+		for i := range cells {
+			base, twoPow := rnd.Int63()-rnd.Int63(), rnd.Int63()-rnd.Int63()
+			if i < len(extremes) {
+				base, twoPow = extremes[i], extremes[len(extremes)-1-i]
+			}
+			cells[i] = cell.NewCell(base, int(twoPow))
+		}
+		if unpacked := UnpackCells(PackCells(cells)); !reflect.DeepEqual(unpacked, cells) {
+			t.Errorf("UnpackCells() did not round-trip for %dx%d", size[0], size[1])
+		}
+		b, err := MarshalInternalDataGame(context.TODO(), 1, 2, cells)
+		if err != nil {
+			t.Fatalf("MarshalInternalDataGame() error = %v", err)
+		}
+		unmarshalled, _, _, err := UnmarshalInternalDataGame(context.TODO(), b)
+		if err != nil {
+			t.Fatalf("UnmarshalInternalDataGame() error = %v", err)
+		}
+		if !reflect.DeepEqual(unmarshalled, cells) {
+			t.Errorf("UnmarshalInternalDataGame() did not round-trip for %dx%d", size[0], size[1])
+		}
+	}
+}
+
 func TestMarshalCells(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,1292 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/runar-rkmedia/go-common/logger"
+	"github.com/runar-rkmedia/gotally/sqlite"
+)
+
+// Returns a storage that is kept in memory, for tests and ephemeral servers.
+// Only the queries are reimplemented, so that the storage behaves exactly like
+// the sqlite-storage, for instance in how rules are deduplicated and how
+// play-states are set.
+func NewMemoryStorage(l logger.AppLogger) (*sqliteStorage, error) {
+	db := newMemoryDB()
+	p := &sqliteStorage{
+		ruleCache: newRuleCacheSqlite(),
+		queries:   memoryQuerier{db: db},
+		begin:     db.begin,
+	}
+	return p, p.prefetchRules(l)
+}
+
+type memoryDB struct {
+	// Held for the duration of a query, or for the whole transaction.
+	// Queries outside of the transaction must therefore not be issued while it
+	// is open, as that would deadlock.
+	mu sync.Mutex
+	// Incremented for each inserted row, so that rows are listed in the order
+	// they were inserted, like sqlite does
+	seq   int64
+	games memoryTable[sqlite.Game]
+	// Keyed by gameCheckpointKey
+	checkpoints memoryTable[sqlite.GameCheckpoint]
+	rules       memoryTable[sqlite.Rule]
+	users       memoryTable[sqlite.User]
+	sessions    memoryTable[sqlite.Session]
+	linkCodes   memoryTable[sqlite.LinkCode]
+	templates   memoryTable[sqlite.GameTemplate]
+	votes       memoryTable[sqlite.Vote]
+}
+
+func newMemoryDB() *memoryDB {
+	return &memoryDB{
+		games:       memoryTable[sqlite.Game]{},
+		checkpoints: memoryTable[sqlite.GameCheckpoint]{},
+		rules:       memoryTable[sqlite.Rule]{},
+		users:       memoryTable[sqlite.User]{},
+		sessions:    memoryTable[sqlite.Session]{},
+		linkCodes:   memoryTable[sqlite.LinkCode]{},
+		templates:   memoryTable[sqlite.GameTemplate]{},
+		votes:       memoryTable[sqlite.Vote]{},
+	}
+}
+
+func (db *memoryDB) begin(ctx context.Context) (sqlite.Querier, transaction, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	db.mu.Lock()
+	tx := &memoryTx{db: db}
+	return memoryQuerier{db: db, tx: tx}, tx, nil
+}
+
+// Changes are applied directly, and undone if the transaction is rolled back.
+type memoryTx struct {
+	db   *memoryDB
+	undo []func()
+	done bool
+}
+
+func (tx *memoryTx) Commit() error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	tx.done = true
+	tx.undo = nil
+	tx.db.mu.Unlock()
+	return nil
+}
+
+func (tx *memoryTx) Rollback() error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+	tx.done = true
+	tx.undo = nil
+	tx.db.mu.Unlock()
+	return nil
+}
+
+type memoryRow[T any] struct {
+	seq int64
+	row T
+}
+
+// Rows by their primary key
+type memoryTable[T any] map[string]memoryRow[T]
+
+func (t memoryTable[T]) get(id string) (T, bool) {
+	r, ok := t[id]
+	return r.row, ok
+}
+
+// Returns the rows in the order they were inserted
+func (t memoryTable[T]) list() []T {
+	rows := make([]memoryRow[T], 0, len(t))
+	for _, r := range t {
+		rows = append(rows, r)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].seq < rows[j].seq })
+	list := make([]T, len(rows))
+	for i, r := range rows {
+		list[i] = r.row
+	}
+	return list
+}
+
+// Implements sqlite.Querier, with the same semantics as the queries in query.sql
+type memoryQuerier struct {
+	db *memoryDB
+	// nil outside of transactions
+	tx *memoryTx
+}
+
+var _ sqlite.Querier = memoryQuerier{}
+
+// Locks the database, unless the querier is within a transaction, which holds
+// the lock already
+func (q memoryQuerier) lock() (unlock func(), err error) {
+	if q.tx != nil {
+		if q.tx.done {
+			return nil, sql.ErrTxDone
+		}
+		return func() {}, nil
+	}
+	q.db.mu.Lock()
+	return q.db.mu.Unlock, nil
+}
+
+func (q memoryQuerier) onRollback(f func()) {
+	if q.tx != nil {
+		q.tx.undo = append(q.tx.undo, f)
+	}
+}
+
+func insertRow[T any](q memoryQuerier, t memoryTable[T], id string, row T) {
+	q.db.seq++
+	t[id] = memoryRow[T]{q.db.seq, row}
+	q.onRollback(func() { delete(t, id) })
+}
+
+func updateRow[T any](q memoryQuerier, t memoryTable[T], id string, row T) {
+	prev := t[id]
+	t[id] = memoryRow[T]{prev.seq, row}
+	q.onRollback(func() { t[id] = prev })
+}
+
+func deleteRow[T any](q memoryQuerier, t memoryTable[T], id string) {
+	prev := t[id]
+	delete(t, id)
+	q.onRollback(func() { t[id] = prev })
+}
+
+func errMemoryUnique(columns string) error {
+	return fmt.Errorf("UNIQUE constraint failed: %s", columns)
+}
+
+func errMemoryPrimaryKey(table, id string) error {
+	return fmt.Errorf("UNIQUE constraint failed: %s.id (%s)", table, id)
+}
+
+// The slices are copied in and out of the database, so that callers cannot
+// change the stored rows, just like with sqlite.
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
+func cloneGame(g sqlite.Game) sqlite.Game {
+	g.Data = cloneBytes(g.Data)
+	g.DataAtStart = cloneBytes(g.DataAtStart)
+	g.History = cloneBytes(g.History)
+	return g
+}
+func cloneTemplate(t sqlite.GameTemplate) sqlite.GameTemplate {
+	t.Data = cloneBytes(t.Data)
+	return t
+}
+func cloneGameCheckpoint(c sqlite.GameCheckpoint) sqlite.GameCheckpoint {
+	c.Data = cloneBytes(c.Data)
+	return c
+}
+
+// The primary key of game_checkpoint is the game-id and the depth
+func gameCheckpointKey(gameID string, depth int64) string {
+	return gameID + "/" + strconv.FormatInt(depth, 10)
+}
+
+func (q memoryQuerier) hasActiveGameID(activeGameID, exceptUserID string) bool {
+	for id, u := range q.db.users {
+		if id != exceptUserID && u.row.ActiveGameID == activeGameID {
+			return true
+		}
+	}
+	return false
+}
+
+func (q memoryQuerier) InsertUser(ctx context.Context, arg sqlite.InsertUserParams) (sqlite.User, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.User{}, err
+	}
+	defer unlock()
+	if _, ok := q.db.users[arg.ID]; ok {
+		return sqlite.User{}, errMemoryPrimaryKey("user", arg.ID)
+	}
+	if q.hasActiveGameID(arg.ActiveGameID, "") {
+		return sqlite.User{}, errMemoryUnique("user.active_game_id")
+	}
+	u := sqlite.User{
+		ID:           arg.ID,
+		CreatedAt:    arg.CreatedAt,
+		UpdatedAt:    arg.UpdatedAt,
+		Username:     arg.Username,
+		ActiveGameID: arg.ActiveGameID,
+	}
+	insertRow(q, q.db.users, u.ID, u)
+	return u, nil
+}
+func (q memoryQuerier) InsertSession(ctx context.Context, arg sqlite.InsertSessionParams) (sqlite.Session, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Session{}, err
+	}
+	defer unlock()
+	if _, ok := q.db.sessions[arg.ID]; ok {
+		return sqlite.Session{}, errMemoryPrimaryKey("session", arg.ID)
+	}
+	s := sqlite.Session{
+		ID:           arg.ID,
+		CreatedAt:    arg.CreatedAt,
+		UpdatedAt:    arg.UpdatedAt,
+		InvalidAfter: arg.InvalidAfter,
+		UserID:       arg.UserID,
+	}
+	insertRow(q, q.db.sessions, s.ID, s)
+	return s, nil
+}
+func (q memoryQuerier) InsertLinkCode(ctx context.Context, arg sqlite.InsertLinkCodeParams) (sqlite.LinkCode, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.LinkCode{}, err
+	}
+	defer unlock()
+	if _, ok := q.db.linkCodes[arg.Code]; ok {
+		return sqlite.LinkCode{}, errMemoryUnique("link_code.code")
+	}
+	c := sqlite.LinkCode{
+		Code:         arg.Code,
+		CreatedAt:    arg.CreatedAt,
+		InvalidAfter: arg.InvalidAfter,
+		UserID:       arg.UserID,
+	}
+	insertRow(q, q.db.linkCodes, c.Code, c)
+	return c, nil
+}
+func (q memoryQuerier) InsertGame(ctx context.Context, arg sqlite.InsertGameParams) (sqlite.Game, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Game{}, err
+	}
+	defer unlock()
+	if _, ok := q.db.games[arg.ID]; ok {
+		return sqlite.Game{}, errMemoryPrimaryKey("game", arg.ID)
+	}
+	g := sqlite.Game{
+		ID:          arg.ID,
+		CreatedAt:   arg.CreatedAt,
+		UpdatedAt:   arg.UpdatedAt,
+		Name:        arg.Name,
+		Description: arg.Description,
+		UserID:      arg.UserID,
+		RuleID:      arg.RuleID,
+		BasedOnGame: arg.BasedOnGame,
+		TemplateID:  arg.TemplateID,
+		Score:       arg.Score,
+		Moves:       arg.Moves,
+		PlayState:   arg.PlayState,
+		Data:        arg.Data,
+		DataAtStart: arg.DataAtStart,
+		History:     arg.History,
+	}
+	insertRow(q, q.db.games, g.ID, cloneGame(g))
+	return cloneGame(g), nil
+}
+func (q memoryQuerier) InsertRule(ctx context.Context, arg sqlite.InsertRuleParams) (sqlite.Rule, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Rule{}, err
+	}
+	defer unlock()
+	if _, ok := q.db.rules[arg.ID]; ok {
+		return sqlite.Rule{}, errMemoryPrimaryKey("rule", arg.ID)
+	}
+	for _, r := range q.db.rules {
+		if r.row.Slug == arg.Slug {
+			return sqlite.Rule{}, errMemoryUnique("rule.slug")
+		}
+	}
+	r := sqlite.Rule{
+		ID:                 arg.ID,
+		Slug:               arg.Slug,
+		CreatedAt:          arg.CreatedAt,
+		UpdatedAt:          arg.UpdatedAt,
+		Mode:               arg.Mode,
+		Description:        arg.Description,
+		SizeX:              arg.SizeX,
+		SizeY:              arg.SizeY,
+		MaxMoves:           arg.MaxMoves,
+		TargetCellValue:    arg.TargetCellValue,
+		TargetScore:        arg.TargetScore,
+		RecreateOnSwipe:    arg.RecreateOnSwipe,
+		NoReswipe:          arg.NoReswipe,
+		NoMultiply:         arg.NoMultiply,
+		NoAddition:         arg.NoAddition,
+		NoSuperPowers:      arg.NoSuperPowers,
+		MaxSwaps:           arg.MaxSwaps,
+		SwapNeighboursOnly: arg.SwapNeighboursOnly,
+		MaxPowers:          arg.MaxPowers,
+		WithSubtraction:    arg.WithSubtraction,
+		WithDivision:       arg.WithDivision,
+		WithMixedOperators: arg.WithMixedOperators,
+		Topology:           arg.Topology,
+	}
+	insertRow(q, q.db.rules, r.ID, r)
+	return r, nil
+}
+func (q memoryQuerier) InserTemplate(ctx context.Context, arg sqlite.InserTemplateParams) (sqlite.GameTemplate, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.GameTemplate{}, err
+	}
+	defer unlock()
+	if _, ok := q.db.templates[arg.ID]; ok {
+		return sqlite.GameTemplate{}, errMemoryPrimaryKey("game_template", arg.ID)
+	}
+	if arg.ChallengeNumber.Valid {
+		for _, t := range q.db.templates {
+			if t.row.ChallengeNumber == arg.ChallengeNumber {
+				return sqlite.GameTemplate{}, errMemoryUnique("game_template.challenge_number")
+			}
+		}
+	}
+	if arg.DailyDate.Valid {
+		for _, t := range q.db.templates {
+			if t.row.DailyDate == arg.DailyDate {
+				return sqlite.GameTemplate{}, errMemoryUnique("game_template.daily_date")
+			}
+		}
+	}
+	t := sqlite.GameTemplate{
+		ID:              arg.ID,
+		CreatedAt:       arg.CreatedAt,
+		RuleID:          arg.RuleID,
+		CreatedBy:       arg.CreatedBy,
+		Name:            arg.Name,
+		Description:     arg.Description,
+		ChallengeNumber: arg.ChallengeNumber,
+		IdealMoves:      arg.IdealMoves,
+		IdealScore:      arg.IdealScore,
+		Data:            arg.Data,
+		DailyDate:       arg.DailyDate,
+	}
+	insertRow(q, q.db.templates, t.ID, cloneTemplate(t))
+	return cloneTemplate(t), nil
+}
+func (q memoryQuerier) InsertVote(ctx context.Context, arg sqlite.InsertVoteParams) (sqlite.Vote, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Vote{}, err
+	}
+	defer unlock()
+	if _, ok := q.db.votes[arg.ID]; ok {
+		return sqlite.Vote{}, errMemoryPrimaryKey("vote", arg.ID)
+	}
+	for _, v := range q.db.votes {
+		if v.row.UserID != arg.UserID {
+			continue
+		}
+		// Nulls are distinct in unique indexes
+		if arg.TemplateID.Valid && v.row.TemplateID == arg.TemplateID {
+			return sqlite.Vote{}, errMemoryUnique("vote.user_id, vote.template_id")
+		}
+		if arg.GameID.Valid && v.row.GameID == arg.GameID {
+			return sqlite.Vote{}, errMemoryUnique("vote.user_id, vote.game_id")
+		}
+	}
+	v := sqlite.Vote{
+		ID:         arg.ID,
+		CreatedAt:  arg.CreatedAt,
+		UserID:     arg.UserID,
+		GameID:     arg.GameID,
+		TemplateID: arg.TemplateID,
+		FunVote:    arg.FunVote,
+	}
+	insertRow(q, q.db.votes, v.ID, v)
+	return v, nil
+}
+
+func (q memoryQuerier) GetGameTemplate(ctx context.Context, id string) (sqlite.GameTemplate, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.GameTemplate{}, err
+	}
+	defer unlock()
+	t, ok := q.db.templates.get(id)
+	if !ok {
+		return sqlite.GameTemplate{}, sql.ErrNoRows
+	}
+	return cloneTemplate(t), nil
+}
+func (q memoryQuerier) GetGameTemplateByChallengeNumber(ctx context.Context, challengeNumber sql.NullInt64) (sqlite.GameTemplate, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.GameTemplate{}, err
+	}
+	defer unlock()
+	if challengeNumber.Valid {
+		for _, t := range q.db.templates.list() {
+			if t.ChallengeNumber == challengeNumber {
+				return cloneTemplate(t), nil
+			}
+		}
+	}
+	return sqlite.GameTemplate{}, sql.ErrNoRows
+}
+func (q memoryQuerier) GetGameTemplateByDailyDate(ctx context.Context, dailyDate sql.NullString) (sqlite.GameTemplate, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.GameTemplate{}, err
+	}
+	defer unlock()
+	if dailyDate.Valid {
+		for _, t := range q.db.templates.list() {
+			if t.DailyDate == dailyDate {
+				return cloneTemplate(t), nil
+			}
+		}
+	}
+	return sqlite.GameTemplate{}, sql.ErrNoRows
+}
+func (q memoryQuerier) GetGameChallengesTemplates(ctx context.Context) ([]sqlite.GameTemplate, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	var items []sqlite.GameTemplate
+	for _, t := range q.db.templates.list() {
+		if t.ChallengeNumber.Valid {
+			items = append(items, cloneTemplate(t))
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].ChallengeNumber.Int64 < items[j].ChallengeNumber.Int64
+	})
+	return items, nil
+}
+func (q memoryQuerier) GetDailyTemplates(ctx context.Context) ([]sqlite.GameTemplate, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	var items []sqlite.GameTemplate
+	for _, t := range q.db.templates.list() {
+		if t.DailyDate.Valid {
+			items = append(items, cloneTemplate(t))
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DailyDate.String > items[j].DailyDate.String
+	})
+	return items, nil
+}
+func (q memoryQuerier) GetChallengeStatsForUser(ctx context.Context, userID string) ([]sqlite.GetChallengeStatsForUserRow, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	var items []sqlite.GetChallengeStatsForUserRow
+	for _, g := range q.db.games.list() {
+		if !g.TemplateID.Valid || g.UserID != userID {
+			continue
+		}
+		u, ok := q.db.users.get(g.UserID)
+		if !ok {
+			continue
+		}
+		items = append(items, sqlite.GetChallengeStatsForUserRow{
+			GameID:     g.ID,
+			TemplateID: g.TemplateID,
+			Score:      g.Score,
+			Moves:      g.Moves,
+			UserID:     u.ID,
+			Username:   u.Username,
+		})
+	}
+	return items, nil
+}
+func (q memoryQuerier) GetAttemptsForTemplate(ctx context.Context, templateID sql.NullString) ([]sqlite.GetAttemptsForTemplateRow, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return q.attemptsForTemplate(templateID), nil
+}
+func (q memoryQuerier) attemptsForTemplate(templateID sql.NullString) []sqlite.GetAttemptsForTemplateRow {
+	var items []sqlite.GetAttemptsForTemplateRow
+	if !templateID.Valid {
+		return items
+	}
+	for _, g := range q.db.games.list() {
+		if g.TemplateID != templateID {
+			continue
+		}
+		u, ok := q.db.users.get(g.UserID)
+		if !ok {
+			continue
+		}
+		items = append(items, sqlite.GetAttemptsForTemplateRow{
+			GameID:    g.ID,
+			Score:     g.Score,
+			Moves:     g.Moves,
+			PlayState: g.PlayState,
+			CreatedAt: g.CreatedAt,
+			UpdatedAt: g.UpdatedAt,
+			UserID:    u.ID,
+			Username:  u.Username,
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.GameID < b.GameID
+	})
+	return items
+}
+
+// The ranked best attempt of each user, as in the GetChallengeLeaderboard-query
+func (q memoryQuerier) challengeLeaderboard(templateID sql.NullString, firstAttemptOnly bool) []sqlite.GetChallengeLeaderboardRow {
+	seen := map[string]struct{}{}
+	var items []sqlite.GetChallengeLeaderboardRow
+	for _, a := range q.attemptsForTemplate(templateID) {
+		if firstAttemptOnly {
+			if _, ok := seen[a.UserID]; ok {
+				continue
+			}
+			seen[a.UserID] = struct{}{}
+		}
+		if a.PlayState != PlayStateWon {
+			continue
+		}
+		items = append(items, sqlite.GetChallengeLeaderboardRow{
+			GameID:    a.GameID,
+			Score:     a.Score,
+			Moves:     a.Moves,
+			CreatedAt: a.CreatedAt,
+			UpdatedAt: a.UpdatedAt,
+			UserID:    a.UserID,
+			Username:  a.Username,
+		})
+	}
+	wonAt := func(r sqlite.GetChallengeLeaderboardRow) time.Time {
+		if r.UpdatedAt.Valid {
+			return r.UpdatedAt.Time
+		}
+		return r.CreatedAt
+	}
+	less := func(a, b sqlite.GetChallengeLeaderboardRow) bool {
+		if a.Moves != b.Moves {
+			return a.Moves < b.Moves
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return wonAt(a).Before(wonAt(b))
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return less(items[i], items[j])
+	})
+	best := map[string]struct{}{}
+	ranked := items[:0]
+	for _, r := range items {
+		if _, ok := best[r.UserID]; ok {
+			continue
+		}
+		best[r.UserID] = struct{}{}
+		r.Rank = int64(len(ranked) + 1)
+		if len(ranked) > 0 {
+			prev := ranked[len(ranked)-1]
+			if !less(prev, r) {
+				r.Rank = prev.Rank
+			}
+		}
+		ranked = append(ranked, r)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Rank != ranked[j].Rank {
+			return ranked[i].Rank < ranked[j].Rank
+		}
+		return ranked[i].UserID < ranked[j].UserID
+	})
+	return ranked
+}
+func (q memoryQuerier) GetChallengeLeaderboard(ctx context.Context, arg sqlite.GetChallengeLeaderboardParams) ([]sqlite.GetChallengeLeaderboardRow, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	items := q.challengeLeaderboard(arg.TemplateID, arg.FirstAttemptOnly)
+	if arg.RowOffset >= int64(len(items)) {
+		return nil, nil
+	}
+	items = items[arg.RowOffset:]
+	if arg.RowLimit < int64(len(items)) {
+		items = items[:arg.RowLimit]
+	}
+	return items, nil
+}
+func (q memoryQuerier) GetChallengeLeaderboardForUser(ctx context.Context, arg sqlite.GetChallengeLeaderboardForUserParams) (sqlite.GetChallengeLeaderboardForUserRow, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.GetChallengeLeaderboardForUserRow{}, err
+	}
+	defer unlock()
+	for _, r := range q.challengeLeaderboard(arg.TemplateID, arg.FirstAttemptOnly) {
+		if r.UserID == arg.UserID {
+			return sqlite.GetChallengeLeaderboardForUserRow(r), nil
+		}
+	}
+	return sqlite.GetChallengeLeaderboardForUserRow{}, sql.ErrNoRows
+}
+func (q memoryQuerier) CountChallengeLeaderboard(ctx context.Context, arg sqlite.CountChallengeLeaderboardParams) (int64, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	return int64(len(q.challengeLeaderboard(arg.TemplateID, arg.FirstAttemptOnly))), nil
+}
+func (q memoryQuerier) GetVoteForTemplateByUser(ctx context.Context, arg sqlite.GetVoteForTemplateByUserParams) (sqlite.Vote, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Vote{}, err
+	}
+	defer unlock()
+	if arg.TemplateID.Valid {
+		for _, v := range q.db.votes.list() {
+			if v.UserID == arg.UserID && v.TemplateID == arg.TemplateID {
+				return v, nil
+			}
+		}
+	}
+	return sqlite.Vote{}, sql.ErrNoRows
+}
+func (q memoryQuerier) GetVoteForGameByUser(ctx context.Context, arg sqlite.GetVoteForGameByUserParams) (sqlite.Vote, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Vote{}, err
+	}
+	defer unlock()
+	if arg.GameID.Valid {
+		for _, v := range q.db.votes.list() {
+			if v.UserID == arg.UserID && v.GameID == arg.GameID {
+				return v, nil
+			}
+		}
+	}
+	return sqlite.Vote{}, sql.ErrNoRows
+}
+func (q memoryQuerier) GetVoteStatsForTemplates(ctx context.Context) ([]sqlite.GetVoteStatsForTemplatesRow, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	byTemplate := map[string]*sqlite.GetVoteStatsForTemplatesRow{}
+	var items []*sqlite.GetVoteStatsForTemplatesRow
+	for _, v := range q.db.votes.list() {
+		if !v.TemplateID.Valid {
+			continue
+		}
+		row, ok := byTemplate[v.TemplateID.String]
+		if !ok {
+			row = &sqlite.GetVoteStatsForTemplatesRow{TemplateID: v.TemplateID, FunVoteTotal: int64(0)}
+			byTemplate[v.TemplateID.String] = row
+			items = append(items, row)
+		}
+		row.Votes++
+		row.FunVoteTotal = row.FunVoteTotal.(int64) + v.FunVote
+	}
+	// Grouping sorts the rows in sqlite
+	sort.Slice(items, func(i, j int) bool { return items[i].TemplateID.String < items[j].TemplateID.String })
+	rows := make([]sqlite.GetVoteStatsForTemplatesRow, len(items))
+	for i, r := range items {
+		rows[i] = *r
+	}
+	return rows, nil
+}
+func (q memoryQuerier) GetRule(ctx context.Context, arg sqlite.GetRuleParams) (sqlite.Rule, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Rule{}, err
+	}
+	defer unlock()
+	for _, r := range q.db.rules.list() {
+		if r.ID == arg.ID || r.Slug == arg.Slug {
+			return r, nil
+		}
+	}
+	return sqlite.Rule{}, sql.ErrNoRows
+}
+func (q memoryQuerier) GetGame(ctx context.Context, id string) (sqlite.Game, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Game{}, err
+	}
+	defer unlock()
+	g, ok := q.db.games.get(id)
+	if !ok {
+		return sqlite.Game{}, sql.ErrNoRows
+	}
+	return cloneGame(g), nil
+}
+func (q memoryQuerier) GetGameCheckpoint(ctx context.Context, arg sqlite.GetGameCheckpointParams) (sqlite.GameCheckpoint, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.GameCheckpoint{}, err
+	}
+	defer unlock()
+	var latest *sqlite.GameCheckpoint
+	for _, c := range q.db.checkpoints.list() {
+		if c.GameID != arg.GameID || c.Depth > arg.Depth {
+			continue
+		}
+		if latest == nil || c.Depth > latest.Depth {
+			c := c
+			latest = &c
+		}
+	}
+	if latest == nil {
+		return sqlite.GameCheckpoint{}, sql.ErrNoRows
+	}
+	return cloneGameCheckpoint(*latest), nil
+}
+func (q memoryQuerier) GetGamesForUser(ctx context.Context, userID string) ([]sqlite.Game, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	var items []sqlite.Game
+	for _, g := range q.db.games.list() {
+		if g.UserID == userID {
+			items = append(items, cloneGame(g))
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].CreatedAt.After(items[j].CreatedAt)
+	})
+	return items, nil
+}
+func (q memoryQuerier) GetOriginalGame(ctx context.Context, id string) (sqlite.Game, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Game{}, err
+	}
+	defer unlock()
+	g, ok := q.db.games.get(id)
+	if !ok || !g.BasedOnGame.Valid {
+		return sqlite.Game{}, sql.ErrNoRows
+	}
+	o, ok := q.db.games.get(g.BasedOnGame.String)
+	if !ok {
+		return sqlite.Game{}, sql.ErrNoRows
+	}
+	return cloneGame(o), nil
+}
+func (q memoryQuerier) GetUser(ctx context.Context, id string) (sqlite.User, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.User{}, err
+	}
+	defer unlock()
+	u, ok := q.db.users.get(id)
+	if !ok {
+		return sqlite.User{}, sql.ErrNoRows
+	}
+	return u, nil
+}
+func (q memoryQuerier) GetRegisteredUserByUsername(ctx context.Context, username string) (sqlite.User, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.User{}, err
+	}
+	defer unlock()
+	for _, u := range q.db.users.list() {
+		if u.Username == username && u.PasswordHash.Valid {
+			return u, nil
+		}
+	}
+	return sqlite.User{}, sql.ErrNoRows
+}
+func (q memoryQuerier) GetSession(ctx context.Context, id string) (sqlite.Session, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Session{}, err
+	}
+	defer unlock()
+	s, ok := q.db.sessions.get(id)
+	if !ok {
+		return sqlite.Session{}, sql.ErrNoRows
+	}
+	return s, nil
+}
+func (q memoryQuerier) GetUserBySessionID(ctx context.Context, id string) (sqlite.GetUserBySessionIDRow, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.GetUserBySessionIDRow{}, err
+	}
+	defer unlock()
+	s, ok := q.db.sessions.get(id)
+	if !ok {
+		return sqlite.GetUserBySessionIDRow{}, sql.ErrNoRows
+	}
+	u, ok := q.db.users.get(s.UserID)
+	if !ok {
+		return sqlite.GetUserBySessionIDRow{}, sql.ErrNoRows
+	}
+	g, ok := q.db.games.get(u.ActiveGameID)
+	if !ok {
+		return sqlite.GetUserBySessionIDRow{}, sql.ErrNoRows
+	}
+	return sqlite.GetUserBySessionIDRow{
+		ID:           s.ID,
+		CreatedAt:    s.CreatedAt,
+		InvalidAfter: s.InvalidAfter,
+		ID_2:         u.ID,
+		CreatedAt_2:  u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
+		Username:     u.Username,
+		PasswordHash: u.PasswordHash,
+		ID_3:         g.ID,
+		CreatedAt_3:  g.CreatedAt,
+		UpdatedAt_2:  g.UpdatedAt,
+		Description:  g.Description,
+		Name:         g.Name,
+		Data:         cloneBytes(g.Data),
+		History:      cloneBytes(g.History),
+		PlayState:    g.PlayState,
+		Score:        g.Score,
+		Moves:        g.Moves,
+		RuleID:       g.RuleID,
+	}, nil
+}
+
+func (q memoryQuerier) GetAllGames(ctx context.Context) ([]sqlite.Game, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	items := q.db.games.list()
+	for i := range items {
+		items[i] = cloneGame(items[i])
+	}
+	return items, nil
+}
+func (q memoryQuerier) GetAllRules(ctx context.Context) ([]sqlite.Rule, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return q.db.rules.list(), nil
+}
+func (q memoryQuerier) GetAllSessions(ctx context.Context) ([]sqlite.Session, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return q.db.sessions.list(), nil
+}
+func (q memoryQuerier) GetAllUsers(ctx context.Context) ([]sqlite.User, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return q.db.users.list(), nil
+}
+func (q memoryQuerier) GetAllTemplates(ctx context.Context) ([]sqlite.GameTemplate, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	items := q.db.templates.list()
+	for i := range items {
+		items[i] = cloneTemplate(items[i])
+	}
+	return items, nil
+}
+func (q memoryQuerier) GetAllVotes(ctx context.Context) ([]sqlite.Vote, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return q.db.votes.list(), nil
+}
+
+func (q memoryQuerier) UpdateGame(ctx context.Context, arg sqlite.UpdateGameParams) (sqlite.Game, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Game{}, err
+	}
+	defer unlock()
+	g, ok := q.db.games.get(arg.ID)
+	if !ok {
+		return sqlite.Game{}, sql.ErrNoRows
+	}
+	g.UpdatedAt = arg.UpdatedAt
+	g.UserID = arg.UserID
+	g.RuleID = arg.RuleID
+	g.Score = arg.Score
+	g.Moves = arg.Moves
+	g.PlayState = arg.PlayState
+	g.Data = cloneBytes(arg.Data)
+	g.History = cloneBytes(arg.History)
+	updateRow(q, q.db.games, g.ID, g)
+	return cloneGame(g), nil
+}
+func (q memoryQuerier) SetPlayStateForGame(ctx context.Context, arg sqlite.SetPlayStateForGameParams) (sqlite.Game, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Game{}, err
+	}
+	defer unlock()
+	g, ok := q.db.games.get(arg.ID)
+	if !ok {
+		return sqlite.Game{}, sql.ErrNoRows
+	}
+	g.UpdatedAt = arg.UpdatedAt
+	g.PlayState = arg.PlayState
+	updateRow(q, q.db.games, g.ID, g)
+	return cloneGame(g), nil
+}
+func (q memoryQuerier) UpdateUser(ctx context.Context, arg sqlite.UpdateUserParams) (sqlite.User, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.User{}, err
+	}
+	defer unlock()
+	u, ok := q.db.users.get(arg.ID)
+	if !ok {
+		return sqlite.User{}, sql.ErrNoRows
+	}
+	if q.hasActiveGameID(arg.ActiveGameID, u.ID) {
+		return sqlite.User{}, errMemoryUnique("user.active_game_id")
+	}
+	u.UpdatedAt = arg.UpdatedAt
+	u.Username = arg.Username
+	u.ActiveGameID = arg.ActiveGameID
+	updateRow(q, q.db.users, u.ID, u)
+	return u, nil
+}
+func (q memoryQuerier) RegisterUser(ctx context.Context, arg sqlite.RegisterUserParams) (sqlite.User, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.User{}, err
+	}
+	defer unlock()
+	u, ok := q.db.users.get(arg.ID)
+	if !ok || u.PasswordHash.Valid {
+		return sqlite.User{}, sql.ErrNoRows
+	}
+	if arg.PasswordHash.Valid {
+		for id, other := range q.db.users {
+			if id != u.ID && other.row.PasswordHash.Valid && other.row.Username == arg.Username {
+				return sqlite.User{}, errMemoryUnique("user.username")
+			}
+		}
+	}
+	u.UpdatedAt = arg.UpdatedAt
+	u.Username = arg.Username
+	u.PasswordHash = arg.PasswordHash
+	updateRow(q, q.db.users, u.ID, u)
+	return u, nil
+}
+func (q memoryQuerier) UseLinkCode(ctx context.Context, arg sqlite.UseLinkCodeParams) (sqlite.LinkCode, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.LinkCode{}, err
+	}
+	defer unlock()
+	c, ok := q.db.linkCodes.get(arg.Code)
+	if !ok || c.UsedAt.Valid || !c.InvalidAfter.After(arg.InvalidAfter) {
+		return sqlite.LinkCode{}, sql.ErrNoRows
+	}
+	c.UsedAt = arg.UsedAt
+	updateRow(q, q.db.linkCodes, c.Code, c)
+	return c, nil
+}
+func (q memoryQuerier) RefreshSession(ctx context.Context, arg sqlite.RefreshSessionParams) (sqlite.Session, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Session{}, err
+	}
+	defer unlock()
+	s, ok := q.db.sessions.get(arg.ID)
+	if !ok {
+		return sqlite.Session{}, sql.ErrNoRows
+	}
+	s.UpdatedAt = arg.UpdatedAt
+	s.InvalidAfter = arg.InvalidAfter
+	updateRow(q, q.db.sessions, s.ID, s)
+	return s, nil
+}
+func (q memoryQuerier) ReplaceSession(ctx context.Context, arg sqlite.ReplaceSessionParams) (sqlite.Session, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Session{}, err
+	}
+	defer unlock()
+	s, ok := q.db.sessions.get(arg.ID)
+	if !ok || s.ReplacedBy.Valid {
+		return sqlite.Session{}, sql.ErrNoRows
+	}
+	s.UpdatedAt = arg.UpdatedAt
+	s.ReplacedBy = arg.ReplacedBy
+	updateRow(q, q.db.sessions, s.ID, s)
+	return s, nil
+}
+func (q memoryQuerier) UpdateVote(ctx context.Context, arg sqlite.UpdateVoteParams) (sqlite.Vote, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.Vote{}, err
+	}
+	defer unlock()
+	v, ok := q.db.votes.get(arg.ID)
+	if !ok {
+		return sqlite.Vote{}, sql.ErrNoRows
+	}
+	v.UpdatedAt = arg.UpdatedAt
+	v.FunVote = arg.FunVote
+	updateRow(q, q.db.votes, v.ID, v)
+	return v, nil
+}
+func (q memoryQuerier) UpsertGameCheckpoint(ctx context.Context, arg sqlite.UpsertGameCheckpointParams) (sqlite.GameCheckpoint, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.GameCheckpoint{}, err
+	}
+	defer unlock()
+	c := cloneGameCheckpoint(sqlite.GameCheckpoint(arg))
+	key := gameCheckpointKey(c.GameID, c.Depth)
+	if _, ok := q.db.checkpoints[key]; ok {
+		updateRow(q, q.db.checkpoints, key, c)
+	} else {
+		insertRow(q, q.db.checkpoints, key, c)
+	}
+	return cloneGameCheckpoint(c), nil
+}
+func (q memoryQuerier) SetActiveGameFormUser(ctx context.Context, arg sqlite.SetActiveGameFormUserParams) (sqlite.User, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.User{}, err
+	}
+	defer unlock()
+	u, ok := q.db.users.get(arg.ID)
+	if !ok {
+		return sqlite.User{}, sql.ErrNoRows
+	}
+	if q.hasActiveGameID(arg.ActiveGameID, u.ID) {
+		return sqlite.User{}, errMemoryUnique("user.active_game_id")
+	}
+	u.UpdatedAt = arg.UpdatedAt
+	u.ActiveGameID = arg.ActiveGameID
+	updateRow(q, q.db.users, u.ID, u)
+	return u, nil
+}
+
+func (q memoryQuerier) DeleteExpiredSessions(ctx context.Context, invalidAfter time.Time) (int64, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	var n int64
+	for _, s := range q.db.sessions.list() {
+		if s.InvalidAfter.Before(invalidAfter) {
+			deleteRow(q, q.db.sessions, s.ID)
+			n++
+		}
+	}
+	return n, nil
+}
+func (q memoryQuerier) DeleteExpiredLinkCodes(ctx context.Context, invalidAfter time.Time) (int64, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	var n int64
+	for _, c := range q.db.linkCodes.list() {
+		if c.InvalidAfter.Before(invalidAfter) {
+			deleteRow(q, q.db.linkCodes, c.Code)
+			n++
+		}
+	}
+	return n, nil
+}
+func (q memoryQuerier) DeleteGameCheckpointsAfter(ctx context.Context, arg sqlite.DeleteGameCheckpointsAfterParams) (int64, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	var n int64
+	for _, c := range q.db.checkpoints.list() {
+		if c.GameID == arg.GameID && c.Depth > arg.Depth {
+			deleteRow(q, q.db.checkpoints, gameCheckpointKey(c.GameID, c.Depth))
+			n++
+		}
+	}
+	return n, nil
+}
+func (q memoryQuerier) DeleteGamesForOrphanedUsers(ctx context.Context) (int64, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	orphans := map[string]bool{}
+	for _, u := range q.db.users {
+		orphans[u.row.ID] = !u.row.PasswordHash.Valid
+	}
+	for _, s := range q.db.sessions {
+		orphans[s.row.UserID] = false
+	}
+	for _, g := range q.db.games {
+		if g.row.Moves > 0 {
+			orphans[g.row.UserID] = false
+		}
+	}
+	for _, t := range q.db.templates {
+		orphans[t.row.CreatedBy] = false
+		if t.row.UpdatedBy.Valid {
+			orphans[t.row.UpdatedBy.String] = false
+		}
+	}
+	for _, v := range q.db.votes {
+		orphans[v.row.UserID] = false
+	}
+	for _, c := range q.db.linkCodes {
+		orphans[c.row.UserID] = false
+	}
+	var n int64
+	for _, g := range q.db.games.list() {
+		if orphans[g.UserID] {
+			deleteRow(q, q.db.games, g.ID)
+			n++
+		}
+	}
+	return n, nil
+}
+func (q memoryQuerier) DeleteOrphanedUsers(ctx context.Context) (int64, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	inUse := map[string]bool{}
+	for _, s := range q.db.sessions {
+		inUse[s.row.UserID] = true
+	}
+	for _, g := range q.db.games {
+		inUse[g.row.UserID] = true
+	}
+	var n int64
+	for _, u := range q.db.users.list() {
+		if !u.PasswordHash.Valid && !inUse[u.ID] {
+			deleteRow(q, q.db.users, u.ID)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (q memoryQuerier) Stats(ctx context.Context, invalidAfter time.Time) (sqlite.StatsRow, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return sqlite.StatsRow{}, err
+	}
+	defer unlock()
+	// Aggregates in sqlite are NULL when there are no rows to aggregate
+	var (
+		stats                      sqlite.StatsRow
+		longestGame, highestScore  *int64
+		historyCount               int64
+		historySum, historySquares float64
+		historyMax, historyMin     *int64
+		historyTotal               int64
+	)
+	stats.Users = int64(len(q.db.users))
+	for _, s := range q.db.sessions {
+		if s.row.InvalidAfter.After(invalidAfter) {
+			stats.Session++
+		}
+	}
+	for _, r := range q.db.games {
+		g := r.row
+		stats.Games++
+		switch g.PlayState {
+		case PlayStateWon:
+			stats.GamesWon++
+		case PlayStateLost:
+			stats.GamesLost++
+		case PlayStateAbandoned:
+			stats.GamesAbandoned++
+		case PlayStateCurrent:
+			stats.GamesCurrent++
+			if longestGame == nil || g.Moves > *longestGame {
+				moves := g.Moves
+				longestGame = &moves
+			}
+			if highestScore == nil || g.Score > *highestScore {
+				score := g.Score
+				highestScore = &score
+			}
+		}
+		if g.History == nil {
+			continue
+		}
+		l := int64(len(g.History))
+		historyCount++
+		historySum += float64(l)
+		historySquares += float64(l * l)
+		historyTotal += l
+		if historyMax == nil || l > *historyMax {
+			historyMax = &l
+		}
+		if historyMin == nil || l < *historyMin {
+			min := l
+			historyMin = &min
+		}
+	}
+	if longestGame != nil {
+		stats.LongestGame = *longestGame
+	}
+	if highestScore != nil {
+		stats.HighestScore = *highestScore
+	}
+	if historyCount > 0 {
+		avg := historySum / float64(historyCount)
+		stats.HistoryAvg = sql.NullFloat64{Float64: avg, Valid: true}
+		stats.HistoryVariance = historySquares/float64(historyCount) - avg*avg
+		stats.HistoryMax = *historyMax
+		stats.HistoryMin = *historyMin
+	}
+	stats.HistoryTotal = historyTotal
+	return stats, nil
+}
@@ -54,21 +54,33 @@ func toTypeRule(rule sqlite.Rule) (types.Rules, error) {
 	if err != nil {
 		return types.Rules{}, err
 	}
+	topology, err := toTopology(rule.Topology)
+	if err != nil {
+		return types.Rules{}, err
+	}
 	return types.Rules{
-		ID:              rule.ID,
-		CreatedAt:       rule.CreatedAt,
-		UpdatedAt:       &rule.UpdatedAt.Time,
-		Description:     rule.Description.String,
-		Mode:            mode,
-		TargetCellValue: uint64(rule.TargetCellValue.Int64),
-		TargetScore:     uint64(rule.TargetScore.Int64),
-		MaxMoves:        uint64(rule.MaxMoves.Int64),
-		Rows:            uint8(rule.SizeY),
-		Columns:         uint8(rule.SizeX),
-		RecreateOnSwipe: rule.RecreateOnSwipe,
-		NoReSwipe:       rule.NoReswipe,
-		NoMultiply:      rule.NoMultiply,
-		NoAddition:      rule.NoAddition,
+		ID:                 rule.ID,
+		CreatedAt:          rule.CreatedAt,
+		UpdatedAt:          &rule.UpdatedAt.Time,
+		Description:        rule.Description.String,
+		Mode:               mode,
+		TargetCellValue:    uint64(rule.TargetCellValue.Int64),
+		TargetScore:        uint64(rule.TargetScore.Int64),
+		MaxMoves:           uint64(rule.MaxMoves.Int64),
+		Rows:               uint8(rule.SizeY),
+		Columns:            uint8(rule.SizeX),
+		RecreateOnSwipe:    rule.RecreateOnSwipe,
+		NoReSwipe:          rule.NoReswipe,
+		NoMultiply:         rule.NoMultiply,
+		NoAddition:         rule.NoAddition,
+		NoSuperPowers:      rule.NoSuperPowers,
+		MaxSwaps:           uint64(rule.MaxSwaps),
+		SwapNeighboursOnly: rule.SwapNeighboursOnly,
+		MaxPowers:          uint64(rule.MaxPowers),
+		WithSubtraction:    rule.WithSubtraction,
+		WithDivision:       rule.WithDivision,
+		WithMixedOperators: rule.WithMixedOperators,
+		Topology:           topology,
 	}, nil
 }
 func toTypeGame(createdGame *sqlite.Game, r *sqlite.Rule, seed, state uint64, cells []cell.Cell, playState string) (types.Game, error) {
@@ -99,6 +111,34 @@ func toTypeGame(createdGame *sqlite.Game, r *sqlite.Rule, seed, state uint64, ce
 	}
 	return tg, nil
 }
+func toTypeVote(v sqlite.Vote) *types.Vote {
+	return &types.Vote{
+		ID:         v.ID,
+		CreatedAt:  v.CreatedAt,
+		UpdatedAt:  fromNullTime(v.UpdatedAt),
+		UserID:     v.UserID,
+		GameID:     v.GameID.String,
+		TemplateID: v.TemplateID.String,
+		FunVote:    int(v.FunVote),
+	}
+}
+func toTypeUser(u sqlite.User) *types.User {
+	return &types.User{
+		ID:           u.ID,
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    fromNullTime(u.UpdatedAt),
+		UserName:     u.Username,
+		PasswordHash: u.PasswordHash.String,
+	}
+}
+func toTypeSession(s sqlite.Session) *types.Session {
+	return &types.Session{
+		ID:           s.ID,
+		CreatedAt:    s.CreatedAt,
+		UserID:       s.UserID,
+		InvalidAfter: s.InvalidAfter,
+	}
+}
 
 var (
 	ErrArgumentRequired = errors.New("argument required")
@@ -0,0 +1,382 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"github.com/runar-rkmedia/go-common/logger"
+	"github.com/runar-rkmedia/gotally/postgres"
+	"github.com/runar-rkmedia/gotally/sqlite"
+)
+
+// Returns a storage backed by postgres.
+// The queries in query-postgres.sql mirror the ones for sqlite, and the
+// generated models are identical, so the sqlite-storage is reused with an
+// adapter for the queries.
+func NewPostgresStorage(l logger.AppLogger, dsn string) (*sqliteStorage, error) {
+	db, err := newDb(l, dsn, true)
+	if err != nil {
+		return nil, err
+	}
+	queries := postgres.New(db)
+	_, err = queries.InitializeDatabase(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+	p := &sqliteStorage{
+		db:        db,
+		ruleCache: newRuleCacheSqlite(),
+		queries:   postgresQuerier{queries},
+		begin: beginSqlTx(db, func(tx *sql.Tx) sqlite.Querier {
+			return postgresQuerier{queries.WithTx(tx)}
+		}),
+	}
+	return p, p.prefetchRules(l)
+}
+
+// Implements sqlite.Querier with the queries generated for postgres.
+// Most types are converted directly, but the rows for queries where sqlc infers
+// different types for each engine are mapped by hand.
+type postgresQuerier struct {
+	q *postgres.Queries
+}
+
+var _ sqlite.Querier = postgresQuerier{}
+
+func (q postgresQuerier) GetUserBySessionID(ctx context.Context, id string) (sqlite.GetUserBySessionIDRow, error) {
+	r, err := q.q.GetUserBySessionID(ctx, id)
+	if err != nil {
+		return sqlite.GetUserBySessionIDRow{}, err
+	}
+	return sqlite.GetUserBySessionIDRow{
+		ID:           r.SessionID,
+		CreatedAt:    r.SessionCreatedAt,
+		InvalidAfter: r.SessionInvalidAfter,
+		ID_2:         r.UserID,
+		CreatedAt_2:  r.UserCreatedAt,
+		UpdatedAt:    r.UserUpdatedAt,
+		Username:     r.Username,
+		PasswordHash: r.PasswordHash,
+		ID_3:         r.GameID,
+		CreatedAt_3:  r.GameCreatedAt,
+		UpdatedAt_2:  r.GameUpdatedAt,
+		Description:  r.GameDescription,
+		Name:         r.GameName,
+		Data:         r.GameData,
+		History:      r.GameHistory,
+		PlayState:    r.GamePlayState,
+		Score:        r.GameScore,
+		Moves:        r.GameMoves,
+		RuleID:       r.RuleID,
+	}, nil
+}
+func (q postgresQuerier) Stats(ctx context.Context, invalidAfter time.Time) (sqlite.StatsRow, error) {
+	r, err := q.q.Stats(ctx, invalidAfter)
+	if err != nil {
+		return sqlite.StatsRow{}, err
+	}
+	return sqlite.StatsRow{
+		Users:           r.Users,
+		Session:         r.Session,
+		Games:           r.Games,
+		GamesWon:        r.GamesWon,
+		GamesLost:       r.GamesLost,
+		GamesAbandoned:  r.GamesAbandoned,
+		GamesCurrent:    r.GamesCurrent,
+		LongestGame:     r.LongestGame,
+		HighestScore:    r.HighestScore,
+		HistoryVariance: r.HistoryVariance,
+		HistoryAvg:      r.HistoryAvg,
+		HistoryMax:      r.HistoryMax,
+		HistoryMin:      r.HistoryMin,
+		HistoryTotal:    r.HistoryTotal,
+	}, nil
+}
+func (q postgresQuerier) GetVoteStatsForTemplates(ctx context.Context) ([]sqlite.GetVoteStatsForTemplatesRow, error) {
+	rows, err := q.q.GetVoteStatsForTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.GetVoteStatsForTemplatesRow, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.GetVoteStatsForTemplatesRow{
+			TemplateID:   r.TemplateID,
+			Votes:        r.Votes,
+			FunVoteTotal: r.FunVoteTotal,
+		}
+	}
+	return items, nil
+}
+func (q postgresQuerier) DeleteExpiredLinkCodes(ctx context.Context, invalidAfter time.Time) (int64, error) {
+	return q.q.DeleteExpiredLinkCodes(ctx, invalidAfter)
+}
+func (q postgresQuerier) DeleteExpiredSessions(ctx context.Context, invalidAfter time.Time) (int64, error) {
+	return q.q.DeleteExpiredSessions(ctx, invalidAfter)
+}
+func (q postgresQuerier) DeleteGameCheckpointsAfter(ctx context.Context, arg sqlite.DeleteGameCheckpointsAfterParams) (int64, error) {
+	return q.q.DeleteGameCheckpointsAfter(ctx, postgres.DeleteGameCheckpointsAfterParams(arg))
+}
+func (q postgresQuerier) DeleteGamesForOrphanedUsers(ctx context.Context) (int64, error) {
+	return q.q.DeleteGamesForOrphanedUsers(ctx)
+}
+func (q postgresQuerier) DeleteOrphanedUsers(ctx context.Context) (int64, error) {
+	return q.q.DeleteOrphanedUsers(ctx)
+}
+func (q postgresQuerier) GetAllGames(ctx context.Context) ([]sqlite.Game, error) {
+	rows, err := q.q.GetAllGames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.Game, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.Game(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetAllRules(ctx context.Context) ([]sqlite.Rule, error) {
+	rows, err := q.q.GetAllRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.Rule, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.Rule(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetAllSessions(ctx context.Context) ([]sqlite.Session, error) {
+	rows, err := q.q.GetAllSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.Session, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.Session(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetAllTemplates(ctx context.Context) ([]sqlite.GameTemplate, error) {
+	rows, err := q.q.GetAllTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.GameTemplate, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.GameTemplate(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetAllUsers(ctx context.Context) ([]sqlite.User, error) {
+	rows, err := q.q.GetAllUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.User, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.User(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetAllVotes(ctx context.Context) ([]sqlite.Vote, error) {
+	rows, err := q.q.GetAllVotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.Vote, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.Vote(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetAttemptsForTemplate(ctx context.Context, templateID sql.NullString) ([]sqlite.GetAttemptsForTemplateRow, error) {
+	rows, err := q.q.GetAttemptsForTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.GetAttemptsForTemplateRow, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.GetAttemptsForTemplateRow(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetChallengeLeaderboard(ctx context.Context, arg sqlite.GetChallengeLeaderboardParams) ([]sqlite.GetChallengeLeaderboardRow, error) {
+	rows, err := q.q.GetChallengeLeaderboard(ctx, postgres.GetChallengeLeaderboardParams(arg))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.GetChallengeLeaderboardRow, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.GetChallengeLeaderboardRow(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetChallengeLeaderboardForUser(ctx context.Context, arg sqlite.GetChallengeLeaderboardForUserParams) (sqlite.GetChallengeLeaderboardForUserRow, error) {
+	r, err := q.q.GetChallengeLeaderboardForUser(ctx, postgres.GetChallengeLeaderboardForUserParams(arg))
+	return sqlite.GetChallengeLeaderboardForUserRow(r), err
+}
+func (q postgresQuerier) CountChallengeLeaderboard(ctx context.Context, arg sqlite.CountChallengeLeaderboardParams) (int64, error) {
+	return q.q.CountChallengeLeaderboard(ctx, postgres.CountChallengeLeaderboardParams(arg))
+}
+func (q postgresQuerier) GetChallengeStatsForUser(ctx context.Context, userID string) ([]sqlite.GetChallengeStatsForUserRow, error) {
+	rows, err := q.q.GetChallengeStatsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.GetChallengeStatsForUserRow, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.GetChallengeStatsForUserRow(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetDailyTemplates(ctx context.Context) ([]sqlite.GameTemplate, error) {
+	rows, err := q.q.GetDailyTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.GameTemplate, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.GameTemplate(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetGame(ctx context.Context, id string) (sqlite.Game, error) {
+	r, err := q.q.GetGame(ctx, id)
+	return sqlite.Game(r), err
+}
+func (q postgresQuerier) GetGameChallengesTemplates(ctx context.Context) ([]sqlite.GameTemplate, error) {
+	rows, err := q.q.GetGameChallengesTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.GameTemplate, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.GameTemplate(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetGameCheckpoint(ctx context.Context, arg sqlite.GetGameCheckpointParams) (sqlite.GameCheckpoint, error) {
+	r, err := q.q.GetGameCheckpoint(ctx, postgres.GetGameCheckpointParams(arg))
+	return sqlite.GameCheckpoint(r), err
+}
+func (q postgresQuerier) GetGameTemplate(ctx context.Context, id string) (sqlite.GameTemplate, error) {
+	r, err := q.q.GetGameTemplate(ctx, id)
+	return sqlite.GameTemplate(r), err
+}
+func (q postgresQuerier) GetGameTemplateByChallengeNumber(ctx context.Context, challengeNumber sql.NullInt64) (sqlite.GameTemplate, error) {
+	r, err := q.q.GetGameTemplateByChallengeNumber(ctx, challengeNumber)
+	return sqlite.GameTemplate(r), err
+}
+func (q postgresQuerier) GetGameTemplateByDailyDate(ctx context.Context, dailyDate sql.NullString) (sqlite.GameTemplate, error) {
+	r, err := q.q.GetGameTemplateByDailyDate(ctx, dailyDate)
+	return sqlite.GameTemplate(r), err
+}
+func (q postgresQuerier) GetGamesForUser(ctx context.Context, userID string) ([]sqlite.Game, error) {
+	rows, err := q.q.GetGamesForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]sqlite.Game, len(rows))
+	for i, r := range rows {
+		items[i] = sqlite.Game(r)
+	}
+	return items, nil
+}
+func (q postgresQuerier) GetOriginalGame(ctx context.Context, id string) (sqlite.Game, error) {
+	r, err := q.q.GetOriginalGame(ctx, id)
+	return sqlite.Game(r), err
+}
+func (q postgresQuerier) GetRegisteredUserByUsername(ctx context.Context, username string) (sqlite.User, error) {
+	r, err := q.q.GetRegisteredUserByUsername(ctx, username)
+	return sqlite.User(r), err
+}
+func (q postgresQuerier) GetRule(ctx context.Context, arg sqlite.GetRuleParams) (sqlite.Rule, error) {
+	r, err := q.q.GetRule(ctx, postgres.GetRuleParams(arg))
+	return sqlite.Rule(r), err
+}
+func (q postgresQuerier) GetSession(ctx context.Context, id string) (sqlite.Session, error) {
+	r, err := q.q.GetSession(ctx, id)
+	return sqlite.Session(r), err
+}
+func (q postgresQuerier) GetUser(ctx context.Context, id string) (sqlite.User, error) {
+	r, err := q.q.GetUser(ctx, id)
+	return sqlite.User(r), err
+}
+func (q postgresQuerier) GetVoteForGameByUser(ctx context.Context, arg sqlite.GetVoteForGameByUserParams) (sqlite.Vote, error) {
+	r, err := q.q.GetVoteForGameByUser(ctx, postgres.GetVoteForGameByUserParams(arg))
+	return sqlite.Vote(r), err
+}
+func (q postgresQuerier) GetVoteForTemplateByUser(ctx context.Context, arg sqlite.GetVoteForTemplateByUserParams) (sqlite.Vote, error) {
+	r, err := q.q.GetVoteForTemplateByUser(ctx, postgres.GetVoteForTemplateByUserParams(arg))
+	return sqlite.Vote(r), err
+}
+func (q postgresQuerier) InserTemplate(ctx context.Context, arg sqlite.InserTemplateParams) (sqlite.GameTemplate, error) {
+	r, err := q.q.InserTemplate(ctx, postgres.InserTemplateParams(arg))
+	return sqlite.GameTemplate(r), err
+}
+func (q postgresQuerier) InsertGame(ctx context.Context, arg sqlite.InsertGameParams) (sqlite.Game, error) {
+	r, err := q.q.InsertGame(ctx, postgres.InsertGameParams(arg))
+	return sqlite.Game(r), err
+}
+func (q postgresQuerier) InsertLinkCode(ctx context.Context, arg sqlite.InsertLinkCodeParams) (sqlite.LinkCode, error) {
+	r, err := q.q.InsertLinkCode(ctx, postgres.InsertLinkCodeParams(arg))
+	return sqlite.LinkCode(r), err
+}
+func (q postgresQuerier) InsertRule(ctx context.Context, arg sqlite.InsertRuleParams) (sqlite.Rule, error) {
+	r, err := q.q.InsertRule(ctx, postgres.InsertRuleParams(arg))
+	return sqlite.Rule(r), err
+}
+func (q postgresQuerier) InsertSession(ctx context.Context, arg sqlite.InsertSessionParams) (sqlite.Session, error) {
+	r, err := q.q.InsertSession(ctx, postgres.InsertSessionParams(arg))
+	return sqlite.Session(r), err
+}
+func (q postgresQuerier) InsertUser(ctx context.Context, arg sqlite.InsertUserParams) (sqlite.User, error) {
+	r, err := q.q.InsertUser(ctx, postgres.InsertUserParams(arg))
+	return sqlite.User(r), err
+}
+func (q postgresQuerier) InsertVote(ctx context.Context, arg sqlite.InsertVoteParams) (sqlite.Vote, error) {
+	r, err := q.q.InsertVote(ctx, postgres.InsertVoteParams(arg))
+	return sqlite.Vote(r), err
+}
+func (q postgresQuerier) RefreshSession(ctx context.Context, arg sqlite.RefreshSessionParams) (sqlite.Session, error) {
+	r, err := q.q.RefreshSession(ctx, postgres.RefreshSessionParams(arg))
+	return sqlite.Session(r), err
+}
+func (q postgresQuerier) RegisterUser(ctx context.Context, arg sqlite.RegisterUserParams) (sqlite.User, error) {
+	r, err := q.q.RegisterUser(ctx, postgres.RegisterUserParams(arg))
+	return sqlite.User(r), err
+}
+func (q postgresQuerier) ReplaceSession(ctx context.Context, arg sqlite.ReplaceSessionParams) (sqlite.Session, error) {
+	r, err := q.q.ReplaceSession(ctx, postgres.ReplaceSessionParams(arg))
+	return sqlite.Session(r), err
+}
+func (q postgresQuerier) SetActiveGameFormUser(ctx context.Context, arg sqlite.SetActiveGameFormUserParams) (sqlite.User, error) {
+	r, err := q.q.SetActiveGameFormUser(ctx, postgres.SetActiveGameFormUserParams(arg))
+	return sqlite.User(r), err
+}
+func (q postgresQuerier) SetPlayStateForGame(ctx context.Context, arg sqlite.SetPlayStateForGameParams) (sqlite.Game, error) {
+	r, err := q.q.SetPlayStateForGame(ctx, postgres.SetPlayStateForGameParams(arg))
+	return sqlite.Game(r), err
+}
+func (q postgresQuerier) UpdateGame(ctx context.Context, arg sqlite.UpdateGameParams) (sqlite.Game, error) {
+	r, err := q.q.UpdateGame(ctx, postgres.UpdateGameParams(arg))
+	return sqlite.Game(r), err
+}
+func (q postgresQuerier) UpdateUser(ctx context.Context, arg sqlite.UpdateUserParams) (sqlite.User, error) {
+	r, err := q.q.UpdateUser(ctx, postgres.UpdateUserParams(arg))
+	return sqlite.User(r), err
+}
+func (q postgresQuerier) UpdateVote(ctx context.Context, arg sqlite.UpdateVoteParams) (sqlite.Vote, error) {
+	r, err := q.q.UpdateVote(ctx, postgres.UpdateVoteParams(arg))
+	return sqlite.Vote(r), err
+}
+func (q postgresQuerier) UpsertGameCheckpoint(ctx context.Context, arg sqlite.UpsertGameCheckpointParams) (sqlite.GameCheckpoint, error) {
+	r, err := q.q.UpsertGameCheckpoint(ctx, postgres.UpsertGameCheckpointParams(arg))
+	return sqlite.GameCheckpoint(r), err
+}
+func (q postgresQuerier) UseLinkCode(ctx context.Context, arg sqlite.UseLinkCodeParams) (sqlite.LinkCode, error) {
+	r, err := q.q.UseLinkCode(ctx, postgres.UseLinkCodeParams(arg))
+	return sqlite.LinkCode(r), err
+}
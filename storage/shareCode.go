@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+
+	protomodel "github.com/runar-rkmedia/gotally/gen/proto/tally/v1"
+	"github.com/runar-rkmedia/gotally/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// A share-code is laid out as:
+//
+//	[version: 1 byte][zlib-compressed InternalShareCode][crc32: 4 bytes]
+//
+// and encoded with base64url without padding, so that it can be used in URLs.
+// The checksum is the IEEE crc32 of everything before it.
+const (
+	shareCodeVersion1      byte = 1
+	shareCodeVersionLatest      = shareCodeVersion1
+	shareCodeChecksumSize       = 4
+	// Longer codes are rejected before they are decoded
+	shareCodeMaxLength = 1 << 16
+	// The decompressed size of a code, which is well above the largest board and a long history
+	shareCodeMaxDecompressedSize = 1 << 20
+	// Same as the limit when creating challenges
+	shareCodeMaxBoardSize = 16
+	// Limits the number of swaps and super-powers, since each is tried when searching for moves
+	shareCodeMaxPowers = 100
+)
+
+var (
+	ErrShareCodeInvalid  = errors.New("share-code is invalid")
+	ErrShareCodeChecksum = errors.New("share-code has an invalid checksum")
+	ErrShareCodeVersion  = errors.New("share-code has an unsupported version")
+)
+
+// Packs the game, as it was at the start, into a compact URL-safe string.
+// Its rules, name, description, cells, seed and state are included, as well as the
+// history if set, so that the game can be replayed from the code.
+func EncodeShareCode(g types.Game) (string, error) {
+	mode, err := fromMode(g.Rules.Mode)
+	if err != nil {
+		return "", err
+	}
+	topology, err := fromTopology(g.Rules.Topology)
+	if err != nil {
+		return "", err
+	}
+	sc := protomodel.InternalShareCode{
+		Name:        g.Name,
+		Description: g.Description,
+		Rules: &protomodel.InternalRules{
+			Mode:               int64(mode),
+			Description:        g.Rules.Description,
+			Columns:            uint32(g.Rules.Columns),
+			Rows:               uint32(g.Rules.Rows),
+			TargetCellValue:    g.Rules.TargetCellValue,
+			TargetScore:        g.Rules.TargetScore,
+			MaxMoves:           g.Rules.MaxMoves,
+			RecreateOnSwipe:    g.Rules.RecreateOnSwipe,
+			NoReswipe:          g.Rules.NoReSwipe,
+			NoMultiply:         g.Rules.NoMultiply,
+			NoAddition:         g.Rules.NoAddition,
+			NoSuperPowers:      g.Rules.NoSuperPowers,
+			MaxSwaps:           g.Rules.MaxSwaps,
+			SwapNeighboursOnly: g.Rules.SwapNeighboursOnly,
+			MaxPowers:          g.Rules.MaxPowers,
+			WithSubtraction:    g.Rules.WithSubtraction,
+			WithDivision:       g.Rules.WithDivision,
+			WithMixedOperators: g.Rules.WithMixedOperators,
+			Topology:           topology,
+		},
+		Start: &protomodel.InternalDataGame{
+			State: g.State,
+			Seed:  g.Seed,
+			Cells: PackCells(g.Cells),
+		},
+		History: g.History,
+	}
+	b, err := proto.Marshal(&sc)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal share-code: %w", err)
+	}
+	zb, err := compressProto(b)
+	if err != nil {
+		return "", err
+	}
+	out := make([]byte, 0, 1+len(zb)+shareCodeChecksumSize)
+	out = append(out, shareCodeVersionLatest)
+	out = append(out, zb...)
+	out = binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(out))
+	return base64.RawURLEncoding.EncodeToString(out), nil
+}
+
+// Unpacks a share-code created by EncodeShareCode.
+// The returned game has no ID, UserID or timestamps, and its rules have no ID.
+func DecodeShareCode(code string) (types.Game, error) {
+	var g types.Game
+	if len(code) > shareCodeMaxLength {
+		return g, fmt.Errorf("%w: too long", ErrShareCodeInvalid)
+	}
+	b, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil {
+		return g, fmt.Errorf("%w: %s", ErrShareCodeInvalid, err)
+	}
+	if len(b) < 1+shareCodeChecksumSize {
+		return g, fmt.Errorf("%w: too short", ErrShareCodeInvalid)
+	}
+	payload, sum := b[:len(b)-shareCodeChecksumSize], b[len(b)-shareCodeChecksumSize:]
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(sum) {
+		return g, ErrShareCodeChecksum
+	}
+	if payload[0] != shareCodeVersion1 {
+		return g, fmt.Errorf("%w: %d", ErrShareCodeVersion, payload[0])
+	}
+	var sc protomodel.InternalShareCode
+	if err := unmarshalCompressedProto(payload[1:], &sc, shareCodeMaxDecompressedSize); err != nil {
+		return g, fmt.Errorf("%w: %s", ErrShareCodeInvalid, err)
+	}
+	r, start := sc.GetRules(), sc.GetStart()
+	if r == nil || start == nil {
+		return g, fmt.Errorf("%w: missing rules or game", ErrShareCodeInvalid)
+	}
+	if r.Columns == 0 || r.Rows == 0 || r.Columns > shareCodeMaxBoardSize || r.Rows > shareCodeMaxBoardSize {
+		return g, fmt.Errorf("%w: board-size %dx%d", ErrShareCodeInvalid, r.Columns, r.Rows)
+	}
+	if len(start.Cells) != int(r.Columns*r.Rows)*2 {
+		return g, fmt.Errorf("%w: expected %d cells, got %d", ErrShareCodeInvalid, r.Columns*r.Rows, len(start.Cells)/2)
+	}
+	if r.NoMultiply && r.NoAddition && !r.WithSubtraction && !r.WithDivision {
+		return g, fmt.Errorf("%w: the rules have no evaluation-method", ErrShareCodeInvalid)
+	}
+	if r.MaxSwaps > shareCodeMaxPowers || r.MaxPowers > shareCodeMaxPowers {
+		return g, fmt.Errorf("%w: MaxSwaps and MaxPowers must be at most %d", ErrShareCodeInvalid, shareCodeMaxPowers)
+	}
+	cells := UnpackCells(start.Cells)
+	if err := types.ValidateCells(cells); err != nil {
+		return g, fmt.Errorf("%w: %s", ErrShareCodeInvalid, err)
+	}
+	mode, err := toMode(r.Mode)
+	if err != nil {
+		return g, fmt.Errorf("%w: %s", ErrShareCodeInvalid, err)
+	}
+	topology, err := toTopology(r.Topology)
+	if err != nil {
+		return g, fmt.Errorf("%w: %s", ErrShareCodeInvalid, err)
+	}
+	g = types.Game{
+		Name:        sc.Name,
+		Description: sc.Description,
+		Seed:        start.Seed,
+		State:       start.State,
+		Cells:       cells,
+		History:     sc.History,
+		PlayState:   types.PlayStateCurrent,
+	}
+	g.Rules = types.Rules{
+		Mode:               mode,
+		Description:        r.Description,
+		Columns:            uint8(r.Columns),
+		Rows:               uint8(r.Rows),
+		TargetCellValue:    r.TargetCellValue,
+		TargetScore:        r.TargetScore,
+		MaxMoves:           r.MaxMoves,
+		RecreateOnSwipe:    r.RecreateOnSwipe,
+		NoReSwipe:          r.NoReswipe,
+		NoMultiply:         r.NoMultiply,
+		NoAddition:         r.NoAddition,
+		NoSuperPowers:      r.NoSuperPowers,
+		MaxSwaps:           r.MaxSwaps,
+		SwapNeighboursOnly: r.SwapNeighboursOnly,
+		MaxPowers:          r.MaxPowers,
+		WithSubtraction:    r.WithSubtraction,
+		WithDivision:       r.WithDivision,
+		WithMixedOperators: r.WithMixedOperators,
+		Topology:           topology,
+	}
+	return g, nil
+}
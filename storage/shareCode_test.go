@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func shareCodeGameForTest() types.Game {
+	g := types.Game{
+		Name:        "Share me",
+		Description: "A game to share",
+		Seed:        1008,
+		State:       42,
+		Cells: []cell.Cell{
+			cell.NewCell(1, 0), cell.NewCell(2, 0), cell.NewEmptyCell(),
+			cell.NewBlockedCell(), cell.NewCell(3, 2), cell.NewCell(64, 0),
+			cell.NewEmptyCell(), cell.NewEmptyCell(), cell.NewCell(1, 1),
+		},
+		History:   []byte{0xf8, 0x12, 0x34},
+		PlayState: types.PlayStateCurrent,
+	}
+	g.Rules = types.Rules{
+		Mode:               types.RuleModeChallenge,
+		Description:        "Rules to share",
+		Columns:            3,
+		Rows:               3,
+		TargetCellValue:    128,
+		MaxMoves:           10,
+		NoReSwipe:          true,
+		MaxSwaps:           2,
+		SwapNeighboursOnly: true,
+		MaxPowers:          1,
+		WithDivision:       true,
+		Topology:           types.TopologyEightWay,
+	}
+	return g
+}
+
+func TestShareCode(t *testing.T) {
+	t.Run("Should round-trip the game", func(t *testing.T) {
+		g := shareCodeGameForTest()
+		code, err := EncodeShareCode(g)
+		if err != nil {
+			t.Fatalf("EncodeShareCode() error = %v", err)
+		}
+		if len(code) > 200 {
+			t.Errorf("EncodeShareCode() should be short, got %d characters: %s", len(code), code)
+		}
+		got, err := DecodeShareCode(code)
+		if err != nil {
+			t.Fatalf("DecodeShareCode() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, g) {
+			t.Errorf("DecodeShareCode() = %#v, want %#v", got, g)
+		}
+	})
+	t.Run("Should round-trip a game without history", func(t *testing.T) {
+		g := shareCodeGameForTest()
+		g.History = nil
+		withHistory, _ := EncodeShareCode(shareCodeGameForTest())
+		code, err := EncodeShareCode(g)
+		if err != nil {
+			t.Fatalf("EncodeShareCode() error = %v", err)
+		}
+		if len(code) >= len(withHistory) {
+			t.Errorf("EncodeShareCode() without history should be shorter, got %d, with history %d", len(code), len(withHistory))
+		}
+		got, err := DecodeShareCode(code)
+		if err != nil {
+			t.Fatalf("DecodeShareCode() error = %v", err)
+		}
+		if len(got.History) != 0 {
+			t.Errorf("DecodeShareCode() should not have a history, got %v", got.History)
+		}
+	})
+	t.Run("Should reject invalid codes", func(t *testing.T) {
+		code, err := EncodeShareCode(shareCodeGameForTest())
+		if err != nil {
+			t.Fatalf("EncodeShareCode() error = %v", err)
+		}
+		raw, _ := base64.RawURLEncoding.DecodeString(code)
+		tampered := append([]byte{}, raw...)
+		tampered[len(tampered)/2] ^= 0xff
+		unknownVersion := append([]byte{}, raw[:len(raw)-shareCodeChecksumSize]...)
+		unknownVersion[0] = 99
+		unknownVersion = binary.BigEndian.AppendUint32(unknownVersion, crc32.ChecksumIEEE(unknownVersion))
+
+		tests := []struct {
+			name string
+			code string
+			want error
+		}{
+			{"empty", "", ErrShareCodeInvalid},
+			{"not base64url", "not a code!", ErrShareCodeInvalid},
+			{"too short", base64.RawURLEncoding.EncodeToString([]byte{1, 2}), ErrShareCodeInvalid},
+			{"tampered", base64.RawURLEncoding.EncodeToString(tampered), ErrShareCodeChecksum},
+			{"truncated", code[:len(code)-4], ErrShareCodeChecksum},
+			{"unknown version", base64.RawURLEncoding.EncodeToString(unknownVersion), ErrShareCodeVersion},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := DecodeShareCode(tt.code)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("DecodeShareCode() error = %v, want %v", err, tt.want)
+				}
+			})
+		}
+	})
+	t.Run("Should reject oversized codes", func(t *testing.T) {
+		// Zeroes compress very well, so this code is small, but expands beyond the limit
+		zb, err := compressProto(make([]byte, shareCodeMaxDecompressedSize+1))
+		if err != nil {
+			t.Fatalf("compressProto() error = %v", err)
+		}
+		bomb := append([]byte{shareCodeVersionLatest}, zb...)
+		bomb = binary.BigEndian.AppendUint32(bomb, crc32.ChecksumIEEE(bomb))
+
+		for name, code := range map[string]string{
+			"too long":             strings.Repeat("A", shareCodeMaxLength+1),
+			"decompresses too far": base64.RawURLEncoding.EncodeToString(bomb),
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := DecodeShareCode(code)
+				if !errors.Is(err, ErrShareCodeInvalid) {
+					t.Errorf("DecodeShareCode() error = %v, want %v", err, ErrShareCodeInvalid)
+				}
+			})
+		}
+	})
+	t.Run("Should reject codes with invalid rules or cells", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(g *types.Game)
+		}{
+			{"without evaluation-methods", func(g *types.Game) {
+				g.Rules.NoMultiply, g.Rules.NoAddition, g.Rules.WithDivision = true, true, false
+			}},
+			{"too large board", func(g *types.Game) {
+				g.Rules.Columns, g.Rules.Rows = 17, 17
+				g.Cells = make([]cell.Cell, 17*17)
+			}},
+			{"too many swaps", func(g *types.Game) { g.Rules.MaxSwaps = 1000 }},
+			{"too many powers", func(g *types.Game) { g.Rules.MaxPowers = 1000 }},
+			{"negative base-value", func(g *types.Game) { g.Cells[0] = cell.NewCell(-2, 0) }},
+			{"huge base-value", func(g *types.Game) { g.Cells[0] = cell.NewCell(1<<50, 0) }},
+			{"huge power", func(g *types.Game) { g.Cells[0] = cell.NewCell(3, 62) }},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := shareCodeGameForTest()
+				tt.modify(&g)
+				code, err := EncodeShareCode(g)
+				if err != nil {
+					t.Fatalf("EncodeShareCode() error = %v", err)
+				}
+				_, err = DecodeShareCode(code)
+				if !errors.Is(err, ErrShareCodeInvalid) {
+					t.Errorf("DecodeShareCode() error = %v, want %v", err, ErrShareCodeInvalid)
+				}
+			})
+		}
+	})
+}
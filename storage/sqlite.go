@@ -14,12 +14,32 @@ import (
 	"github.com/runar-rkmedia/gotally/types"
 )
 
+// The storage is also used for postgres, through the sqlite.Querier-interface.
+// See NewPostgresStorage
 type sqliteStorage struct {
 	db *sql.DB
 	// rules are accessed very often, and there are not too many in any case
 	// therefore we cache them
 	ruleCache ruleCacheSqLite
-	queries   sqlite.Queries
+	queries   sqlite.Querier
+	begin     func(ctx context.Context) (sqlite.Querier, transaction, error)
+}
+
+// The parts of sql.Tx used by the storage
+type transaction interface {
+	Commit() error
+	Rollback() error
+}
+
+// Begins transactions on the database, with queries bound to it.
+func beginSqlTx(db *sql.DB, withTx func(tx *sql.Tx) sqlite.Querier) func(ctx context.Context) (sqlite.Querier, transaction, error) {
+	return func(ctx context.Context) (sqlite.Querier, transaction, error) {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		return withTx(tx), tx, nil
+	}
 }
 
 func NewSqliteStorage(l logger.AppLogger, dsn string) (*sqliteStorage, error) {
@@ -27,34 +47,41 @@ func NewSqliteStorage(l logger.AppLogger, dsn string) (*sqliteStorage, error) {
 	if err != nil {
 		return nil, err
 	}
-	p := &sqliteStorage{
-		db,
-		newRuleCacheSqlite(),
-		*sqlite.New(db),
-	}
-	_, err = p.queries.InitializeDatabase(context.TODO())
+	queries := sqlite.New(db)
+	_, err = queries.InitializeDatabase(context.TODO())
 	if err != nil {
 		return nil, err
 	}
+	p := &sqliteStorage{
+		db:        db,
+		ruleCache: newRuleCacheSqlite(),
+		queries:   queries,
+		begin: beginSqlTx(db, func(tx *sql.Tx) sqlite.Querier {
+			return queries.WithTx(tx)
+		}),
+	}
+	return p, p.prefetchRules(l)
+}
 
-	err = p.fetchRules(context.TODO())
+func (p *sqliteStorage) prefetchRules(l logger.AppLogger) error {
+	err := p.fetchRules(context.TODO(), p.queries)
 	if err != nil {
-		return p, err
+		return err
 	}
 	if l.HasDebug() {
 		l.Debug().
 			Int("ruleCount", len(p.ruleCache.rules)).
 			Msg("prefetched rules")
 	}
-	return p, nil
+	return nil
 }
-func (p *sqliteStorage) fetchRules(ctx context.Context) (err error) {
+func (p *sqliteStorage) fetchRules(ctx context.Context, q sqlite.Querier) (err error) {
 	ctx, span := tracerSqlite.Start(ctx, "fetchRules")
 	defer func() {
 		AnnotateSpanError(span, err)
 		span.End()
 	}()
-	rules, err := p.queries.GetAllRules(ctx)
+	rules, err := q.GetAllRules(ctx)
 	if err != nil {
 		return err
 	}
@@ -62,12 +89,8 @@ func (p *sqliteStorage) fetchRules(ctx context.Context) (err error) {
 	return nil
 }
 
-func (p *sqliteStorage) beginTx(ctx context.Context) (*sqlite.Queries, *sql.Tx, error) {
-	tx, err := p.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-	return p.queries.WithTx(tx), tx, nil
+func (p *sqliteStorage) beginTx(ctx context.Context) (sqlite.Querier, transaction, error) {
+	return p.begin(ctx)
 }
 func (p *sqliteStorage) Stats(ctx context.Context) (sess *types.Statistics, err error) {
 
@@ -76,7 +99,7 @@ func (p *sqliteStorage) Stats(ctx context.Context) (sess *types.Statistics, err
 		AnnotateSpanError(span, err)
 		span.End()
 	}()
-	stats, err := p.queries.Stats(ctx)
+	stats, err := p.queries.Stats(ctx, time.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -156,39 +179,17 @@ func (p *sqliteStorage) GetGameChallenges(ctx context.Context, payload types.Get
 	if err != nil {
 		return nil, fmt.Errorf("failed to get stats for game-challenges")
 	}
+	votes, err := q.GetVoteStatsForTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get votes for game-challenges: %w", err)
+	}
 	response = make([]types.GameTemplate, len(list))
-	p.fetchRules(ctx)
+	p.fetchRules(ctx, q)
 	for i := 0; i < len(list); i++ {
-		r := p.ruleCache.getCachedRule(list[i].RuleID)
-		if r == nil {
-			rr, err := q.GetRule(ctx, sqlite.GetRuleParams{ID: list[i].RuleID})
-			if err != nil {
-				return response, fmt.Errorf("failed to fetch rule")
-			}
-			p.ruleCache.addRulesToCache([]sqlite.Rule{rr})
-			if rr.ID == "" {
-				return response, fmt.Errorf("expected to find the rule with id '%s' in the database, but it was not found", list[i].RuleID)
-			}
-			r = &rr
-		}
-		rule, err := toTypeRule(*r)
+		response[i], err = p.toTypeGameTemplate(ctx, q, list[i])
 		if err != nil {
 			return response, err
 		}
-		cells, _, _, err := UnmarshalInternalDataGame(ctx, list[i].Data)
-		response[i] = types.GameTemplate{
-			ID:              list[i].ID,
-			CreatedAt:       list[i].CreatedAt,
-			UpdatedAt:       fromNullTime(list[i].UpdatedAt),
-			ChallengeNumber: nullIntToIntP(list[i].ChallengeNumber),
-			IdealMoves:      nullIntToIntP(list[i].IdealMoves),
-			CreatedByID:     list[i].CreatedBy,
-			UpdatedBy:       list[i].UpdatedBy.String,
-			Description:     list[i].Description.String,
-			Name:            list[i].Name,
-			Cells:           cells,
-			Rules:           rule,
-		}
 		for j := 0; j < len(stats); j++ {
 			if stats[j].TemplateID.String != list[i].ID {
 				continue
@@ -205,11 +206,310 @@ func (p *sqliteStorage) GetGameChallenges(ctx context.Context, payload types.Get
 			})
 
 		}
+		for j := 0; j < len(votes); j++ {
+			if votes[j].TemplateID.String != list[i].ID {
+				continue
+			}
+			total, err := toUint64(votes[j].FunVoteTotal)
+			if err != nil {
+				return response, fmt.Errorf("failure for type FunVoteTotal: %w", err)
+			}
+			response[i].Votes = types.VoteStats{
+				Count:        uint64(votes[j].Votes),
+				FunVoteTotal: total,
+			}
+			break
+		}
 
 	}
 
 	return
 }
+
+// Maps the template, with its rule and cells
+func (p *sqliteStorage) toTypeGameTemplate(ctx context.Context, q sqlite.Querier, t sqlite.GameTemplate) (types.GameTemplate, error) {
+	r := p.ruleCache.getCachedRule(t.RuleID)
+	if r == nil {
+		rr, err := q.GetRule(ctx, sqlite.GetRuleParams{ID: t.RuleID})
+		if err != nil {
+			return types.GameTemplate{}, fmt.Errorf("failed to fetch rule")
+		}
+		p.ruleCache.addRulesToCache([]sqlite.Rule{rr})
+		if rr.ID == "" {
+			return types.GameTemplate{}, fmt.Errorf("expected to find the rule with id '%s' in the database, but it was not found", t.RuleID)
+		}
+		r = &rr
+	}
+	rule, err := toTypeRule(*r)
+	if err != nil {
+		return types.GameTemplate{}, err
+	}
+	cells, _, _, err := UnmarshalInternalDataGame(ctx, t.Data)
+	if err != nil {
+		return types.GameTemplate{}, fmt.Errorf("failed to unmarshal data for template: %w", err)
+	}
+	return types.GameTemplate{
+		ID:              t.ID,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       fromNullTime(t.UpdatedAt),
+		ChallengeNumber: nullIntToIntP(t.ChallengeNumber),
+		IdealMoves:      nullIntToIntP(t.IdealMoves),
+		CreatedByID:     t.CreatedBy,
+		UpdatedBy:       t.UpdatedBy.String,
+		Description:     t.Description.String,
+		Name:            t.Name,
+		Cells:           cells,
+		DailyDate:       t.DailyDate.String,
+		Rules:           rule,
+	}, nil
+}
+func (p *sqliteStorage) GetChallengeLeaderboard(ctx context.Context, payload types.GetChallengeLeaderboardPayload) (response types.ChallengeLeaderboard, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "GetChallengeLeaderboard")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	err = payload.Validate()
+	if err != nil {
+		return response, fmt.Errorf("payload-validation-failed: %w", err)
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return response, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	templateID := toNullString(payload.TemplateID)
+	rows, err := q.GetChallengeLeaderboard(ctx, sqlite.GetChallengeLeaderboardParams{
+		TemplateID:       templateID,
+		FirstAttemptOnly: payload.FirstAttemptOnly,
+		RowLimit:         int64(payload.Limit),
+		RowOffset:        int64(payload.Offset),
+	})
+	if err != nil {
+		return response, fmt.Errorf("failed to get leaderboard for game-challenge: %w", err)
+	}
+	total, err := q.CountChallengeLeaderboard(ctx, sqlite.CountChallengeLeaderboardParams{
+		TemplateID:       templateID,
+		FirstAttemptOnly: payload.FirstAttemptOnly,
+	})
+	if err != nil {
+		return response, fmt.Errorf("failed to count players for game-challenge: %w", err)
+	}
+	response.TotalPlayers = int(total)
+	response.Entries = make([]types.LeaderboardEntry, len(rows))
+	for i, row := range rows {
+		response.Entries[i] = toLeaderboardEntry(row)
+	}
+	if payload.CurrentUserID == "" {
+		return response, nil
+	}
+	current, err := q.GetChallengeLeaderboardForUser(ctx, sqlite.GetChallengeLeaderboardForUserParams{
+		TemplateID:       templateID,
+		FirstAttemptOnly: payload.FirstAttemptOnly,
+		UserID:           payload.CurrentUserID,
+	})
+	if err != nil {
+		if errIsSqlNoRows(err) {
+			return response, nil
+		}
+		return response, fmt.Errorf("failed to get leaderboard-entry for current user: %w", err)
+	}
+	entry := toLeaderboardEntry(sqlite.GetChallengeLeaderboardRow(current))
+	response.CurrentUser = &entry
+	return response, nil
+}
+
+func toLeaderboardEntry(row sqlite.GetChallengeLeaderboardRow) types.LeaderboardEntry {
+	wonAt := row.CreatedAt
+	if row.UpdatedAt.Valid {
+		wonAt = row.UpdatedAt.Time
+	}
+	return types.LeaderboardEntry{
+		Rank: int(row.Rank),
+		PlayStats: types.PlayStats{
+			GameID:   row.GameID,
+			UserID:   row.UserID,
+			Username: row.Username,
+			Score:    uint64(row.Score),
+			Moves:    uint64(row.Moves),
+		},
+		WonAt: wonAt,
+	}
+}
+
+func (p *sqliteStorage) GetGameTemplate(ctx context.Context, payload types.GetGameTemplatePayload) (response *types.GameTemplate, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "GetGameTemplate")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	err = payload.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("payload-validation-failed: %w", err)
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	t, err := q.GetGameTemplate(ctx, payload.ID)
+	if err != nil {
+		if errIsSqlNoRows(err) {
+			return nil, types.ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+	template, err := p.toTypeGameTemplate(ctx, q, t)
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// Returns the latest checkpoint at or before the depth, or nil if there is none
+func (p *sqliteStorage) GetGameCheckpoint(ctx context.Context, payload types.GetGameCheckpointPayload) (response *types.GameCheckpoint, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "GetGameCheckpoint")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	err = payload.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("payload-validation-failed: %w", err)
+	}
+	c, err := p.queries.GetGameCheckpoint(ctx, sqlite.GetGameCheckpointParams{
+		GameID: payload.GameID,
+		Depth:  int64(payload.MaxDepth),
+	})
+	if err != nil {
+		if errIsSqlNoRows(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get checkpoint: %w", err)
+	}
+	cells, seed, state, err := UnmarshalInternalDataGame(ctx, c.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+	return &types.GameCheckpoint{
+		Depth:     int(c.Depth),
+		CreatedAt: c.CreatedAt,
+		Score:     uint64(c.Score),
+		Cells:     cells,
+		Seed:      seed,
+		State:     state,
+	}, nil
+}
+func (p *sqliteStorage) GetDailyChallenge(ctx context.Context, payload types.GetDailyChallengePayload) (response *types.DailyChallenge, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "GetDailyChallenge")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	err = payload.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("payload-validation-failed: %w", err)
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	t, err := q.GetGameTemplateByDailyDate(ctx, toNullString(payload.Date))
+	if err != nil {
+		if errIsSqlNoRows(err) {
+			return nil, types.ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get template for daily challenge: %w", err)
+	}
+	daily, err := p.toDailyChallenge(ctx, q, t, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &daily, nil
+}
+func (p *sqliteStorage) ListDailyChallenges(ctx context.Context, payload types.ListDailyChallengesPayload) (response types.DailyChallengeList, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "ListDailyChallenges")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	err = payload.Validate()
+	if err != nil {
+		return response, fmt.Errorf("payload-validation-failed: %w", err)
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return response, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	templates, err := q.GetDailyTemplates(ctx)
+	if err != nil {
+		return response, fmt.Errorf("failed to get templates for daily challenges: %w", err)
+	}
+	response.Total = len(templates)
+	response.Challenges = []types.DailyChallenge{}
+	if payload.Offset >= len(templates) {
+		return response, nil
+	}
+	end := payload.Offset + payload.Limit
+	if end > len(templates) {
+		end = len(templates)
+	}
+	for _, t := range templates[payload.Offset:end] {
+		daily, err := p.toDailyChallenge(ctx, q, t, payload.UserID)
+		if err != nil {
+			return response, err
+		}
+		response.Challenges = append(response.Challenges, daily)
+	}
+	return response, nil
+}
+
+// Maps the template of a daily challenge, with the first attempt of the user, if any
+func (p *sqliteStorage) toDailyChallenge(ctx context.Context, q sqlite.Querier, t sqlite.GameTemplate, userID string) (types.DailyChallenge, error) {
+	template, err := p.toTypeGameTemplate(ctx, q, t)
+	if err != nil {
+		return types.DailyChallenge{}, err
+	}
+	daily := types.DailyChallenge{GameTemplate: template}
+	if userID == "" {
+		return daily, nil
+	}
+	attempts, err := q.GetAttemptsForTemplate(ctx, toNullString(t.ID))
+	if err != nil {
+		return daily, fmt.Errorf("failed to get attempts for daily challenge: %w", err)
+	}
+	for _, a := range attempts {
+		if a.UserID != userID {
+			continue
+		}
+		playState, err := toPlayState(a.PlayState)
+		if err != nil {
+			return daily, err
+		}
+		daily.RankedAttempt = &types.DailyAttempt{
+			PlayStats: types.PlayStats{
+				GameID:   a.GameID,
+				UserID:   a.UserID,
+				Username: a.Username,
+				Score:    uint64(a.Score),
+				Moves:    uint64(a.Moves),
+			},
+			PlayState: playState,
+		}
+		break
+	}
+	return daily, nil
+}
 func (p *sqliteStorage) CreateGameTemplate(ctx context.Context, payload types.CreateGameTemplatePayload) (response *types.GameTemplate, err error) {
 
 	ctx, span := tracerSqlite.Start(ctx, "CreateGameTemplate")
@@ -248,6 +548,7 @@ func (p *sqliteStorage) CreateGameTemplate(ctx context.Context, payload types.Cr
 		IdealMoves:  toNullInt64(uint64(payload.IdealMoves)),
 		IdealScore:  toNullInt64(uint64(payload.IdealScore)),
 		Data:        data,
+		DailyDate:   toNullString(payload.DailyDate),
 	}
 	if payload.ChallengeNumber != nil {
 		templateArgs.ChallengeNumber.Int64 = int64(*payload.ChallengeNumber)
@@ -272,6 +573,7 @@ func (p *sqliteStorage) CreateGameTemplate(ctx context.Context, payload types.Cr
 		Description:     t.Description.String,
 		Name:            t.Name,
 		Cells:           payload.Cells,
+		DailyDate:       t.DailyDate.String,
 		Rules:           typeRule,
 	}
 	err = tx.Commit()
@@ -394,7 +696,7 @@ func sqlString(s string) sql.NullString {
 	}
 	return sql.NullString{String: s, Valid: true}
 }
-func (p *sqliteStorage) ensureRuleExists(ctx context.Context, q *sqlite.Queries, r types.Rules) (sqlite.Rule, error) {
+func (p *sqliteStorage) ensureRuleExists(ctx context.Context, q sqlite.Querier, r types.Rules) (sqlite.Rule, error) {
 	slug := r.Hash()
 	existing := p.ruleCache.getCachedRule(slug)
 	if existing != nil {
@@ -416,6 +718,10 @@ func (p *sqliteStorage) ensureRuleExists(ctx context.Context, q *sqlite.Queries,
 	if err != nil {
 		return sqlite.Rule{}, fmt.Errorf("failed to convert rule.Mode from rule %#v: %w", r, err)
 	}
+	topology, err := fromTopology(r.Topology)
+	if err != nil {
+		return sqlite.Rule{}, fmt.Errorf("failed to convert rule.Topology from rule %#v: %w", r, err)
+	}
 	if r.ID == "" {
 		// TODO: is it valid at this point to have a rule-id?
 		// return sqlite.Rule{}, fmt.Errorf("The id of the rule cannot be empty")
@@ -426,20 +732,28 @@ func (p *sqliteStorage) ensureRuleExists(ctx context.Context, q *sqlite.Queries,
 		return sqlite.Rule{}, fmt.Errorf("mode %s requires TargetCellValue", r.Mode)
 	}
 	insertParams := sqlite.InsertRuleParams{
-		ID:              r.ID,
-		Slug:            slug,
-		CreatedAt:       time.Now(),
-		Description:     sqlString(r.Description),
-		Mode:            int64(mode),
-		SizeX:           int64(r.Columns),
-		SizeY:           int64(r.Rows),
-		RecreateOnSwipe: r.RecreateOnSwipe,
-		NoReswipe:       r.NoReSwipe,
-		NoMultiply:      r.NoMultiply,
-		NoAddition:      r.NoAddition,
-		MaxMoves:        toNullInt64(r.MaxMoves),
-		TargetCellValue: toNullInt64(r.TargetCellValue),
-		TargetScore:     toNullInt64(r.TargetCellValue),
+		ID:                 r.ID,
+		Slug:               slug,
+		CreatedAt:          time.Now(),
+		Description:        sqlString(r.Description),
+		Mode:               int64(mode),
+		SizeX:              int64(r.Columns),
+		SizeY:              int64(r.Rows),
+		RecreateOnSwipe:    r.RecreateOnSwipe,
+		NoReswipe:          r.NoReSwipe,
+		NoMultiply:         r.NoMultiply,
+		NoAddition:         r.NoAddition,
+		NoSuperPowers:      r.NoSuperPowers,
+		MaxMoves:           toNullInt64(r.MaxMoves),
+		TargetCellValue:    toNullInt64(r.TargetCellValue),
+		TargetScore:        toNullInt64(r.TargetScore),
+		MaxSwaps:           int64(r.MaxSwaps),
+		SwapNeighboursOnly: r.SwapNeighboursOnly,
+		MaxPowers:          int64(r.MaxPowers),
+		WithSubtraction:    r.WithSubtraction,
+		WithDivision:       r.WithDivision,
+		WithMixedOperators: r.WithMixedOperators,
+		Topology:           topology,
 	}
 	if insertParams.SizeX == 0 || insertParams.SizeY == 0 {
 		return sqlite.Rule{}, fmt.Errorf("The rules has invalid size: %#v", insertParams)
@@ -504,10 +818,33 @@ func fromMode(p types.RuleMode) (RuleMode, error) {
 	}
 	return -1, fmt.Errorf("unknown mode during fromMode: '%s'", p)
 }
+func toTopology(p int64) (types.Topology, error) {
+	switch p {
+	case TopologyOrthogonal:
+		return types.TopologyOrthogonal, nil
+	case TopologyEightWay:
+		return types.TopologyEightWay, nil
+	case TopologyToroidal:
+		return types.TopologyToroidal, nil
+	}
+	return "", fmt.Errorf("unknown topology during toTopology: %d", p)
+}
+func fromTopology(p types.Topology) (int64, error) {
+	switch p {
+	case "", types.TopologyOrthogonal:
+		return TopologyOrthogonal, nil
+	case types.TopologyEightWay:
+		return TopologyEightWay, nil
+	case types.TopologyToroidal:
+		return TopologyToroidal, nil
+	}
+	return -1, fmt.Errorf("unknown topology during fromTopology: '%s'", p)
+}
 
 type PlayState = int64
 type RuleMode int64
 type InstructionKind = int64
+type Topology = int64
 
 const (
 	PlayStateWon PlayState = iota + 1
@@ -522,6 +859,11 @@ const (
 	RuleModeChallenge
 	RuleModeTutorial
 )
+const (
+	TopologyOrthogonal Topology = iota
+	TopologyEightWay
+	TopologyToroidal
+)
 const (
 	InstructionKindSwipe InstructionKind = iota + 1
 	InstructionKindCombine
@@ -611,8 +953,9 @@ func (p *sqliteStorage) GetUserBySessionID(ctx context.Context, payload types.Ge
 		ID:        sess.ID_2,
 		CreatedAt: sess.CreatedAt_2,
 		// session does not have an UpdatedAt-field, so the suffix-count is off by one
-		UpdatedAt: &sess.UpdatedAt.Time,
-		UserName:  sess.Username,
+		UpdatedAt:    &sess.UpdatedAt.Time,
+		UserName:     sess.Username,
+		PasswordHash: sess.PasswordHash.String,
 	}
 
 	tRule, err := toTypeRule(*rule)
@@ -710,6 +1053,29 @@ func (p *sqliteStorage) UpdateGame(ctx context.Context, payload types.UpdateGame
 	if updateGameArgs.Moves != updated.Moves {
 		return fmt.Errorf("Did not expect moves to be zero. (this is a temporary check, and should be removed in the future)")
 	}
+	_, err = q.DeleteGameCheckpointsAfter(ctx, sqlite.DeleteGameCheckpointsAfterParams{
+		GameID: g.ID,
+		Depth:  int64(payload.Depth),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete the checkpoints after depth %d: %w", payload.Depth, err)
+	}
+	for _, c := range payload.Checkpoints {
+		data, err := MarshalInternalDataGame(ctx, c.Seed, c.State, c.Cells)
+		if err != nil {
+			return fmt.Errorf("%s %w: checkpoint", err, ErrArgumentInvalid)
+		}
+		_, err = q.UpsertGameCheckpoint(ctx, sqlite.UpsertGameCheckpointParams{
+			GameID:    g.ID,
+			Depth:     int64(c.Depth),
+			CreatedAt: time.Now(),
+			Score:     int64(c.Score),
+			Data:      data,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to store the checkpoint at depth %d: %w", c.Depth, err)
+		}
+	}
 
 	err = tx.Commit()
 	return err
@@ -754,10 +1120,365 @@ func (p *sqliteStorage) Dump(ctx context.Context) (tg types.Dump, err error) {
 		return tg, fmt.Errorf("failed to retrieve all templates")
 	}
 	tg.Template = templates
+	votes, err := q.GetAllVotes(ctx)
+	if err != nil {
+		return tg, fmt.Errorf("failed to retrieve all votes")
+	}
+	tg.Votes = votes
 
 	return
 }
 
+// Registers a vote from the user for a game.
+// If the game is based on a template, the vote is registered for the template.
+// A user has at most one vote per game or template, and voting again updates
+// the existing vote.
+func (p *sqliteStorage) VoteForBoard(ctx context.Context, payload types.VoteForBoardPayload) (vote *types.Vote, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "VoteForBoard")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	g, err := q.GetGame(ctx, payload.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find game %w", err)
+	}
+	var existing sqlite.Vote
+	if g.TemplateID.Valid {
+		existing, err = q.GetVoteForTemplateByUser(ctx, sqlite.GetVoteForTemplateByUserParams{
+			UserID:     payload.UserID,
+			TemplateID: g.TemplateID,
+		})
+	} else {
+		existing, err = q.GetVoteForGameByUser(ctx, sqlite.GetVoteForGameByUserParams{
+			UserID: payload.UserID,
+			GameID: toNullString(g.ID),
+		})
+	}
+	var v sqlite.Vote
+	switch {
+	case err == nil:
+		v, err = q.UpdateVote(ctx, sqlite.UpdateVoteParams{
+			UpdatedAt: toNullTimeNonNullable(time.Now()),
+			FunVote:   int64(payload.FunVote),
+			ID:        existing.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update vote: %w", err)
+		}
+	case errIsSqlNoRows(err):
+		args := sqlite.InsertVoteParams{
+			ID:        createID(),
+			CreatedAt: time.Now(),
+			UserID:    payload.UserID,
+			FunVote:   int64(payload.FunVote),
+		}
+		if g.TemplateID.Valid {
+			args.TemplateID = g.TemplateID
+		} else {
+			args.GameID = toNullString(g.ID)
+		}
+		v, err = q.InsertVote(ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert vote: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("failed to lookup existing vote: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return toTypeVote(v), nil
+}
+
+func (p *sqliteStorage) RegisterUser(ctx context.Context, payload types.RegisterUserPayload) (user *types.User, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "RegisterUser")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	_, err = q.GetRegisteredUserByUsername(ctx, payload.Username)
+	switch {
+	case err == nil:
+		return nil, types.ErrUsernameTaken
+	case errIsSqlNoRows(err):
+	default:
+		return nil, fmt.Errorf("failed to lookup existing username: %w", err)
+	}
+	u, err := q.RegisterUser(ctx, sqlite.RegisterUserParams{
+		UpdatedAt:    toNullTimeNonNullable(time.Now()),
+		Username:     payload.Username,
+		PasswordHash: toNullString(payload.PasswordHash),
+		ID:           payload.UserID,
+	})
+	if err != nil {
+		if errIsSqlNoRows(err) {
+			return nil, types.ErrAlreadyRegistered
+		}
+		return nil, fmt.Errorf("failed to register user: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return toTypeUser(u), nil
+}
+
+// Returns nil if there is no registered user with the username
+func (p *sqliteStorage) GetRegisteredUser(ctx context.Context, payload types.GetRegisteredUserPayload) (user *types.User, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "GetRegisteredUser")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	u, err := p.queries.GetRegisteredUserByUsername(ctx, payload.Username)
+	if err != nil {
+		if errIsSqlNoRows(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to lookup user by username: %w", err)
+	}
+	return toTypeUser(u), nil
+}
+
+// Creates a new session for an existing user
+func (p *sqliteStorage) CreateSession(ctx context.Context, payload types.CreateSessionPayload) (sess *types.Session, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "CreateSession")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	s, err := p.queries.InsertSession(ctx, sqlite.InsertSessionParams{
+		ID:           payload.SessionID,
+		CreatedAt:    time.Now(),
+		InvalidAfter: payload.InvalidAfter,
+		UserID:       payload.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert session: %w", err)
+	}
+	return toTypeSession(s), nil
+}
+
+func (p *sqliteStorage) RefreshSession(ctx context.Context, payload types.RefreshSessionPayload) (err error) {
+	ctx, span := tracerSqlite.Start(ctx, "RefreshSession")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return err
+	}
+	_, err = p.queries.RefreshSession(ctx, sqlite.RefreshSessionParams{
+		UpdatedAt:    toNullTimeNonNullable(time.Now()),
+		InvalidAfter: payload.InvalidAfter,
+		ID:           payload.SessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to refresh session: %w", err)
+	}
+	return nil
+}
+
+// Moves the user of an expired session over to a new session. If the expired
+// session was already replaced, for instance by a concurrent request with the
+// same session, the session that replaced it is returned instead.
+func (p *sqliteStorage) RenewSession(ctx context.Context, payload types.RenewSessionPayload) (sess *types.Session, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "RenewSession")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	now := time.Now()
+	expired, err := q.ReplaceSession(ctx, sqlite.ReplaceSessionParams{
+		UpdatedAt:  toNullTimeNonNullable(now),
+		ReplacedBy: toNullString(payload.SessionID),
+		ID:         payload.ExpiredSessionID,
+	})
+	if errIsSqlNoRows(err) {
+		expired, err = q.GetSession(ctx, payload.ExpiredSessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the expired session: %w", err)
+		}
+		s, err := q.GetSession(ctx, expired.ReplacedBy.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the session that replaced the expired session: %w", err)
+		}
+		return toTypeSession(s), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace session: %w", err)
+	}
+	s, err := q.InsertSession(ctx, sqlite.InsertSessionParams{
+		ID:           payload.SessionID,
+		CreatedAt:    now,
+		InvalidAfter: payload.InvalidAfter,
+		UserID:       expired.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert session: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return toTypeSession(s), nil
+}
+
+// Deletes expired sessions and link-codes, and the guest-users that are left
+// without any sessions, if they never made a move.
+func (p *sqliteStorage) ReapExpiredSessions(ctx context.Context, payload types.ReapSessionsPayload) (result types.ReapResult, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "ReapExpiredSessions")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return result, err
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	result.Sessions, err = q.DeleteExpiredSessions(ctx, payload.ExpiredBefore)
+	if err != nil {
+		return result, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+	result.LinkCodes, err = q.DeleteExpiredLinkCodes(ctx, time.Now())
+	if err != nil {
+		return result, fmt.Errorf("failed to delete expired link-codes: %w", err)
+	}
+	result.Games, err = q.DeleteGamesForOrphanedUsers(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to delete games for orphaned users: %w", err)
+	}
+	result.Users, err = q.DeleteOrphanedUsers(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to delete orphaned users: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return types.ReapResult{}, err
+	}
+	return result, nil
+}
+
+func (p *sqliteStorage) CreateLinkCode(ctx context.Context, payload types.CreateLinkCodePayload) (code *types.LinkCode, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "CreateLinkCode")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	c, err := p.queries.InsertLinkCode(ctx, sqlite.InsertLinkCodeParams{
+		Code:         payload.Code,
+		CreatedAt:    time.Now(),
+		InvalidAfter: payload.InvalidAfter,
+		UserID:       payload.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert link-code: %w", err)
+	}
+	return &types.LinkCode{
+		Code:         c.Code,
+		CreatedAt:    c.CreatedAt,
+		InvalidAfter: c.InvalidAfter,
+		UserID:       c.UserID,
+	}, nil
+}
+
+// Uses the link-code to create a new session for the user that created the code.
+// The code can only be used once.
+func (p *sqliteStorage) UseLinkCode(ctx context.Context, payload types.UseLinkCodePayload) (user *types.User, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "UseLinkCode")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	now := time.Now()
+	c, err := q.UseLinkCode(ctx, sqlite.UseLinkCodeParams{
+		UsedAt:       toNullTimeNonNullable(now),
+		Code:         payload.Code,
+		InvalidAfter: now,
+	})
+	if err != nil {
+		if errIsSqlNoRows(err) {
+			return nil, types.ErrLinkCodeInvalid
+		}
+		return nil, fmt.Errorf("failed to use link-code: %w", err)
+	}
+	_, err = q.InsertSession(ctx, sqlite.InsertSessionParams{
+		ID:           payload.SessionID,
+		CreatedAt:    now,
+		InvalidAfter: payload.InvalidAfter,
+		UserID:       c.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session for user: %w", err)
+	}
+	u, err := q.GetUser(ctx, c.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for link-code: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return toTypeUser(u), nil
+}
+
 func (p *sqliteStorage) GetOriginalGame(ctx context.Context, payload types.GetOriginalGamePayload) (tg types.Game, err error) {
 
 	ctx, span := tracerSqlite.Start(ctx, "RestartGame")
@@ -903,6 +1624,188 @@ func (p *sqliteStorage) RestartGame(ctx context.Context, payload types.RestartGa
 	}
 	return toTypeGame(&createdGame, &rule, seed, state, cells, playstate)
 }
+
+// Returns the users games matching the filters, the most recently created first.
+func (p *sqliteStorage) ListGames(ctx context.Context, payload types.ListGamesPayload) (response types.GameList, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "ListGames")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	err = payload.Validate()
+	if err != nil {
+		return response, fmt.Errorf("payload-validation-failed: %w", err)
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return response, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	u, err := q.GetUser(ctx, payload.UserID)
+	if err != nil {
+		return response, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+	rows, err := q.GetGamesForUser(ctx, payload.UserID)
+	if err != nil {
+		return response, fmt.Errorf("failed to retrieve games for user: %w", err)
+	}
+	type match struct {
+		game      sqlite.Game
+		rule      sqlite.Rule
+		playState types.PlayState
+	}
+	matches := []match{}
+	for _, g := range rows {
+		if payload.TemplateID != "" && g.TemplateID.String != payload.TemplateID {
+			continue
+		}
+		playState, err := toPlayState(g.PlayState)
+		if err != nil {
+			return response, fmt.Errorf("invalid playstate for game '%s': %w", g.ID, err)
+		}
+		if len(payload.PlayStates) > 0 && !contains(payload.PlayStates, playState) {
+			continue
+		}
+		rule, err := p.ensureRuleExists(ctx, q, types.Rules{ID: g.RuleID})
+		if err != nil {
+			return response, fmt.Errorf("failed to retrieve rule with id '%s': %w", g.RuleID, err)
+		}
+		if len(payload.Modes) > 0 {
+			mode, err := toMode(rule.Mode)
+			if err != nil {
+				return response, err
+			}
+			if !contains(payload.Modes, mode) {
+				continue
+			}
+		}
+		matches = append(matches, match{g, rule, playState})
+	}
+	response.Total = len(matches)
+	response.Games = []types.ListedGame{}
+	if payload.Offset >= len(matches) {
+		return response, nil
+	}
+	end := payload.Offset + payload.Limit
+	if end > len(matches) {
+		end = len(matches)
+	}
+	for _, m := range matches[payload.Offset:end] {
+		cells, seed, state, err := UnmarshalInternalDataGame(ctx, m.game.Data)
+		if err != nil {
+			return response, fmt.Errorf("failed to unmarshal data for game '%s': %w", m.game.ID, err)
+		}
+		g, err := toTypeGame(&m.game, &m.rule, seed, state, cells, m.playState)
+		if err != nil {
+			return response, err
+		}
+		response.Games = append(response.Games, types.ListedGame{
+			Game:       g,
+			TemplateID: m.game.TemplateID.String,
+			Active:     m.game.ID == u.ActiveGameID,
+		})
+	}
+	return response, nil
+}
+
+// Makes an unfinished game the users active game again.
+// The game that was active is abandoned, like when starting a new game.
+func (p *sqliteStorage) ResumeGame(ctx context.Context, payload types.ResumeGamePayload) (tg types.Game, err error) {
+	ctx, span := tracerSqlite.Start(ctx, "ResumeGame")
+	defer func() {
+		AnnotateSpanError(span, err)
+		span.End()
+	}()
+	err = payload.Validate()
+	if err != nil {
+		return tg, fmt.Errorf("payload-validation-failed: %w", err)
+	}
+	q, tx, err := p.beginTx(ctx)
+	if err != nil {
+		return tg, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	g, err := q.GetGame(ctx, payload.GameID)
+	if err != nil {
+		if errIsSqlNoRows(err) {
+			return tg, fmt.Errorf("%w: %s", types.ErrGameNotFound, payload.GameID)
+		}
+		return tg, fmt.Errorf("failed to retrieve game: %w", err)
+	}
+	if g.UserID != payload.UserID {
+		return tg, fmt.Errorf("%w: %s", types.ErrGameNotFound, payload.GameID)
+	}
+	if g.PlayState == PlayStateWon || g.PlayState == PlayStateLost {
+		return tg, fmt.Errorf("%w: %s", types.ErrGameFinished, payload.GameID)
+	}
+	u, err := q.GetUser(ctx, payload.UserID)
+	if err != nil {
+		return tg, fmt.Errorf("failed to retrieve user: %w", err)
+	}
+	now := toNullTimeNonNullable(time.Now())
+	if u.ActiveGameID != g.ID {
+		if u.ActiveGameID != "" {
+			activeGame, err := q.GetGame(ctx, u.ActiveGameID)
+			if err != nil {
+				return tg, fmt.Errorf("failed to retrieve activegame for user: %w", err)
+			}
+			if activeGame.PlayState == PlayStateCurrent {
+				_, err := q.SetPlayStateForGame(ctx, sqlite.SetPlayStateForGameParams{
+					UpdatedAt: now,
+					PlayState: PlayStateAbandoned,
+					ID:        activeGame.ID,
+				})
+				if err != nil {
+					return tg, fmt.Errorf("failed to abandon activegame for user: %w", err)
+				}
+			}
+		}
+		_, err = q.SetActiveGameFormUser(ctx, sqlite.SetActiveGameFormUserParams{
+			UpdatedAt:    now,
+			ActiveGameID: g.ID,
+			ID:           u.ID,
+		})
+		if err != nil {
+			return tg, fmt.Errorf("failed to set activegame for user: %w", err)
+		}
+	}
+	if g.PlayState != PlayStateCurrent {
+		g, err = q.SetPlayStateForGame(ctx, sqlite.SetPlayStateForGameParams{
+			UpdatedAt: now,
+			PlayState: PlayStateCurrent,
+			ID:        g.ID,
+		})
+		if err != nil {
+			return tg, fmt.Errorf("failed to set playstate for game: %w", err)
+		}
+	}
+	rule, err := p.ensureRuleExists(ctx, q, types.Rules{ID: g.RuleID})
+	if err != nil {
+		return tg, fmt.Errorf("failed to retrieve rule with id '%s': %w", g.RuleID, err)
+	}
+	cells, seed, state, err := UnmarshalInternalDataGame(ctx, g.Data)
+	if err != nil {
+		return tg, fmt.Errorf("failed to unmarshal data for game: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return tg, err
+	}
+	return toTypeGame(&g, &rule, seed, state, cells, types.PlayStateCurrent)
+}
+
+func contains[T comparable](list []T, v T) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
 func (p *sqliteStorage) NewGameForUser(ctx context.Context, payload types.NewGamePayload) (tg types.Game, err error) {
 	ctx, span := tracerSqlite.Start(ctx, "NewGameForUser")
 	defer func() {
@@ -918,7 +1821,7 @@ func (p *sqliteStorage) NewGameForUser(ctx context.Context, payload types.NewGam
 	}()
 	return p.newGameForUser(ctx, q, tx, payload)
 }
-func (p *sqliteStorage) newGameForUser(ctx context.Context, q *sqlite.Queries, tx *sql.Tx, payload types.NewGamePayload) (tg types.Game, err error) {
+func (p *sqliteStorage) newGameForUser(ctx context.Context, q sqlite.Querier, tx transaction, payload types.NewGamePayload) (tg types.Game, err error) {
 	// TODO: simplyfy with custom sql-code.
 	if payload.Game.ID == "" {
 		return tg, fmt.Errorf("%w: Game.Id", ErrArgumentRequired)
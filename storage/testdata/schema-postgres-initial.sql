@@ -0,0 +1,139 @@
+-- The schema mirrors schema-sqlite.sql, and the columns are kept in the same
+-- order, so that the generated models are identical. Likewise, the queries in
+-- query-postgres.sql mirror query.sql, with the same names and parameter-order.
+--
+-- Sqlite does not enforce the foreign keys declared in its schema, and the
+-- application relies on that: tutorial-templates are not stored, and a user is
+-- inserted before its active game. Foreign keys are therefore left out here,
+-- and the columns are indexed instead.
+create table if not exists rule
+(
+    id                varchar(21)     not null,
+    slug              varchar(64)      not null,
+    created_at        timestamptz      not null,
+    updated_at        timestamptz,
+    mode              bigint           not null,
+    description       varchar(400)     ,
+    size_x            bigint not null,
+    size_y            bigint not null,
+    max_moves            bigint,
+    target_cell_value            bigint,
+    target_score            bigint,
+    recreate_on_swipe BOOLEAN          not null,
+    no_reswipe        BOOLEAN          not null,
+    no_multiply       BOOLEAN          not null,
+    no_addition       BOOLEAN          not null,
+    no_super_powers   BOOLEAN          not null default false,
+    primary key (id),
+    constraint my_uniq_id
+        unique (slug, description)
+);
+
+create table if not exists game
+(
+    id         varchar(21)    not null,
+    created_at timestamptz default CURRENT_TIMESTAMP not null,
+    updated_at timestamptz,
+    name       varchar(80)     ,
+    description       varchar(400)     ,
+    user_id    varchar(21)    not null,
+    rule_id    varchar(21)    not null,
+    based_on_game varchar(21),
+    template_id    varchar(21),
+    score      bigint not null,
+    moves      bigint    not null,
+    play_state bigint             not null,
+    data       bytea  not null,
+    data_at_start       bytea  not null,
+    history       bytea,
+    primary key (id)
+);
+
+
+create table if not exists users
+(
+    id             varchar(21) not null,
+    created_at     timestamptz     not null,
+    updated_at     timestamptz,
+    username       varchar(21) not null,
+    active_game_id varchar(21) not null,
+    -- Only set for registered users. Guest-users are only reachable from their sessions.
+    password_hash  varchar(200),
+    primary key (id)
+);
+
+
+create table if not exists session
+(
+    id            varchar(21) not null,
+    created_at    timestamptz     not null,
+    updated_at    timestamptz,
+    invalid_after timestamptz     not null,
+    user_id       varchar(21) not null,
+    primary key (id)
+);
+-- One-time codes used to attach a new session to an existing user,
+-- for instance when the user switches to a different device.
+create table if not exists link_code
+(
+    code          varchar(21) not null,
+    created_at    timestamptz     not null,
+    invalid_after timestamptz     not null,
+    used_at       timestamptz,
+    user_id       varchar(21) not null,
+    primary key (code)
+);
+create table if not exists game_template
+(
+    id            varchar(21) not null,
+    created_at    timestamptz     not null,
+    updated_at    timestamptz,
+    rule_id    varchar(21)    not null,
+    created_by    varchar(21)    not null,
+    updated_by    varchar(21),
+    name       varchar(80)     not null,
+    description       varchar(400)     ,
+    challenge_number bigint,
+    ideal_moves bigint,
+    ideal_score bigint,
+    data       bytea  not null,
+    UNIQUE(challenge_number),
+    primary key (id)
+);
+
+create table if not exists vote
+(
+    id            varchar(21) not null,
+    created_at    timestamptz     not null,
+    updated_at    timestamptz,
+    user_id       varchar(21) not null,
+    game_id       varchar(21),
+    template_id   varchar(21),
+    fun_vote      bigint          not null,
+    primary key (id)
+);
+
+
+create unique index if not exists active_game_id
+    on users (active_game_id);
+-- Guest-users may share the generated names, but registered users must be unique
+create unique index if not exists user_username_registered
+    on users (username) where password_hash is not null;
+
+create unique index if not exists slug
+    on rule (slug);
+create unique index if not exists game_template_challenge_number
+    on game_template (challenge_number);
+create unique index if not exists vote_user_template
+    on vote (user_id, template_id);
+create unique index if not exists vote_user_game
+    on vote (user_id, game_id);
+
+create index if not exists game_user_id
+    on game (user_id);
+create index if not exists game_template_id
+    on game (template_id);
+create index if not exists session_user_id
+    on session (user_id);
+create index if not exists link_code_user_id
+    on link_code (user_id);
@@ -3,6 +3,7 @@ package tallylogic
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -23,7 +24,9 @@ type TableBoard struct {
 
 type TableBoardOptions struct {
 	EvaluateOptions
-	Cells []cell.Cell
+	// Decides which cells are neighbours. Defaults to TopologyOrthogonal.
+	Topology Topology
+	Cells    []cell.Cell
 }
 
 type EvaluateOptions struct {
@@ -31,6 +34,24 @@ type EvaluateOptions struct {
 	NoMultiply bool
 	// If set, will not attempt to use addition for evauluation
 	NoAddition bool
+	// If set, will also attempt to subtract the rest of the path from the first cell
+	WithSubtraction bool
+	// If set, will also attempt to divide the first cell by the rest of the path.
+	// Every division must be exact.
+	WithDivision bool
+	// If set, will also attempt chains where each step may use any of the
+	// methods above, evaluated from left to right without precedence.
+	// These chains are limited to MaxMixedChainLength cells.
+	WithMixedOperators bool
+}
+
+// The maximum number of cells (not counting the target) in a chain of mixed operators.
+// The number of possible chains grows exponentially with the length.
+const MaxMixedChainLength = 5
+
+// Whether any of the methods other than addition and multiplication are used
+func (o EvaluateOptions) extended() bool {
+	return o.WithSubtraction || o.WithDivision || o.WithMixedOperators
 }
 
 // TODO: return error here
@@ -43,8 +64,8 @@ func RestoreTableBoard(columns, rows int, cells []cell.Cell, options ...TableBoa
 	for _, v := range options {
 		tb.TableBoardOptions = v
 	}
-	if tb.NoMultiply && tb.NoAddition {
-		panic(fmt.Errorf("NoAddition && NoMultiply cannot both be set (need at least one evauluation-method)"))
+	if tb.NoMultiply && tb.NoAddition && !tb.WithSubtraction && !tb.WithDivision {
+		panic(fmt.Errorf("NoAddition && NoMultiply cannot both be set without WithSubtraction or WithDivision (need at least one evauluation-method)"))
 	}
 	if len(tb.TableBoardOptions.Cells) > 0 {
 		tb.cells = tb.TableBoardOptions.Cells
@@ -137,13 +158,15 @@ func (tb TableBoard) PrintBoard(highlighter func(c CellValuer, index int, padded
 
 	}
 	for i := 0; i < tb.rows; i++ {
-		s += "\n" + headerPrinter(" %02d: ", i*tb.rows)
+		s += "\n" + headerPrinter(" %02d: ", i*tb.columns)
 		for j := 0; j < tb.columns; j++ {
-			index := ((i + 1) * tb.columns) - tb.columns + ((j + 1) + tb.rows) - tb.rows - 1
+			index := i*tb.columns + j
 			value := tb.cells[index].Value()
 			var formatted string
 			if value > 0 {
 				formatted = strconv.FormatInt(int64(value), 10)
+			} else if tb.cells[index].IsBlocked() {
+				formatted = strings.Repeat("#", longest)
 			}
 			padLength := longest - len(formatted)
 
@@ -237,10 +260,10 @@ func (tb TableBoard) CoordToIndex(x, y int) (int, bool) {
 	if y < 0 {
 		return 0, false
 	}
-	if y > tb.rows {
+	if y >= tb.rows {
 		return 0, false
 	}
-	if x > tb.columns {
+	if x >= tb.columns {
 		return 0, false
 	}
 
@@ -254,6 +277,10 @@ const (
 	EvalMethodNil EvalMethod = iota
 	EvalMethodSum
 	EvalMethodProduct
+	EvalMethodSubtraction
+	EvalMethodDivision
+	// A chain of different operators, evaluated from left to right
+	EvalMethodMixed
 )
 
 func PrintEvalMethod(e EvalMethod) string {
@@ -264,6 +291,12 @@ func PrintEvalMethod(e EvalMethod) string {
 		return "EvalMethodProduct"
 	case EvalMethodSum:
 		return "EvalMethodSum"
+	case EvalMethodSubtraction:
+		return "EvalMethodSubtraction"
+	case EvalMethodDivision:
+		return "EvalMethodDivision"
+	case EvalMethodMixed:
+		return "EvalMethodMixed"
 	default:
 		return "EvalMethodUnknown"
 
@@ -282,9 +315,11 @@ var (
 	ErrPathIndexOutsideBounds = errors.New("The path includes an item outside the current bounds")
 	ErrPathIndexInvalidCell   = errors.New("The path-index pointed to an invalid cell")
 	ErrPathIndexEmptyCell     = errors.New("The path-index pointed to an empty cell")
+	ErrPathIndexBlockedCell   = errors.New("The path-index pointed to a blocked cell")
 
 	ErrIndexInvalid               = errors.New("The index for the path is invalid")
 	ErrCellAtIndexAlreadyHasValue = errors.New("The cell at the index already has a value")
+	ErrCellIsBlocked              = errors.New("The cell is blocked")
 )
 
 func (tb TableBoard) ValidatePath(indexes []int) (err error, invalidIndex int) {
@@ -309,6 +344,9 @@ func (tb TableBoard) ValidatePath(indexes []int) (err error, invalidIndex int) {
 			return fmt.Errorf("%w for index %d at position %d", ErrPathIndexOutsideBounds, index, i), i
 		}
 		c := tb.cells[index]
+		if c.IsBlocked() {
+			return fmt.Errorf("%w for index %d at position %d", ErrPathIndexBlockedCell, index, i), i
+		}
 		if c.Value() == 0 {
 			return fmt.Errorf("%w for index %d at position %d", ErrPathIndexEmptyCell, index, i), i
 		}
@@ -367,7 +405,7 @@ func (tb TableBoard) SoftEvaluatesTo(indexes []int, targetValue int64) (int64, E
 			return 0, EvalMethodNil, ErrResultIndexOverflow
 		}
 		cell := tb.cells[index]
-		if cell.IsEmpty() {
+		if !cell.HasValue() {
 			return 0, EvalMethodNil, ErrResultIndexOverflow
 		}
 		v := cell.Value()
@@ -377,7 +415,7 @@ func (tb TableBoard) SoftEvaluatesTo(indexes []int, targetValue int64) (int64, E
 		// return early if we have overshow the targetValue
 		overshotMultiply := !tb.NoMultiply && product > targetValue
 		overshotAddition := !tb.NoAddition && sum > targetValue
-		if overshotAddition && overshotMultiply {
+		if overshotAddition && overshotMultiply && !tb.extended() {
 			return 0, EvalMethodNil, ErrResultOvershot
 		}
 	}
@@ -389,35 +427,139 @@ func (tb TableBoard) SoftEvaluatesTo(indexes []int, targetValue int64) (int64, E
 	if !tb.NoAddition && sum == targetValue {
 		return sum, EvalMethodSum, nil
 	}
+	if !tb.extended() {
+		return 0, EvalMethodNil, ErrResultNoMatch
+	}
+	return tb.softEvaluatesToExtended(indexes, targetValue, sum)
+}
+
+// Like SoftEvaluatesTo, for the methods enabled by EvaluateOptions.extended.
+// The indexes must already be validated by SoftEvaluatesTo.
+func (tb TableBoard) softEvaluatesToExtended(indexes []int, targetValue, sum int64) (int64, EvalMethod, error) {
+	first := tb.cells[indexes[0]].Value()
+	if tb.WithSubtraction && first-(sum-first) == targetValue {
+		return targetValue, EvalMethodSubtraction, nil
+	}
+	if tb.WithDivision {
+		divisor, ok := tb.productOfCells(indexes[1:])
+		if ok && divisor != 0 && first%divisor == 0 && first/divisor == targetValue {
+			return targetValue, EvalMethodDivision, nil
+		}
+	}
+	if tb.WithMixedOperators && len(indexes) <= MaxMixedChainLength && tb.mixedChainEvaluatesTo(indexes, targetValue) {
+		return targetValue, EvalMethodMixed, nil
+	}
 
+	// The hint-calculator extends paths by adding cells in front of them, and
+	// stops when the path has overshot. This is only the case if no cell on the
+	// board can be put in front of the path to reach the targetValue.
+	// The product may have overflowed, in which case no cell is large enough
+	product, productOk := tb.productOfCells(indexes)
+	overshot := (tb.NoAddition || sum > targetValue) && (tb.NoMultiply || !productOk || product > targetValue)
+	if overshot && (tb.WithSubtraction || tb.WithDivision) {
+		highest, _ := tb.HighestValue()
+		if tb.WithSubtraction {
+			overshot = targetValue+sum > highest.Value()
+		}
+		if overshot && tb.WithDivision {
+			needed, ok := multiplyChecked(targetValue, product)
+			overshot = !productOk || !ok || needed > highest.Value()
+		}
+	}
+	if tb.WithMixedOperators && len(indexes) < MaxMixedChainLength {
+		overshot = false
+	}
+	if overshot {
+		return 0, EvalMethodNil, ErrResultOvershot
+	}
 	return 0, EvalMethodNil, ErrResultNoMatch
 }
 
+// Returns the product of the values of the cells, and false if it overflows.
+func (tb TableBoard) productOfCells(indexes []int) (int64, bool) {
+	var product int64 = 1
+	for _, index := range indexes {
+		var ok bool
+		product, ok = multiplyChecked(product, tb.cells[index].Value())
+		if !ok {
+			return 0, false
+		}
+	}
+	return product, true
+}
+
+// Returns a*b, and false if it overflows.
+func multiplyChecked(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return product, true
+}
+
+// Reports whether any chain of the enabled operators, evaluated from left to
+// right, results in the targetValue.
+func (tb TableBoard) mixedChainEvaluatesTo(indexes []int, targetValue int64) bool {
+	values := map[int64]struct{}{tb.cells[indexes[0]].Value(): {}}
+	for _, index := range indexes[1:] {
+		v := tb.cells[index].Value()
+		next := make(map[int64]struct{}, len(values)*4)
+		for x := range values {
+			if !tb.NoAddition {
+				next[x+v] = struct{}{}
+			}
+			if !tb.NoMultiply {
+				next[x*v] = struct{}{}
+			}
+			if tb.WithSubtraction {
+				next[x-v] = struct{}{}
+			}
+			if tb.WithDivision && x%v == 0 {
+				next[x/v] = struct{}{}
+			}
+		}
+		values = next
+	}
+	_, ok := values[targetValue]
+	return ok
+}
+
 func (tb TableBoard) getRows() [][]*cell.Cell {
 	return tb._getColumnsOrRows(true)
 }
 func (tb TableBoard) getColumns() [][]*cell.Cell {
 	return tb._getColumnsOrRows(false)
 }
+
+// Returns the cells grouped by rows, or by columns.
+// For rows, the outer slice has one entry per row, each with one cell per column.
+// For columns, the outer slice has one entry per column, each with one cell per row.
 func (tb TableBoard) _getColumnsOrRows(rows bool) [][]*cell.Cell {
-	var columns = make([][]*cell.Cell, tb.rows)
-	for rowIndex := 0; rowIndex < tb.rows; rowIndex++ {
-		columns[rowIndex] = make([]*cell.Cell, tb.columns)
-		for colIndex := 0; colIndex < tb.columns; colIndex++ {
+	outer, inner := tb.columns, tb.rows
+	if rows {
+		outer, inner = tb.rows, tb.columns
+	}
+	var groups = make([][]*cell.Cell, outer)
+	for o := 0; o < outer; o++ {
+		groups[o] = make([]*cell.Cell, inner)
+		for i := 0; i < inner; i++ {
 			var index int
 			var ok bool
 			if rows {
-				index, ok = tb.CoordToIndex(colIndex, rowIndex)
+				index, ok = tb.CoordToIndex(i, o)
 			} else {
-				index, ok = tb.CoordToIndex(rowIndex, colIndex)
+				index, ok = tb.CoordToIndex(o, i)
 			}
 			if !ok {
-				panic(fmt.Sprintf("overflow in getRows %d %d", rowIndex, colIndex))
+				panic(fmt.Sprintf("overflow in getRows %d %d", o, i))
 			}
-			columns[rowIndex][colIndex] = &tb.cells[index]
+			groups[o][i] = &tb.cells[index]
 		}
 	}
-	return columns
+	return groups
 }
 
 type SwipeDirection string
@@ -485,13 +627,9 @@ func (tb *TableBoard) swipeHorizontal(positive bool) []cell.Cell {
 	rows := tb.getRows()
 	tiles := make([]cell.Cell, len(tb.cells))
 	for ri := 0; ri < len(rows); ri++ {
-		if positive {
-			sort.Sort(PosCellRange(rows[ri]))
-		} else {
-			sort.Sort(NegCellRange(rows[ri]))
-		}
+		sortCellsBetweenBlocked(rows[ri], positive)
 		for i := 0; i < len(rows[ri]); i++ {
-			tiles[i+tb.rows*ri] = *rows[ri][i]
+			tiles[i+tb.columns*ri] = *rows[ri][i]
 		}
 	}
 	return tiles
@@ -502,12 +640,7 @@ func (tb *TableBoard) swipeVertical(positive bool) []cell.Cell {
 	columns := tb.getColumns()
 	tiles := make([]cell.Cell, len(tb.cells))
 	for ci := 0; ci < len(columns); ci++ {
-
-		if positive {
-			sort.Sort(PosCellRange(columns[ci]))
-		} else {
-			sort.Sort(NegCellRange(columns[ci]))
-		}
+		sortCellsBetweenBlocked(columns[ci], positive)
 		for i := 0; i < len(columns[ci]); i++ {
 			// for i, cell := range columns[ci] {
 			tiles[i*tb.columns+ci] = *columns[ci][i]
@@ -516,6 +649,26 @@ func (tb *TableBoard) swipeVertical(positive bool) []cell.Cell {
 	return tiles
 }
 
+// Moves the empty cells to the start (positive) or end of the line.
+// Blocked cells stay in place, and the cells on each side of them are sorted separately,
+// so that no cell slides through a blocked cell.
+func sortCellsBetweenBlocked(line []*cell.Cell, positive bool) {
+	start := 0
+	for i := 0; i <= len(line); i++ {
+		if i < len(line) && !line[i].IsBlocked() {
+			continue
+		}
+		if i-start > 1 {
+			if positive {
+				sort.Sort(PosCellRange(line[start:i]))
+			} else {
+				sort.Sort(NegCellRange(line[start:i]))
+			}
+		}
+		start = i + 1
+	}
+}
+
 type PosCellRange []*cell.Cell
 
 func (pp PosCellRange) Less(i, j int) bool { return pp[i].IsEmpty() }
@@ -539,6 +692,9 @@ func (tb TableBoard) AreNeighboursByIndex(a, b int) bool {
 	if a >= max || b >= max {
 		return false
 	}
+	if tb.Topology != TopologyOrthogonal {
+		return contains(tb.topologyNeighbours(a), b)
+	}
 	ac, ar := tb.IndexToCord(a)
 	bc, br := tb.IndexToCord(b)
 
@@ -574,7 +730,8 @@ func (tb *TableBoard) precalculateNeighboursForCellIndex() {
 
 }
 
-// Returns the neighbours for a gives cell. Note that the cells might be empty
+// Returns the neighbours for a gives cell. Note that the cells might be empty.
+// Blocked cells are neither neighbours, nor have any neighbours.
 func (tb *TableBoard) NeighboursForCellIndex(index int) ([]int, bool) {
 	if index < 0 {
 		return []int{}, false
@@ -590,6 +747,12 @@ func (tb *TableBoard) NeighboursForCellIndex(index int) ([]int, bool) {
 }
 func (tb TableBoard) neighboursForCellIndex(index int) []int {
 	var neighbours []int
+	if tb.cells[index].IsBlocked() {
+		return neighbours
+	}
+	if tb.Topology != TopologyOrthogonal {
+		return tb.withoutBlocked(tb.topologyNeighbours(index))
+	}
 	column, row := tb.IndexToCord(index)
 
 	// Neighbour above
@@ -609,13 +772,33 @@ func (tb TableBoard) neighboursForCellIndex(index int) []int {
 		neighbours = append(neighbours, index+tb.columns)
 	}
 	// This should now be sorted, becouse of the ordering above
-	return neighbours
+	return tb.withoutBlocked(neighbours)
+}
+
+// Removes the indexes of blocked cells, without allocating if there are none.
+func (tb TableBoard) withoutBlocked(indexes []int) []int {
+	for i, index := range indexes {
+		if !tb.cells[index].IsBlocked() {
+			continue
+		}
+		filtered := append([]int{}, indexes[:i]...)
+		for _, index := range indexes[i+1:] {
+			if !tb.cells[index].IsBlocked() {
+				filtered = append(filtered, index)
+			}
+		}
+		return filtered
+	}
+	return indexes
 }
 
 func (tb *TableBoard) AddCellToBoard(c cell.Cell, index int, overwrite bool) error {
 	if !tb.ValidCellIndex(index) {
 		return ErrIndexInvalid
 	}
+	if tb.cells[index].IsBlocked() {
+		return ErrCellIsBlocked
+	}
 	if !overwrite && tb.cells[index].Value() > 0 {
 		return ErrCellAtIndexAlreadyHasValue
 	}
@@ -624,6 +807,18 @@ func (tb *TableBoard) AddCellToBoard(c cell.Cell, index int, overwrite bool) err
 
 }
 
+// Swaps the cells at the two indexes
+func (tb *TableBoard) SwapCells(a, b int) error {
+	if !tb.ValidCellIndex(a) || !tb.ValidCellIndex(b) {
+		return ErrIndexInvalid
+	}
+	if tb.cells[a].IsBlocked() || tb.cells[b].IsBlocked() {
+		return ErrCellIsBlocked
+	}
+	tb.cells[a], tb.cells[b] = tb.cells[b], tb.cells[a]
+	return nil
+}
+
 func (cg *TableBoard) ListEmptyCells() []int {
 	cells := cg.Cells()
 	empty := []int{}
@@ -33,4 +33,6 @@ type BoardController interface {
 	// Returns the neighbours for a gives cell. Note that the cells might be empty
 	NeighboursForCellIndex(index int) ([]int, bool)
 	AddCellToBoard(c cell.Cell, index int, overwrite bool) error
+	// Swaps the cells at the two indexes
+	SwapCells(a, b int) error
 }
@@ -0,0 +1,350 @@
+package tallylogic
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+)
+
+// Board-shapes used for property-testing, including non-square boards.
+var testBoardShapes = [][2]int{
+	// columns, rows
+	{1, 4}, {4, 1},
+	{2, 3}, {3, 2},
+	{3, 3},
+	{3, 5}, {5, 3},
+	{4, 6}, {6, 4},
+	{5, 5},
+	{2, 7}, {7, 2},
+}
+
+func randomTableBoard(columns, rows int, rnd rand.Source) TableBoard {
+	cells := make([]cell.Cell, columns*rows)
+	for i := range cells {
+		// about half the cells are empty
+		if rnd.Int63()%2 == 0 {
+			cells[i] = cell.NewEmptyCell()
+			continue
+		}
+		cells[i] = cell.NewCell(rnd.Int63()%12+1, 0)
+	}
+	return RestoreTableBoard(columns, rows, cells)
+}
+
+// Returns the values of the cells laid out as rows
+func boardValueRows(tb TableBoard) [][]int64 {
+	out := make([][]int64, tb.rows)
+	for r := 0; r < tb.rows; r++ {
+		out[r] = make([]int64, tb.columns)
+		for c := 0; c < tb.columns; c++ {
+			index, ok := tb.CoordToIndex(c, r)
+			if !ok {
+				panic(fmt.Sprintf("invalid coord %d,%d for %dx%d", c, r, tb.columns, tb.rows))
+			}
+			out[r][c] = tb.cells[index].Value()
+		}
+	}
+	return out
+}
+
+// Swipes a line of values manually, moving non-empty values towards the end of the line.
+func swipeLine(line []int64, towardsEnd bool) []int64 {
+	values := []int64{}
+	for _, v := range line {
+		if v != 0 {
+			values = append(values, v)
+		}
+	}
+	out := make([]int64, len(line))
+	if towardsEnd {
+		copy(out[len(line)-len(values):], values)
+	} else {
+		copy(out, values)
+	}
+	return out
+}
+
+// The expected values after a swipe, calculated independently of the TableBoard
+func expectedSwipe(rows [][]int64, dir SwipeDirection) [][]int64 {
+	nRows := len(rows)
+	nCols := len(rows[0])
+	out := make([][]int64, nRows)
+	for r := range out {
+		out[r] = make([]int64, nCols)
+	}
+	switch dir {
+	case SwipeDirectionLeft, SwipeDirectionRight:
+		for r := 0; r < nRows; r++ {
+			copy(out[r], swipeLine(rows[r], dir == SwipeDirectionRight))
+		}
+	case SwipeDirectionUp, SwipeDirectionDown:
+		for c := 0; c < nCols; c++ {
+			column := make([]int64, nRows)
+			for r := 0; r < nRows; r++ {
+				column[r] = rows[r][c]
+			}
+			swiped := swipeLine(column, dir == SwipeDirectionDown)
+			for r := 0; r < nRows; r++ {
+				out[r][c] = swiped[r]
+			}
+		}
+	}
+	return out
+}
+
+func TestTableBoard_Shapes_Swipe(t *testing.T) {
+	dirs := []SwipeDirection{SwipeDirectionUp, SwipeDirectionRight, SwipeDirectionDown, SwipeDirectionLeft}
+	for _, shape := range testBoardShapes {
+		columns, rows := shape[0], shape[1]
+		t.Run(fmt.Sprintf("%dx%d", columns, rows), func(t *testing.T) {
+			rnd := rand.NewSource(int64(columns*100 + rows))
+			for i := 0; i < 50; i++ {
+				tb := randomTableBoard(columns, rows, rnd)
+				for j := 0; j < 8; j++ {
+					dir := dirs[rnd.Int63()%4]
+					want := expectedSwipe(boardValueRows(tb), dir)
+					before := tb.PrintBoard(nil)
+					tb.SwipeDirection(dir)
+					got := boardValueRows(tb)
+					if fmt.Sprint(got) != fmt.Sprint(want) {
+						t.Fatalf("Swipe %s gave unexpected result\nbefore: %s\ngot:  %v\nwant: %v", dir, before, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTableBoard_Shapes_Neighbours(t *testing.T) {
+	for _, shape := range testBoardShapes {
+		columns, rows := shape[0], shape[1]
+		t.Run(fmt.Sprintf("%dx%d", columns, rows), func(t *testing.T) {
+			tb := NewTableBoard(columns, rows)
+			for a := 0; a < columns*rows; a++ {
+				ac, ar := tb.IndexToCord(a)
+				if index, ok := tb.CoordToIndex(ac, ar); !ok || index != a {
+					t.Fatalf("CoordToIndex(IndexToCord(%d)) returned %d, %v", a, index, ok)
+				}
+				neighbours, ok := tb.NeighboursForCellIndex(a)
+				if !ok {
+					t.Fatalf("Failed to get neighbours for %d", a)
+				}
+				isNeighbour := map[int]bool{}
+				for _, n := range neighbours {
+					isNeighbour[n] = true
+				}
+				for b := 0; b < columns*rows; b++ {
+					bc, br := tb.IndexToCord(b)
+					dc, dr := ac-bc, ar-br
+					want := (dc == 0 && (dr == 1 || dr == -1)) || (dr == 0 && (dc == 1 || dc == -1))
+					if isNeighbour[b] != want {
+						t.Errorf("NeighboursForCellIndex(%d) includes %d: %v, want %v", a, b, isNeighbour[b], want)
+					}
+					if got := tb.AreNeighboursByIndex(a, b); got != want {
+						t.Errorf("AreNeighboursByIndex(%d, %d) = %v, want %v", a, b, got, want)
+					}
+				}
+			}
+			if _, ok := tb.CoordToIndex(columns, 0); ok {
+				t.Errorf("CoordToIndex should not allow a column outside of the board")
+			}
+			if _, ok := tb.CoordToIndex(0, rows); ok {
+				t.Errorf("CoordToIndex should not allow a row outside of the board")
+			}
+		})
+	}
+}
+
+func TestCompactHistory_Shapes(t *testing.T) {
+	for _, shape := range testBoardShapes {
+		columns, rows := shape[0], shape[1]
+		if columns*rows < 2 {
+			continue
+		}
+		t.Run(fmt.Sprintf("%dx%d", columns, rows), func(t *testing.T) {
+			rnd := rand.NewSource(int64(columns*100 + rows))
+			for i := 0; i < 20; i++ {
+				history := historyStringCreator(t, columns, rows, rnd, 20)
+				c := NewCompactHistory(columns, rows)
+				all := []Instruction_{}
+				tb := newTableBoard(t, columns, rows)
+				for _, ins := range parseHistoryStringForTest(t, history) {
+					all = append(all, ins)
+					switch {
+					case ins.IsSwipe:
+						c.AddSwipe(ins.Direction)
+					case ins.IsPath:
+						if err, invalidIndex := tb.ValidatePath(ins.Path); err != nil {
+							t.Fatalf("SanityTest: invalid path at index %d: %v", invalidIndex, err)
+						}
+						if err := c.AddPath(ins.Path); err != nil {
+							t.Fatalf("failed to add path %v: %v", ins.Path, err)
+						}
+					case ins.IsHelper:
+						switch ins.Helper {
+						case helperHint:
+							c.AddHint()
+						case helperSwap:
+							c.AddSwap(ins.Path[0], ins.Path[1])
+						case helperUndo:
+							c.AddUndo()
+						case helperRedo:
+							c.AddRedo()
+						}
+					}
+				}
+				if got := c.Describe(); got != history {
+					t.Fatalf("History did not roundtrip\ngot:  %s\nwant: %s", got, history)
+				}
+				restored := NewCompactHistoryFromBinary(columns, rows, c.BytesCopy())
+				got, err := restored.All()
+				if err != nil {
+					t.Fatalf("failed to read restored history: %v", err)
+				}
+				if len(got) != len(all) {
+					t.Fatalf("Expected %d instructions, got %d", len(all), len(got))
+				}
+				for j := range all {
+					if !got[j].Equal(all[j]) {
+						t.Fatalf("Instruction %d did not roundtrip: got %v, want %v", j, got[j], all[j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGame_Shapes_Replay(t *testing.T) {
+	dirs := []SwipeDirection{SwipeDirectionUp, SwipeDirectionRight, SwipeDirectionDown, SwipeDirectionLeft}
+	for _, shape := range testBoardShapes {
+		columns, rows := shape[0], shape[1]
+		t.Run(fmt.Sprintf("%dx%d", columns, rows), func(t *testing.T) {
+			rnd := rand.NewSource(int64(columns*100 + rows))
+			for i := 0; i < 10; i++ {
+				template := NewGameTemplate(GameModeTutorial, "shape", "shape", "", rows, columns)
+				template.Rules.RecreateOnSwipe = true
+				template.Board = randomTableBoard(columns, rows, rnd)
+				g, err := NewGame(GameModeTutorial, template, NewGameOptions{Seed: uint64(i + 1), State: 1})
+				if err != nil {
+					t.Fatalf("failed to create game: %v", err)
+				}
+				if g.Rules.SizeX != columns || g.Rules.SizeY != rows {
+					t.Fatalf("Expected game of size %dx%d, got %dx%d", columns, rows, g.Rules.SizeX, g.Rules.SizeY)
+				}
+				original := g.Copy()
+				for j := 0; j < 30; j++ {
+					hints := g.GetHint()
+					if len(hints) > 0 && rnd.Int63()%2 == 0 {
+						for _, h := range hints {
+							if !g.EvaluateForPath(h.Path) {
+								t.Fatalf("Failed to evaluate hint %v\n%s", h.Path, g.Print())
+							}
+							break
+						}
+						continue
+					}
+					g.Swipe(dirs[rnd.Int63()%4])
+				}
+				history, err := g.History.All()
+				if err != nil {
+					t.Fatalf("failed to read history: %v", err)
+				}
+				replay := original.Copy()
+				for j, ins := range history {
+					if !replay.Instruct(ins) {
+						t.Fatalf("Failed to replay instruction %d %v\n%s", j, ins, replay.Print())
+					}
+				}
+				if replay.board.Hash() != g.board.Hash() {
+					t.Fatalf("Replay did not produce the same board\ngot: %s\nwant: %s", replay.Print(), g.Print())
+				}
+				if replay.Score() != g.Score() {
+					t.Errorf("Replay did not produce the same score: got %d, want %d", replay.Score(), g.Score())
+				}
+			}
+		})
+	}
+}
+
+// Parses the history-string format used in these tests, like "U;R;6,1,2;H;"
+func parseHistoryStringForTest(t *testing.T, history string) []Instruction_ {
+	t.Helper()
+	out := []Instruction_{}
+	for _, x := range strings.Split(history, ";") {
+		switch x {
+		case "":
+			continue
+		case "U":
+			out = append(out, NewSwipeInstruction_(SwipeDirectionUp))
+		case "R":
+			out = append(out, NewSwipeInstruction_(SwipeDirectionRight))
+		case "D":
+			out = append(out, NewSwipeInstruction_(SwipeDirectionDown))
+		case "L":
+			out = append(out, NewSwipeInstruction_(SwipeDirectionLeft))
+		case "H":
+			out = append(out, NewHelperInstruction_(helperHint))
+		case "Z":
+			out = append(out, NewHelperInstruction_(helperUndo))
+		case "Y":
+			out = append(out, NewHelperInstruction_(helperRedo))
+		default:
+			if strings.HasPrefix(x, "S") {
+				var a, b int
+				if _, err := fmt.Sscanf(x, "S%d,%d", &a, &b); err != nil {
+					t.Fatalf("Failed to parse swap-indexes from history %s: %v", x, err)
+				}
+				out = append(out, NewSwapInstruction_(a, b))
+				continue
+			}
+			pathStr := strings.Split(x, ",")
+			path := make([]int, len(pathStr))
+			for i, p := range pathStr {
+				j, err := strconv.Atoi(p)
+				if err != nil {
+					t.Fatalf("Failed to parse path-index from history %s: %v", x, err)
+				}
+				path[i] = j
+			}
+			out = append(out, NewPathInstruction_(path))
+		}
+	}
+	return out
+}
+
+func TestGameGenerator_Shapes(t *testing.T) {
+	for _, shape := range testBoardShapes {
+		columns, rows := shape[0], shape[1]
+		if columns*rows < 4 {
+			continue
+		}
+		t.Run(fmt.Sprintf("%dx%d", columns, rows), func(t *testing.T) {
+			gb, err := NewGameGenerator(GameGeneratorOptions{
+				Rows:                rows,
+				Columns:             columns,
+				GoalChecker:         GoalCheckLargestCell{TargetCellValue: 12},
+				TargetCellValue:     12,
+				MinBricks:           1,
+				MaxBricks:           3,
+				Seed:                uint64(columns*100 + rows),
+				GameSolutionChannel: make(chan SolvableGame),
+			})
+			if err != nil {
+				t.Fatalf("failed to create generator: %v", err)
+			}
+			for i := 0; i < 10; i++ {
+				g := gb.generateGame()
+				if g.Rules.SizeX != columns || g.Rules.SizeY != rows {
+					t.Fatalf("Expected game of size %dx%d, got %dx%d", columns, rows, g.Rules.SizeX, g.Rules.SizeY)
+				}
+				if len(g.Cells()) != columns*rows {
+					t.Fatalf("Expected %d cells, got %d", columns*rows, len(g.Cells()))
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package tallylogic
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -210,6 +211,82 @@ func TestTableBoard_swipe(t *testing.T) {
 				4, 2, 5, 6,
 			),
 		},
+		{
+			"Swipe Left with blocked cells",
+			fields{
+				cellCreator(
+					1, BlockedCell, 0, 2,
+					0, 3, BlockedCell, 4,
+					BlockedCell, 0, 0, 5,
+					0, 0, 6, 0,
+				),
+				4, 4,
+			},
+			SwipeDirectionLeft,
+			cellCreator(
+				1, BlockedCell, 2, 0,
+				3, 0, BlockedCell, 4,
+				BlockedCell, 5, 0, 0,
+				6, 0, 0, 0,
+			),
+		},
+		{
+			"Swipe Right with blocked cells",
+			fields{
+				cellCreator(
+					1, BlockedCell, 0, 2,
+					0, 3, BlockedCell, 4,
+					BlockedCell, 5, 0, 0,
+					0, 0, 6, 0,
+				),
+				4, 4,
+			},
+			SwipeDirectionRight,
+			cellCreator(
+				1, BlockedCell, 0, 2,
+				0, 3, BlockedCell, 4,
+				BlockedCell, 0, 0, 5,
+				0, 0, 0, 6,
+			),
+		},
+		{
+			"Swipe Up with blocked cells",
+			fields{
+				cellCreator(
+					0, BlockedCell, 1, 0,
+					2, 0, BlockedCell, 0,
+					BlockedCell, 3, 0, 4,
+					0, 0, 5, 0,
+				),
+				4, 4,
+			},
+			SwipeDirectionUp,
+			cellCreator(
+				2, BlockedCell, 1, 4,
+				0, 3, BlockedCell, 0,
+				BlockedCell, 0, 5, 0,
+				0, 0, 0, 0,
+			),
+		},
+		{
+			"Swipe Down with blocked cells",
+			fields{
+				cellCreator(
+					0, BlockedCell, 1, 0,
+					2, 0, BlockedCell, 0,
+					BlockedCell, 3, 0, 4,
+					0, 0, 5, 0,
+				),
+				4, 4,
+			},
+			SwipeDirectionDown,
+			cellCreator(
+				0, BlockedCell, 1, 0,
+				2, 0, BlockedCell, 0,
+				BlockedCell, 0, 0, 0,
+				0, 3, 5, 4,
+			),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -407,6 +484,188 @@ func Benchmark_TableBoardSwipeHorizontal(b *testing.B) {
 	})
 }
 
+func TestTableBoard_BlockedCells(t *testing.T) {
+	// A donut-shaped board
+	tb := RestoreTableBoard(3, 3, cellCreator(
+		1, 2, 3,
+		4, BlockedCell, 4,
+		0, 2, 2,
+	))
+	t.Run("Blocked cells are neither neighbours, nor have any neighbours", func(t *testing.T) {
+		for index, want := range map[int][]int{
+			1: {0, 2},
+			3: {0, 6},
+			4: nil,
+			7: {6, 8},
+		} {
+			got, ok := tb.NeighboursForCellIndex(index)
+			if !ok || !reflect.DeepEqual(got, want) {
+				t.Errorf("TableBoard.NeighboursForCellIndex(%d) = %v, want %v", index, got, want)
+			}
+		}
+	})
+	t.Run("Paths cannot cross blocked cells", func(t *testing.T) {
+		err, invalidIndex := tb.ValidatePath([]int{3, 4, 5})
+		if !errors.Is(err, ErrPathIndexBlockedCell) || invalidIndex != 1 {
+			t.Errorf("TableBoard.ValidatePath() = %v at %d, want %v at 1", err, invalidIndex, ErrPathIndexBlockedCell)
+		}
+		if _, _, err := tb.EvaluatesTo([]int{1, 4, 7}, false, false); !errors.Is(err, ErrPathIndexBlockedCell) {
+			t.Errorf("TableBoard.EvaluatesTo() error = %v, want %v", err, ErrPathIndexBlockedCell)
+		}
+	})
+	t.Run("Blocked cells cannot be filled or swapped", func(t *testing.T) {
+		board := tb.Copy()
+		if !reflect.DeepEqual(board.ListEmptyCells(), []int{6}) {
+			t.Errorf("TableBoard.ListEmptyCells() = %v, want [6]", board.ListEmptyCells())
+		}
+		if err := board.AddCellToBoard(cell.NewCell(1, 0), 4, true); !errors.Is(err, ErrCellIsBlocked) {
+			t.Errorf("TableBoard.AddCellToBoard() error = %v, want %v", err, ErrCellIsBlocked)
+		}
+		if err := board.SwapCells(1, 4); !errors.Is(err, ErrCellIsBlocked) {
+			t.Errorf("TableBoard.SwapCells() error = %v, want %v", err, ErrCellIsBlocked)
+		}
+	})
+}
+
+func TestTableBoard_Topology(t *testing.T) {
+	cells := func() []cell.Cell {
+		return cellCreator(
+			1, 2, 3, 4,
+			5, 6, 7, 8,
+			9, 10, 11, BlockedCell,
+		)
+	}
+	tests := []struct {
+		name       string
+		topology   Topology
+		neighbours map[int][]int
+		valid      [][]int
+		invalid    [][]int
+	}{
+		{
+			"Orthogonal",
+			TopologyOrthogonal,
+			map[int][]int{0: {1, 4}, 5: {1, 4, 6, 9}, 7: {3, 6}},
+			[][]int{{0, 1, 5}},
+			[][]int{{0, 5}, {3, 0}},
+		},
+		{
+			"Eight-way includes the diagonals",
+			TopologyEightWay,
+			map[int][]int{0: {1, 4, 5}, 5: {0, 1, 2, 4, 6, 8, 9, 10}, 7: {2, 3, 6, 10}},
+			[][]int{{0, 5, 10}, {3, 6, 9}},
+			[][]int{{3, 0}, {0, 2}},
+		},
+		{
+			"Toroidal wraps around the edges",
+			TopologyToroidal,
+			map[int][]int{0: {1, 3, 4, 8}, 5: {1, 4, 6, 9}, 7: {3, 4, 6}},
+			[][]int{{3, 0, 8}, {4, 7, 3}},
+			[][]int{{0, 5}, {10, 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := RestoreTableBoard(4, 3, cells(), TableBoardOptions{Topology: tt.topology})
+			for index, want := range tt.neighbours {
+				got, ok := tb.NeighboursForCellIndex(index)
+				if !ok || !reflect.DeepEqual(got, want) {
+					t.Errorf("TableBoard.NeighboursForCellIndex(%d) = %v, want %v", index, got, want)
+				}
+				for _, n := range want {
+					if !tb.AreNeighboursByIndex(index, n) {
+						t.Errorf("TableBoard.AreNeighboursByIndex(%d, %d) = false, want true", index, n)
+					}
+				}
+			}
+			for _, path := range tt.valid {
+				if err, invalidIndex := tb.ValidatePath(path); err != nil {
+					t.Errorf("TableBoard.ValidatePath(%v) = %v at %d, want no error", path, err, invalidIndex)
+				}
+			}
+			for _, path := range tt.invalid {
+				if err, invalidIndex := tb.ValidatePath(path); err == nil || invalidIndex != 1 {
+					t.Errorf("TableBoard.ValidatePath(%v) = %v at %d, want an error at 1", path, err, invalidIndex)
+				}
+			}
+		})
+	}
+}
+
+func TestTableBoard_EvaluatesToWithOperators(t *testing.T) {
+	cells := cellCreator(
+		12, 3, 2, 4,
+		20, 5, 2, 8,
+	)
+	all := EvaluateOptions{WithSubtraction: true, WithDivision: true, WithMixedOperators: true}
+	tests := []struct {
+		name        string
+		options     EvaluateOptions
+		indexes     []int
+		targetValue int64
+		wantMethod  EvalMethod
+		wantErr     error
+	}{
+		{"Subtraction", EvaluateOptions{WithSubtraction: true}, []int{0, 1}, 9, EvalMethodSubtraction, nil},
+		{"Subtraction of several cells", EvaluateOptions{WithSubtraction: true}, []int{4, 1, 6}, 15, EvalMethodSubtraction, nil},
+		{"Subtraction is opt-in", EvaluateOptions{}, []int{0, 1}, 9, EvalMethodNil, ErrResultOvershot},
+		{"Subtraction without addition and multiplication", EvaluateOptions{NoAddition: true, NoMultiply: true, WithSubtraction: true}, []int{0, 1}, 9, EvalMethodSubtraction, nil},
+		{"Division", EvaluateOptions{WithDivision: true}, []int{0, 1, 2}, 2, EvalMethodDivision, nil},
+		{"Division must be exact", EvaluateOptions{WithDivision: true}, []int{1, 2}, 1, EvalMethodNil, ErrResultNoMatch},
+		{"Mixed operators are evaluated left to right", EvaluateOptions{WithMixedOperators: true}, []int{1, 2, 3}, 20, EvalMethodMixed, nil},
+		{"Mixed operators with subtraction and division", all, []int{0, 1, 3, 5}, 21, EvalMethodMixed, nil},
+		{"Mixed operators are limited in length", EvaluateOptions{WithMixedOperators: true}, []int{0, 4, 5, 6, 7}, 3, EvalMethodNil, ErrResultOvershot},
+		{"Prefer multiplication", all, []int{1, 2}, 6, EvalMethodProduct, nil},
+		{"Prefer addition over the new methods", all, []int{1, 2}, 5, EvalMethodSum, nil},
+		{"Not overshot while a cell in front could match", EvaluateOptions{WithSubtraction: true}, []int{2, 3}, 5, EvalMethodNil, ErrResultNoMatch},
+		{"Overshot when no cell in front could match", EvaluateOptions{WithSubtraction: true, WithDivision: true}, []int{4, 1}, 9, EvalMethodNil, ErrResultOvershot},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := RestoreTableBoard(4, 2, cells, TableBoardOptions{EvaluateOptions: tt.options})
+			gotNum, gotMethod, err := tb.SoftEvaluatesTo(tt.indexes, tt.targetValue)
+			if err != tt.wantErr {
+				t.Fatalf("TableBoard.SoftEvaluatesTo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotMethod != tt.wantMethod {
+				t.Errorf("TableBoard.SoftEvaluatesTo() Method: got %s, want %s", PrintEvalMethod(gotMethod), PrintEvalMethod(tt.wantMethod))
+			}
+			if tt.wantErr == nil && gotNum != tt.targetValue {
+				t.Errorf("TableBoard.SoftEvaluatesTo() got = %v, want %v", gotNum, tt.targetValue)
+			}
+		})
+	}
+}
+func TestTableBoard_EvaluatesToWithOverflow(t *testing.T) {
+	// 1<<32 * 1<<32 overflows int64 to 0
+	large := cell.NewCell(1, 32)
+	cells := []cell.Cell{
+		cell.NewCell(3, 0), large, large, cell.NewCell(2, 0),
+		large, large, large, cell.NewCell(1, 0),
+	}
+	all := EvaluateOptions{WithSubtraction: true, WithDivision: true, WithMixedOperators: true}
+	tests := []struct {
+		name        string
+		options     EvaluateOptions
+		indexes     []int
+		targetValue int64
+		wantErr     error
+	}{
+		{"Division by an overflowing product", EvaluateOptions{WithDivision: true}, []int{0, 1, 2}, 3, ErrResultOvershot},
+		{"Division of large cells", EvaluateOptions{WithDivision: true}, []int{4, 5, 6}, 1, ErrResultOvershot},
+		{"Overshot by an overflowing product", EvaluateOptions{WithDivision: true}, []int{3, 1, 2}, 2, ErrResultOvershot},
+		{"Mixed operators of large cells", all, []int{0, 1, 2}, 7, ErrResultNoMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := RestoreTableBoard(4, 2, cells, TableBoardOptions{EvaluateOptions: tt.options})
+			_, _, err := tb.SoftEvaluatesTo(tt.indexes, tt.targetValue)
+			if err != tt.wantErr {
+				t.Fatalf("TableBoard.SoftEvaluatesTo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
 func TestTableBoard_AreNeighboursByIndex(t *testing.T) {
 	type fields struct {
 		cells             []cell.Cell
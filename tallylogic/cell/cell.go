@@ -10,9 +10,19 @@ type Cell struct {
 	power     int
 }
 
+// The base-value of a blocked cell. Blocked cells are walls or holes in the board,
+// which cannot hold a value, and which swipes and paths cannot pass through.
+//
+// The base-value is used as-is when the cells are stored and sent to clients,
+// and in layouts of templates.
+const BlockedBaseValue int64 = -1
+
 func NewEmptyCell() Cell {
 	return NewCell(0, 0)
 }
+func NewBlockedCell() Cell {
+	return NewCell(BlockedBaseValue, 0)
+}
 func NewCellCopy(c Cell) Cell {
 	return NewCell(c.baseValue, c.power)
 }
@@ -33,6 +43,14 @@ func (c Cell) Equal(b Cell) bool {
 func (c *Cell) IsEmpty() bool {
 	return c.baseValue == 0
 }
+func (c Cell) IsBlocked() bool {
+	return c.baseValue == BlockedBaseValue
+}
+
+// Reports whether the cell holds a value, e.g. is neither empty nor blocked
+func (c Cell) HasValue() bool {
+	return c.baseValue > 0
+}
 func (c Cell) Doubled() Cell {
 	return NewCell(c.baseValue, c.power+1)
 }
@@ -41,12 +59,18 @@ func (c Cell) Raw() (int64, int64) {
 	return c.baseValue, int64(c.power)
 }
 func (c Cell) Value() int64 {
+	if c.baseValue < 0 {
+		return 0
+	}
 	if c.power == 0 {
 		return c.baseValue
 	}
 	return c.baseValue * (int64(math.Pow(2, float64(c.power))))
 }
 func (c Cell) String() string {
+	if c.IsBlocked() {
+		return "#"
+	}
 	return strconv.FormatInt(c.Value(), 10)
 }
 func (c Cell) Hash() int64 {
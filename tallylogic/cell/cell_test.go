@@ -26,6 +26,7 @@ func TestCell_Value(t *testing.T) {
 		{7, 1, 14},
 		{7, 2, 28},
 		{7, 3, 56},
+		{BlockedBaseValue, 0, 0},
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("should return correct for %d %d = %d", tt.baseValue, tt.power, tt.want), func(t *testing.T) {
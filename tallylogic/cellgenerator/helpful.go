@@ -26,7 +26,7 @@ func (cg *helpfulGenerator) GenerateAt(index int, board BoardController) cell.Ce
 	withValue := 0
 	for i, v := range cells {
 		cellValues[i] = cellValueIndex{v.Value(), i}
-		if !v.IsEmpty() {
+		if v.HasValue() {
 			withValue++
 		}
 	}
@@ -0,0 +1,104 @@
+package tallylogic
+
+import (
+	"github.com/runar-rkmedia/gotally/randomizer"
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+	"github.com/runar-rkmedia/gotally/tallylogic/cellgenerator"
+)
+
+// A checkpoint is created for every CheckpointInterval moves in effect.
+const CheckpointInterval = 25
+
+// A snapshot of the game, so that undo can replay the history from here,
+// instead of from the start of the game.
+type Checkpoint struct {
+	// The number of moves in effect (not undone) when the snapshot was taken
+	Depth int
+	Score int64
+	Cells []cell.Cell
+	// The state of the cell-generator
+	Seed, State uint64
+}
+
+// Returns a snapshot of the game as it is now.
+func (g Game) Checkpoint() (Checkpoint, error) {
+	depth, err := g.Depth()
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	return g.checkpoint(depth), nil
+}
+
+// Returns the checkpoints created by undo, ordered by Depth.
+func (g Game) Checkpoints() []Checkpoint {
+	return g.checkpoints
+}
+
+// Adds a checkpoint, typically one that was stored earlier, so that undo can
+// replay from it. The checkpoint must belong to the moves currently in effect.
+func (g *Game) AddCheckpoint(c Checkpoint) {
+	checkpoints := make([]Checkpoint, 0, len(g.checkpoints)+1)
+	for _, existing := range g.checkpoints {
+		if existing.Depth < c.Depth {
+			checkpoints = append(checkpoints, existing)
+		}
+	}
+	checkpoints = append(checkpoints, c)
+	for _, existing := range g.checkpoints {
+		if existing.Depth > c.Depth {
+			checkpoints = append(checkpoints, existing)
+		}
+	}
+	g.checkpoints = checkpoints
+}
+
+func (g Game) checkpoint(depth int) Checkpoint {
+	cells := g.Cells()
+	c := make([]cell.Cell, len(cells))
+	copy(c, cells)
+	seed, state := g.Seed()
+	return Checkpoint{
+		Depth: depth,
+		Score: g.score,
+		Cells: c,
+		Seed:  seed,
+		State: state,
+	}
+}
+
+// Returns the latest checkpoint at or before the depth, or the start of the game.
+func (g Game) checkpointAtOrBefore(depth int) *Checkpoint {
+	for i := len(g.checkpoints) - 1; i >= 0; i-- {
+		if g.checkpoints[i].Depth <= depth {
+			return &g.checkpoints[i]
+		}
+	}
+	return g.start
+}
+
+// Drops the checkpoints after the depth, since these no longer belong to the
+// moves in effect.
+func (g *Game) dropCheckpointsAfter(depth int) {
+	checkpoints := []Checkpoint{}
+	for _, c := range g.checkpoints {
+		if c.Depth <= depth {
+			checkpoints = append(checkpoints, c)
+		}
+	}
+	g.checkpoints = checkpoints
+}
+
+// Sets the board, score and cell-generator as they were at the checkpoint.
+// The board and cell-generator are replaced, not changed, so that copies of
+// the game are left as they were.
+func (g *Game) restoreCheckpoint(c Checkpoint) {
+	board := g.board.Copy()
+	for i, v := range c.Cells {
+		board.AddCellToBoard(cell.NewCellCopy(v), i, true)
+	}
+	g.board = board
+	g.Hinter = NewHintCalculator(g.board, g.board, g.board)
+	g.cellGenerator = cellgenerator.NewCellGenerator(randomizer.NewRandomizerFromSeed(c.Seed, c.State))
+	g.score = c.Score
+	g.ClearSelection()
+}
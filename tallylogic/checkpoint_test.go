@@ -0,0 +1,133 @@
+package tallylogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+// Plays the game with hints and swipes, until it has made the number of moves,
+// and returns the board after each move, starting with the board before any moves.
+func playMovesForTest(t *testing.T, g *Game, moves int) []string {
+	t.Helper()
+	boards := []string{g.Print()}
+outer:
+	for len(boards) <= moves {
+		if hints := g.GetHintConsistantly(context.Background(), 1); len(hints) > 0 {
+			testza.AssertTrue(t, g.EvaluateForPath(hints[0].Path))
+			boards = append(boards, g.Print())
+			continue
+		}
+		for _, dir := range []SwipeDirection{SwipeDirectionUp, SwipeDirectionRight, SwipeDirectionDown, SwipeDirectionLeft} {
+			if g.Swipe(dir) {
+				boards = append(boards, g.Print())
+				continue outer
+			}
+		}
+		t.Fatalf("the game ran out of moves after %d moves: %s", len(boards)-1, g.Print())
+	}
+	return boards
+}
+
+func TestGame_Checkpoints(t *testing.T) {
+	t.Run("Undo should restore the exact board, also for generated cells", func(t *testing.T) {
+		g := mustCreateNewGameForTest(GameModeRandom, nil)()
+		moves := CheckpointInterval*2 + 5
+		boards := playMovesForTest(t, &g, moves)
+		testza.AssertLen(t, g.Checkpoints(), 0, "Expected checkpoints to only be created by undo")
+
+		testza.AssertNoError(t, g.UndoSteps(3))
+		testza.AssertEqual(t, boards[moves-3], g.Print())
+		testza.AssertEqual(t, moves+3, g.Moves(), "Expected every step of the undo to count as a move")
+		depth, err := g.Depth()
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, moves-3, depth)
+		checkpoints := g.Checkpoints()
+		testza.AssertLen(t, checkpoints, 2)
+		testza.AssertEqual(t, CheckpointInterval, checkpoints[0].Depth)
+		testza.AssertEqual(t, CheckpointInterval*2, checkpoints[1].Depth)
+
+		testza.AssertNoError(t, g.Undo())
+		testza.AssertEqual(t, boards[moves-4], g.Print())
+
+		// The undo below the last checkpoint should drop it
+		testza.AssertNoError(t, g.UndoSteps(moves-4-CheckpointInterval*2+1))
+		testza.AssertEqual(t, boards[CheckpointInterval*2-1], g.Print())
+		testza.AssertLen(t, g.Checkpoints(), 1)
+	})
+	t.Run("Undo should only replay from the latest checkpoint", func(t *testing.T) {
+		g := mustCreateNewGameForTest(GameModeRandom, nil)()
+		boards := playMovesForTest(t, &g, CheckpointInterval+3)
+		testza.AssertNoError(t, g.UndoSteps(2))
+		testza.AssertEqual(t, boards[CheckpointInterval+1], g.Print())
+
+		// Without the start of the game, undo can only use the checkpoint
+		g.start = nil
+		testza.AssertNoError(t, g.UndoSteps(1))
+		testza.AssertEqual(t, boards[CheckpointInterval], g.Print())
+		err := g.UndoSteps(1)
+		testza.AssertNotNil(t, err)
+		testza.AssertEqual(t, boards[CheckpointInterval], g.Print(), "Expected a failed undo to leave the game as it was")
+
+		restored := mustCreateNewGameForTest(GameModeRandom, nil)()
+		playMovesForTest(t, &restored, CheckpointInterval+3)
+		restored.start = nil
+		checkpoint := g.Checkpoints()[0]
+		restored.AddCheckpoint(checkpoint)
+		testza.AssertNoError(t, restored.UndoSteps(3))
+		testza.AssertEqual(t, boards[CheckpointInterval], restored.Print())
+	})
+	t.Run("Redo should make the undone moves again", func(t *testing.T) {
+		g := mustCreateNewGameForTest(GameModeRandom, nil)()
+		boards := playMovesForTest(t, &g, 6)
+		testza.AssertFalse(t, g.CanRedo())
+		testza.AssertErrorIs(t, g.Redo(), ErrNoMoreHistoryToRedo)
+
+		testza.AssertNoError(t, g.UndoSteps(3))
+		testza.AssertTrue(t, g.CanRedo())
+		testza.AssertNoError(t, g.Redo())
+		testza.AssertEqual(t, boards[4], g.Print())
+		testza.AssertNoError(t, g.Redo())
+		testza.AssertEqual(t, boards[5], g.Print())
+		testza.AssertNoError(t, g.Undo())
+		testza.AssertEqual(t, boards[4], g.Print())
+		testza.AssertNoError(t, g.Redo())
+		testza.AssertNoError(t, g.Redo())
+		testza.AssertEqual(t, boards[6], g.Print())
+		testza.AssertFalse(t, g.CanRedo())
+
+		testza.AssertNoError(t, g.Undo())
+		playMovesForTest(t, &g, 1)
+		testza.AssertFalse(t, g.CanRedo(), "Expected a new move to discard the undone moves")
+		done, undone, err := g.History.UndoStacks()
+		testza.AssertNoError(t, err)
+		testza.AssertLen(t, done, 6)
+		testza.AssertLen(t, undone, 0)
+	})
+	t.Run("Should replay undo and redo", func(t *testing.T) {
+		g := mustCreateNewGameForTest(GameModeRandom, nil)()
+		seed, state := g.Seed()
+		atStart := types.Game{
+			Rules: types.Rules{Mode: types.RuleModeInfiniteNormal, Rows: 5, Columns: 5, RecreateOnSwipe: true},
+			Cells: append(g.Cells()[:0:0], g.Cells()...),
+			Seed:  seed,
+			State: state,
+		}
+		playMovesForTest(t, &g, CheckpointInterval+2)
+		testza.AssertNoError(t, g.UndoSteps(4))
+		testza.AssertNoError(t, g.Redo())
+		playMovesForTest(t, &g, 2)
+		seed, state = g.Seed()
+		replay, err := ReplayGame(atStart, g.History.BytesCopy())
+		testza.AssertNoError(t, err)
+		testza.AssertNoError(t, replay.Verify(types.Game{
+			Seed:  seed,
+			State: state,
+			Score: uint64(g.Score()),
+			Moves: uint(g.Moves()),
+			Cells: g.Cells(),
+		}))
+	})
+}
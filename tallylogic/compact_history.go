@@ -36,12 +36,48 @@ const (
 	bitgroupModePathDownEnd
 	bitgroupModePathLeftEnd
 )
+const (
+	// Mode Path with TopologyEightWay. The diagonals use the values of the
+	// combinators above, so every direction is instead followed by
+	// bitgroupModePathNext or bitgroupModePathEnd.
+	bitgroupModePathUpRight history = iota + 4
+	bitgroupModePathDownRight
+	bitgroupModePathDownLeft
+	bitgroupModePathUpLeft
+)
+const (
+	bitgroupModePathNext history = iota
+	bitgroupModePathEnd
+)
 const (
 	// Mode Helper. After any of these, the mode is returned to Default
 	bitgroupModeHelperVoid history = iota
 	bitgroupModeHelperHint
 	bitgroupModeHelperUndo
 	bitgroupModeHelperSwap
+	bitgroupModeHelperRedo
+	bitgroupModeHelperPower
+)
+
+// Ends the indexes of a swap, and the power and index of a super-power.
+// Trailing zero-triplets are treated as padding, so every instruction must end
+// with a triplet that is not zero.
+const helperEnd history = 0b111
+
+// Starts a versioned history, and is followed by the version.
+// The triplet is not used in the default mode, so histories written before
+// the encoding was versioned never start with it.
+const bVersionMarker history = 0b111
+
+const (
+	// Histories without a version-marker. The indexes are stored with the
+	// triplets needed for the board-size, which limits the boards to 511 cells.
+	historyVersionLegacy = 0
+	// The indexes are stored with the triplets needed for the highest index,
+	// and boards of any size are supported.
+	historyVersion1 = 1
+	// New histories are written with this version
+	historyVersionLatest = historyVersion1
 )
 const (
 	modePath    = "path"
@@ -55,6 +91,9 @@ const (
 	helperHint Helper = "Hint"
 	helperUndo Helper = "Undo"
 	helperSwap Helper = "Swap"
+	helperRedo Helper = "Redo"
+	// Super-powers, see Power
+	helperPower Helper = "Power"
 )
 
 type CompactHistory struct {
@@ -62,6 +101,10 @@ type CompactHistory struct {
 	gameRows, gameColumns    int
 	bitsUsedForPathIndex     int
 	tripletsUsedForPathIndex int
+	// The triplets used for indexes in histories without a version-marker
+	legacyTripletsUsedForPathIndex int
+	// Paths are stored as directions, which depend on the topology
+	topology Topology
 }
 
 /*
@@ -95,31 +138,72 @@ type CompactHistory struct {
 | Helper  | 6 bits           | 3 bits for header start, 3 bits for value                                                       |
 | Path    | 21 bits          | 3 bit for header start. <br>6 bits for first path-index, <br>3 bits for rest of the indexes<br> |
 | **Sum** | **1181 bytes**  | **(600 x 3 + 50 x 3 + 350 x 21 )/ 8**                                                           |
+
+	# Versions
+
+	The history starts with bVersionMarker and the version, which is written
+	with the first instruction. Histories without it are read as
+	historyVersionLegacy, so that stored games can still be replayed.
 */
 func NewCompactHistory(gameColumns, gameRows int) CompactHistory {
 	return NewCompactHistoryFromBinary(gameColumns, gameRows, []byte{})
 }
 func NewCompactHistoryFromBinary(gameColumns, gameRows int, data []byte) CompactHistory {
 	boardSize := gameRows * gameColumns
-	bitsUsedForPathIndex := bits.Len(uint(boardSize))
-	tripletsUsedForPathIndex := int(math.Ceil(float64(bitsUsedForPathIndex) / 3))
-	switch tripletsUsedForPathIndex {
-	case 1, 2, 3:
-	default:
-		// There are two real places that should implement support for a higher triplet-size
-		panic(fmt.Sprintf("NotImplemeted:NewCompactHistory tripletsUsedForPathIndex=%d for boardSize %d. BitsNeeded: %d", tripletsUsedForPathIndex, boardSize, bitsUsedForPathIndex))
-
+	bitsUsedForPathIndex := bits.Len(uint(boardSize - 1))
+	if bitsUsedForPathIndex == 0 {
+		bitsUsedForPathIndex = 1
 	}
+	tripletsUsedForPathIndex := int(math.Ceil(float64(bitsUsedForPathIndex) / 3))
 	return CompactHistory{
 		data,
 		gameRows,
 		gameColumns,
 		bitsUsedForPathIndex,
 		tripletsUsedForPathIndex,
+		int(math.Ceil(float64(bits.Len(uint(boardSize))) / 3)),
+		TopologyOrthogonal,
 	}
 
 }
 
+// Returns the version of the encoding of the history.
+// Empty histories are written with historyVersionLatest.
+func (c *CompactHistory) version() int {
+	if len(c.c) == 0 {
+		return historyVersionLatest
+	}
+	if history(c.c.TripletAt(0)) != bVersionMarker {
+		return historyVersionLegacy
+	}
+	return int(c.c.TripletAt(1))
+}
+
+// Returns the number of triplets used for each index in the history
+func (c *CompactHistory) indexTriplets() int {
+	if c.version() == historyVersionLegacy {
+		return c.legacyTripletsUsedForPathIndex
+	}
+	return c.tripletsUsedForPathIndex
+}
+
+// Reports whether the first index of a path below 8 is stored in a single
+// triplet, marked with bModePathAlt.
+func (c *CompactHistory) usesPathAlt() bool {
+	if c.version() == historyVersionLegacy {
+		return c.legacyTripletsUsedForPathIndex == 2
+	}
+	return c.tripletsUsedForPathIndex > 1
+}
+
+// Appends the triplets, starting the history with the version-marker if it is empty
+func (c *CompactHistory) append(triplets ...byte) {
+	if len(c.c) == 0 {
+		c.c.Append(bVersionMarker, historyVersionLatest)
+	}
+	c.c.Append(triplets...)
+}
+
 // Returns the inner bytes (does not copy)
 func (c *CompactHistory) MarshalBinary() ([]byte, error) {
 	return c.c, nil
@@ -144,7 +228,16 @@ func (c *CompactHistory) Size() int {
 	return c.c.Size()
 }
 func NewCompactHistoryFromGame(game Game) CompactHistory {
-	return NewCompactHistoryFromBinary(game.Rules.SizeX, game.Rules.SizeY, game.History.c)
+	return NewCompactHistoryFromBinary(game.Rules.SizeX, game.Rules.SizeY, game.History.c).
+		WithTopology(game.Rules.Options.Topology)
+}
+
+// Returns a copy of the history, where the paths are stored for the topology.
+// The topology must match the games rules, since the same data is read
+// differently for each topology.
+func (c CompactHistory) WithTopology(topology Topology) CompactHistory {
+	c.topology = topology
+	return c
 }
 
 func (c *CompactHistory) IsEmpty() bool {
@@ -164,13 +257,58 @@ func (c *CompactHistory) Length() int {
 	return l
 }
 func (c *CompactHistory) AddHint() {
-	c.c.Append(bModeHelpers, bitgroupModeHelperHint)
+	c.append(bModeHelpers, bitgroupModeHelperHint)
+}
+
+// Adds a swap of the cells at the two indexes.
+// The indexes are stored in full after the helper, like the first index of a path,
+// followed by swapEnd.
+func (c *CompactHistory) AddSwap(a, b int) {
+	width := c.indexTriplets()
+	toAppend := make([]byte, 3+width*2)
+	toAppend[0] = bModeHelpers
+	toAppend[1] = bitgroupModeHelperSwap
+	putIndex(toAppend[2:2+width], a)
+	putIndex(toAppend[2+width:2+width*2], b)
+	toAppend[len(toAppend)-1] = helperEnd
+	c.append(toAppend...)
+}
+
+// Adds the use of a super-power. The power is stored after the helper,
+// followed by the index of the cell for powers that are used on a cell, and
+// helperEnd.
+func (c *CompactHistory) AddPower(power Power, index int) {
+	toAppend := []byte{bModeHelpers, bitgroupModeHelperPower, byte(power)}
+	if power.targetsCell() {
+		indexes := make([]byte, c.indexTriplets())
+		putIndex(indexes, index)
+		toAppend = append(toAppend, indexes...)
+	}
+	toAppend = append(toAppend, helperEnd)
+	c.append(toAppend...)
 }
-func (c *CompactHistory) AddSwap() {
-	c.c.Append(bModeHelpers, bitgroupModeHelperSwap)
+
+// Writes the index as triplets into all of dst, the most significant first
+func putIndex(dst []byte, index int) {
+	for i := range dst {
+		shift := 3 * (len(dst) - 1 - i)
+		dst[i] = byte(index>>shift) & 0b111
+	}
+}
+
+// Reads an index of width triplets written by putIndex, starting at the triplet-index
+func (c *CompactHistory) indexAt(i, width int) int {
+	index := 0
+	for j := 0; j < width; j++ {
+		index = index<<3 | int(c.c.TripletAt(i+j))
+	}
+	return index
 }
 func (c *CompactHistory) AddUndo() {
-	c.c.Append(bModeHelpers, bitgroupModeHelperUndo)
+	c.append(bModeHelpers, bitgroupModeHelperUndo)
+}
+func (c *CompactHistory) AddRedo() {
+	c.append(bModeHelpers, bitgroupModeHelperRedo)
 }
 func (c *CompactHistory) At(index int) Instruction_ {
 	var t Instruction_
@@ -187,13 +325,13 @@ func (c *CompactHistory) At(index int) Instruction_ {
 func (c *CompactHistory) AddSwipe(dir SwipeDirection) {
 	switch dir {
 	case SwipeDirectionUp:
-		c.c.Append(bSwipeUp)
+		c.append(bSwipeUp)
 	case SwipeDirectionRight:
-		c.c.Append(bSwipeRight)
+		c.append(bSwipeRight)
 	case SwipeDirectionDown:
-		c.c.Append(bSwipeDown)
+		c.append(bSwipeDown)
 	case SwipeDirectionLeft:
-		c.c.Append(bSwipeLeft)
+		c.append(bSwipeLeft)
 	}
 }
 func (c *CompactHistory) AddPath(path []int) error {
@@ -201,43 +339,41 @@ func (c *CompactHistory) AddPath(path []int) error {
 	if length < 2 {
 		return fmt.Errorf("Path must be of at least of length 2")
 	}
-	first := byte(path[0])
-	lengthReduction := 0
-	if c.tripletsUsedForPathIndex == 2 && first < 8 {
-		lengthReduction = 1
-	}
+	first := path[0]
+	width := c.indexTriplets()
 	// Start with a 0-byte(bModePath),
-	// followed by the first index path as triplet-count defined by c.tripletsUsedForPathIndex
-	toAppend := make([]byte, length+c.tripletsUsedForPathIndex-lengthReduction)
-
-	switch c.tripletsUsedForPathIndex {
-	case 0:
-		panic(fmt.Sprintf("Invalid state for CompactHistory: tripletsUsedForPathIndex canot be zero. (Size: %d, Length: %d)", c.c.Size(), c.c.Length()))
-	case 1:
-		toAppend[1] = first
-	case 2:
-		if first < 8 {
-			toAppend[1] = first
-			toAppend[0] = bModePathAlt
-		} else {
-			toAppend[1] = first & 0b00111000 >> 3
-			toAppend[2] = first & 0b00000111
+	// followed by the first index path as triplet-count defined by c.indexTriplets.
+	// Low indexes can use a single triplet, marked with bModePathAlt
+	if first < 8 && c.usesPathAlt() {
+		width = 1
+	}
+	toAppend := make([]byte, length+width)
+	if width != c.indexTriplets() {
+		toAppend[0] = bModePathAlt
+	}
+	putIndex(toAppend[1:1+width], first)
+
+	if c.topology == TopologyEightWay {
+		// Each direction is followed by a triplet marking the end of the path
+		toAppend = toAppend[:width+1]
+		for i := 1; i < length; i++ {
+			end := bitgroupModePathNext
+			if i == length-1 {
+				end = bitgroupModePathEnd
+			}
+			toAppend = append(toAppend, c.pathDirection(path[i-1], path[i]), end)
 		}
-	case 3:
-		toAppend[1] = first & 0b11000000 >> 6
-		toAppend[2] = first & 0b00111000 >> 3
-		toAppend[3] = first & 0b00000111
-	default:
-		panic(fmt.Sprintf("NotImplemeted:CompactHistory:AddPath tripletsUsedForPathIndex=%d (%d)", c.tripletsUsedForPathIndex, c.bitsUsedForPathIndex))
+		c.append(toAppend...)
+		return nil
 	}
 
 	for i := 1; i < length; i++ {
-		toAppend[i+c.tripletsUsedForPathIndex-lengthReduction] = combinePathRelative(path[i-1], path[i])
+		toAppend[i+width] = c.pathDirection(path[i-1], path[i])
 		if i == length-1 {
-			toAppend[i+c.tripletsUsedForPathIndex-lengthReduction] += 4
+			toAppend[i+width] += 4
 		}
 	}
-	c.c.Append(toAppend...)
+	c.append(toAppend...)
 	return nil
 }
 
@@ -257,7 +393,10 @@ func (c *CompactHistory) AddPath(path []int) error {
 //
 // Hint:            H
 //
-// Swap:            S
+// Swap:            S followed by the comma-separated indexes, like S3,8
+//
+// Power:           P followed by the power and the index of the cell, like PD3
+// (R: RemoveCell, D: DoubleCell, F: SplitCell) or PS for Shuffle
 //
 // CombinePath:     Comma-separated indexes
 func (c *CompactHistory) Describe() string {
@@ -268,7 +407,7 @@ func (c *CompactHistory) DescribeWithoutParams() string {
 }
 func (c *CompactHistory) describe(withParams bool) string {
 	s := strings.Builder{}
-	err := c.Iterate(
+	err := c.iterate(
 		func(dir SwipeDirection, i int) error {
 			switch dir {
 			case SwipeDirectionUp:
@@ -307,11 +446,29 @@ func (c *CompactHistory) describe(withParams bool) string {
 				s.WriteString("S;")
 			case helperUndo:
 				s.WriteString("Z;")
+			case helperRedo:
+				s.WriteString("Y;")
 			default:
 				return fmt.Errorf("???")
 			}
 			return nil
 		},
+		func(a, b int, i int) error {
+			if !withParams {
+				s.WriteString("S;")
+				return nil
+			}
+			s.WriteString("S" + strconv.Itoa(a) + "," + strconv.Itoa(b) + ";")
+			return nil
+		},
+		func(power Power, index int, i int) error {
+			s.WriteString("P" + power.short())
+			if withParams && power.targetsCell() {
+				s.WriteString(strconv.Itoa(index))
+			}
+			s.WriteString(";")
+			return nil
+		},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to describe: %w after %s", err, s.String()).Error()
@@ -343,7 +500,11 @@ func name(mode string, history history) string {
 		case bitgroupModeHelperSwap:
 			return "Helper: Swap"
 		case bitgroupModeHelperUndo:
-			return "Helper: Swap"
+			return "Helper: Undo"
+		case bitgroupModeHelperRedo:
+			return "Helper: Redo"
+		case bitgroupModeHelperPower:
+			return "Helper: Power"
 		}
 	case modePath:
 		return fmt.Sprintf("Path: %d", history)
@@ -353,12 +514,16 @@ func name(mode string, history history) string {
 }
 
 type Instruction_ struct {
-	IsSwipe   bool
-	IsPath    bool
-	IsHelper  bool
+	IsSwipe  bool
+	IsPath   bool
+	IsHelper bool
+	// The indexes to combine, or for a swap, the two indexes to swap.
+	// For a super-power used on a cell, the index of the cell.
 	Path      []int
 	Direction SwipeDirection
 	Helper    Helper
+	// The super-power, if Helper is a power
+	Power Power
 }
 
 func NewSwipeInstruction_(direction SwipeDirection) Instruction_ {
@@ -370,16 +535,42 @@ func NewPathInstruction_(path []int) Instruction_ {
 func NewHelperInstruction_(helper Helper) Instruction_ {
 	return Instruction_{IsHelper: true, Helper: helper}
 }
+func NewSwapInstruction_(a, b int) Instruction_ {
+	return Instruction_{IsHelper: true, Helper: helperSwap, Path: []int{a, b}}
+}
+func NewPowerInstruction_(power Power, index int) Instruction_ {
+	ins := Instruction_{IsHelper: true, Helper: helperPower, Power: power}
+	if power.targetsCell() {
+		ins.Path = []int{index}
+	}
+	return ins
+}
 func (ins Instruction_) IsHelperUndo() bool {
 	return ins.IsHelper && ins.Helper == helperUndo
 }
+func (ins Instruction_) IsHelperRedo() bool {
+	return ins.IsHelper && ins.Helper == helperRedo
+}
 func (ins Instruction_) IsHelperHint() bool {
 	return ins.IsHelper && ins.Helper == helperHint
 }
+func (ins Instruction_) IsHelperSwap() bool {
+	return ins.IsHelper && ins.Helper == helperSwap
+}
+func (ins Instruction_) IsHelperPower() bool {
+	return ins.IsHelper && ins.Helper == helperPower
+}
 func (ins Instruction_) String() string {
 	switch {
 	case ins.IsSwipe:
 		return string(ins.Direction)
+	case ins.IsHelperSwap():
+		return fmt.Sprintf("%s %d,%d", ins.Helper, ins.Path[0], ins.Path[1])
+	case ins.IsHelperPower():
+		if len(ins.Path) == 0 {
+			return ins.Power.String()
+		}
+		return fmt.Sprintf("%s %d", ins.Power, ins.Path[0])
 	case ins.IsHelper:
 		return string(ins.Helper)
 	case ins.IsPath:
@@ -394,11 +585,17 @@ func (ins Instruction_) String() string {
 func (ins Instruction_) Equal(b Instruction_) bool {
 	switch {
 	case ins.IsSwipe:
-		return ins.IsSwipe && ins.Direction == b.Direction
+		return b.IsSwipe && ins.Direction == b.Direction
 	case ins.IsHelper:
-		return ins.IsHelper && ins.Helper == b.Helper
+		if !b.IsHelper || ins.Helper != b.Helper || ins.Power != b.Power {
+			return false
+		}
+		if ins.Helper != helperSwap && ins.Helper != helperPower {
+			return true
+		}
+		fallthrough
 	case ins.IsPath:
-		if !b.IsSwipe {
+		if ins.IsPath != b.IsPath {
 			return false
 		}
 		if len(ins.Path) != len(b.Path) {
@@ -434,7 +631,7 @@ func (c *CompactHistory) Last() (Instruction_, error) {
 func (c *CompactHistory) IterateKind(
 	f func(tag Instruction_, i int) error,
 ) error {
-	return c.Iterate(
+	return c.iterate(
 		func(dir SwipeDirection, i int) error {
 			return f(Instruction_{Direction: dir, IsSwipe: true}, i)
 		},
@@ -444,67 +641,106 @@ func (c *CompactHistory) IterateKind(
 		func(helper Helper, i int) error {
 			return f(Instruction_{Helper: helper, IsHelper: true}, i)
 		},
+		func(a, b int, i int) error {
+			return f(NewSwapInstruction_(a, b), i)
+		},
+		func(power Power, index int, i int) error {
+			return f(NewPowerInstruction_(power, index), i)
+		},
 	)
 }
 
 var (
 	ErrNoMoreHistoryToUndo = errors.New("No more history to undo")
+	ErrNoMoreHistoryToRedo = errors.New("No more history to redo")
 )
 
-// helper for creating a copy of the history where the undo-actions and its targets are removed
+// Returns the moves that are in effect, in the order they were made, and the
+// moves that have been undone, with the next one to redo last.
+//
+// Each undo moves the last move in effect over to the undone moves, and each
+// redo moves it back. Any other move discards the undone moves. Hints do not
+// change the board, and are left out.
+// For instance, with the history D;L;R;Z;U;Z;Y; the moves in effect are D;L;U;
+// and there are no undone moves. With D;L;R;Z;Z; they are D; and R;L; are undone.
+func (c *CompactHistory) UndoStacks() (done, undone []Instruction_, err error) {
+	err = c.IterateKind(func(ins Instruction_, i int) error {
+		switch {
+		case ins.IsHelperHint():
+		case ins.IsHelperUndo():
+			if len(done) == 0 {
+				return fmt.Errorf("%w: the undo at index %d", ErrNoMoreHistoryToUndo, i)
+			}
+			undone = append(undone, done[len(done)-1])
+			done = done[:len(done)-1]
+		case ins.IsHelperRedo():
+			if len(undone) == 0 {
+				return fmt.Errorf("%w: the redo at index %d", ErrNoMoreHistoryToRedo, i)
+			}
+			done = append(done, undone[len(undone)-1])
+			undone = undone[:len(undone)-1]
+		default:
+			done = append(done, ins)
+			undone = nil
+		}
+		return nil
+	})
+	return done, undone, err
+}
+
+// Returns the moves that would be in effect after an undo.
 // For instance, Say we have the following History:
 // Input: L;R;Z;
-// Output: L;
-// Input: D;L;R;Z;U;Z;Z;
-// Output: D;
+// Output: (empty)
+// Input: D;L;R;Z;U;
+// Output: D;L;
 func (c CompactHistory) FilterForUndo() ([]Instruction_, error) {
-	history, err := c.All()
+	done, _, err := c.UndoStacks()
 	if err != nil {
 		return []Instruction_{}, fmt.Errorf("failed to undo game: %w", err)
 	}
-	l := len(history)
-	undoes := 0
-	others := 0
-	for i := 0; i < len(history); i++ {
-		if history[i].IsHelperUndo() {
-			undoes++
-		} else {
-			others++
-		}
-	}
-	if undoes >= others {
-		return history, ErrNoMoreHistoryToUndo
-	}
-	history = append(history, NewHelperInstruction_(helperUndo))
-	var dropped int
-	for i := 0; i < len(history); i++ {
-		if history[i].IsHelperUndo() {
-			if dropped == l {
-				return []Instruction_{}, nil
-			}
-			if i >= i {
-				history = append(history[:i-1], history[i+1:]...)
-				dropped++
-			} else {
-				history = append(history[:i], history[i+1:]...)
-				panic("what")
-			}
-			i = -1
-		}
+	if len(done) == 0 {
+		return done, ErrNoMoreHistoryToUndo
 	}
-	return history, nil
+	return done[:len(done)-1], nil
 }
+
+// Iterates over the instructions in the history.
+// Swaps and super-powers are passed to onHelper, without their parameters.
+// Use IterateKind to get these.
 func (c *CompactHistory) Iterate(
 	onSwipe func(dir SwipeDirection, i int) error,
 	onCombinePath func(path []int, i int) error,
 	onHelper func(helper Helper, i int) error,
+) error {
+	return c.iterate(onSwipe, onCombinePath, onHelper, func(a, b int, i int) error {
+		return onHelper(helperSwap, i)
+	}, func(power Power, index int, i int) error {
+		return onHelper(helperPower, i)
+	})
+}
+func (c *CompactHistory) iterate(
+	onSwipe func(dir SwipeDirection, i int) error,
+	onCombinePath func(path []int, i int) error,
+	onHelper func(helper Helper, i int) error,
+	onSwap func(a, b int, i int) error,
+	onPower func(power Power, index int, i int) error,
 ) error {
 	l := c.c.Length()
 	mode := modeDefault
 	alt := false
 	var j int
 	path := []int{}
-	for i := 0; i < l; i++ {
+	start := 0
+	switch c.version() {
+	case historyVersionLegacy:
+	case historyVersion1:
+		start = 2
+	default:
+		return fmt.Errorf("Failed to read history, unsupported version %d", c.version())
+	}
+	width := c.indexTriplets()
+	for i := start; i < l; i++ {
 		current := history(c.c.TripletAt(i))
 		switch mode {
 		case modeDefault:
@@ -546,44 +782,40 @@ func (c *CompactHistory) Iterate(
 			}
 		case modePath:
 			if len(path) == 0 {
-				switch c.tripletsUsedForPathIndex {
-				case 1:
-					t := int(c.c.TripletAt(i))
-					path = append(path, t)
-				case 2:
-					if alt {
-						t := int(c.c.TripletAt(i))
-						path = append(path, t)
-
-					} else {
-
-						t := int(c.c.TripletAt(i)<<3 | c.c.TripletAt(i+1))
-						i++
-						path = append(path, t)
-					}
-				case 3:
-					t := int(c.c.TripletAt(i)<<6 | c.c.TripletAt(i+1)<<3 | c.c.TripletAt(i+2))
-					i += 2
-					path = append(path, t)
-				default:
-					panic(fmt.Sprintf("NotImplemeted:CompactHistory:Iterate:modePath tripletsUsedForPathIndex=%d (%dx%d)", c.tripletsUsedForPathIndex, c.gameColumns, c.gameRows))
+				firstWidth := width
+				if alt {
+					firstWidth = 1
 				}
+				if i+firstWidth > l {
+					return fmt.Errorf("Failed to map in mode '%s', the path at triplet-index %d is missing its first index", mode, i)
+				}
+				path = append(path, c.indexAt(i, firstWidth))
+				i += firstWidth - 1
 				continue
 			}
-			switch current {
-			case bitgroupModePathUp, bitgroupModePathUpEnd:
-				path = append(path, path[len(path)-1]-c.gameColumns)
-			case bitgroupModePathRight, bitgroupModePathRightEnd:
-				path = append(path, path[len(path)-1]+1)
-			case bitgroupModePathDown, bitgroupModePathDownEnd:
-				path = append(path, path[len(path)-1]+c.gameColumns)
-			case bitgroupModePathLeft, bitgroupModePathLeftEnd:
-				path = append(path, path[len(path)-1]-1)
-			default:
+			end := false
+			if c.topology == TopologyEightWay {
+				if i+1 >= l {
+					return fmt.Errorf("Failed to map in mode '%s', the path-direction at triplet-index %d is missing its end-marker", mode, i)
+				}
+				i++
+				switch history(c.c.TripletAt(i)) {
+				case bitgroupModePathNext:
+				case bitgroupModePathEnd:
+					end = true
+				default:
+					return fmt.Errorf("Failed to map in mode '%s', got unexpected end-marker at triplet-index %d: %d", mode, i, c.c.TripletAt(i))
+				}
+			} else if current >= bitgroupModePathUpEnd {
+				end = true
+				current -= 4
+			}
+			next, ok := c.pathStep(path[len(path)-1], current)
+			if !ok {
 				return fmt.Errorf("Failed to map in mode, got unexpected value at triplet-index %d, %d %s", i, current, name(mode, current))
 			}
-			switch current {
-			case bitgroupModePathUpEnd, bitgroupModePathRightEnd, bitgroupModePathDownEnd, bitgroupModePathLeftEnd:
+			path = append(path, next)
+			if end {
 				err := onCombinePath(path, j)
 				if err != nil {
 					return err
@@ -606,13 +838,48 @@ func (c *CompactHistory) Iterate(
 					return err
 				}
 				j++
+			case bitgroupModeHelperRedo:
+				err := onHelper(helperRedo, j)
+				if err != nil {
+					return err
+				}
+				j++
 			case bitgroupModeHelperSwap:
-				err := onHelper(helperSwap, j)
+				end := i + 1 + width*2
+				if end >= l || history(c.c.TripletAt(end)) != helperEnd {
+					return fmt.Errorf("Failed to map in mode '%s', the swap at triplet-index %d is missing its indexes", mode, i)
+				}
+				a := c.indexAt(i+1, width)
+				b := c.indexAt(i+1+width, width)
+				i = end
+				err := onSwap(a, b, j)
+				if err != nil {
+					return err
+				}
+				j++
+			case bitgroupModeHelperPower:
+				if i+1 >= l {
+					return fmt.Errorf("Failed to map in mode '%s', the power at triplet-index %d is missing", mode, i)
+				}
+				power := Power(c.c.TripletAt(i + 1))
+				if !power.valid() {
+					return fmt.Errorf("Failed to map in mode '%s', got unexpected power at triplet-index %d: %d", mode, i+1, power)
+				}
+				end := i + 2
+				index := 0
+				if power.targetsCell() {
+					index = c.indexAt(i+2, width)
+					end += width
+				}
+				if end >= l || history(c.c.TripletAt(end)) != helperEnd {
+					return fmt.Errorf("Failed to map in mode '%s', the power at triplet-index %d is missing its index", mode, i)
+				}
+				i = end
+				err := onPower(power, index, j)
 				if err != nil {
 					return err
 				}
 				j++
-
 			default:
 				return fmt.Errorf("Failed to map in mode '%s', got unexpected value at triplet-index %d, %d %s", mode, i, current, name(mode, current))
 			}
@@ -622,6 +889,88 @@ func (c *CompactHistory) Iterate(
 	return nil
 }
 
+// Returns the direction from a to b in the histories topology.
+// Expects that b is a neighbour of a
+func (c *CompactHistory) pathDirection(a, b int) history {
+	if c.topology == TopologyOrthogonal {
+		return combinePathRelative(a, b)
+	}
+	dc := b%c.gameColumns - a%c.gameColumns
+	dr := b/c.gameColumns - a/c.gameColumns
+	if c.topology == TopologyToroidal {
+		dc = wrappedDelta(dc, c.gameColumns)
+		dr = wrappedDelta(dr, c.gameRows)
+	}
+	switch {
+	case dc > 0 && dr < 0:
+		return bitgroupModePathUpRight
+	case dc > 0 && dr > 0:
+		return bitgroupModePathDownRight
+	case dc < 0 && dr > 0:
+		return bitgroupModePathDownLeft
+	case dc < 0 && dr < 0:
+		return bitgroupModePathUpLeft
+	case dc > 0:
+		return bitgroupModePathRight
+	case dc < 0:
+		return bitgroupModePathLeft
+	case dr < 0:
+		return bitgroupModePathUp
+	}
+	return bitgroupModePathDown
+}
+
+// A step across the edge of a toroidal board is a step in the opposite direction
+func wrappedDelta(d, size int) int {
+	if size > 2 && d == size-1 {
+		return -1
+	}
+	if size > 2 && d == 1-size {
+		return 1
+	}
+	return d
+}
+
+// Returns the index after taking a step from the previous index in the
+// direction. The diagonals are only available with TopologyEightWay.
+func (c *CompactHistory) pathStep(previous int, direction history) (int, bool) {
+	if c.topology == TopologyOrthogonal {
+		next, err := relativePathToAbsolute(c.gameColumns, previous, direction)
+		return next, err == nil
+	}
+	dc, dr := 0, 0
+	switch direction {
+	case bitgroupModePathUp:
+		dr = -1
+	case bitgroupModePathRight:
+		dc = 1
+	case bitgroupModePathDown:
+		dr = 1
+	case bitgroupModePathLeft:
+		dc = -1
+	case bitgroupModePathUpRight:
+		dc, dr = 1, -1
+	case bitgroupModePathDownRight:
+		dc, dr = 1, 1
+	case bitgroupModePathDownLeft:
+		dc, dr = -1, 1
+	case bitgroupModePathUpLeft:
+		dc, dr = -1, -1
+	default:
+		return 0, false
+	}
+	if c.topology != TopologyEightWay && dc != 0 && dr != 0 {
+		return 0, false
+	}
+	column := previous%c.gameColumns + dc
+	row := previous/c.gameColumns + dr
+	if c.topology == TopologyToroidal {
+		column = (column + c.gameColumns) % c.gameColumns
+		row = (row + c.gameRows) % c.gameRows
+	}
+	return row*c.gameColumns + column, true
+}
+
 // Packs a path of indexes.
 // Expects that the path is already validated, so that each subsequent element is a "neighbour" of the previous
 func combinePathRelative(a, b int) history {
@@ -649,7 +998,7 @@ func relativePathToAbsolute(columns int, previousPosition int, direction history
 	case bitgroupModePathDown:
 		return previousPosition + columns, nil
 	case bitgroupModePathLeft:
-		return previousPosition + columns, nil
+		return previousPosition - 1, nil
 	}
 
 	return 0, fmt.Errorf("mapping failure")
@@ -49,16 +49,20 @@ func TestCompactHistory(t *testing.T) {
 	}
 
 	tests := []compactTestArgs{
-		{"Test simple history 5x5", 5, 5, "U;R;6,1,2,7,12,11;L;H;D;", 5, 13},
-		compactTestCreator(t, "Test randomized history ", 1000, 5, 5, 8, 7, 17),
-		compactTestCreator(t, "Test randomized history", 1001, 5, 5, 4, 3, 8),
-		compactTestCreator(t, "Test randomized history", 1002, 5, 5, 30, 47, 123),
-		compactTestCreator(t, "Test randomized history", 1003, 4, 4, 3, 3, 6),
-		compactTestCreator(t, "Test randomized history", 1004, 4, 4, 400, 479, 1276),
-		compactTestCreator(t, "Test randomized history on tiny board", 1005, 3, 2, 4, 8, 19),
-		compactTestCreator(t, "Test randomized history on bigger board", 1006, 8, 8, 4, 12, 32),
+		{"Test simple history 5x5", 5, 5, "U;R;6,1,2,7,12,11;L;H;D;", 6, 15},
+		{"Test history with swaps 5x5", 5, 5, "U;S3,24;7,2;S0,1;Z;", 9, 22},
+		{"Test history with swaps on bigger board", 8, 8, "S63,9;L;", 4, 10},
+		{"Test history with redo 5x5", 5, 5, "U;L;Z;Z;Y;Y;Z;R;", 6, 15},
+		compactTestCreator(t, "Test randomized history ", 1000, 5, 5, 8, 9, 24),
+		compactTestCreator(t, "Test randomized history", 1001, 5, 5, 4, 4, 10),
+		compactTestCreator(t, "Test randomized history", 1002, 5, 5, 30, 53, 139),
+		compactTestCreator(t, "Test randomized history", 1003, 4, 4, 3, 3, 8),
+		compactTestCreator(t, "Test randomized history", 1004, 4, 4, 400, 564, 1504),
+		compactTestCreator(t, "Test randomized history on tiny board", 1005, 3, 2, 4, 8, 21),
+		compactTestCreator(t, "Test randomized history on bigger board", 1006, 8, 8, 4, 12, 31),
 	}
 	pathRegex := regexp.MustCompile(`^[0-9,]{2,}$`)
+	swapRegex := regexp.MustCompile(`^S([0-9]+),([0-9]+)$`)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewCompactHistory(tt.columns, tt.rows)
@@ -92,11 +96,17 @@ func TestCompactHistory(t *testing.T) {
 					c.AddSwipe(SwipeDirectionLeft)
 				case "H":
 					c.AddHint()
-				case "S":
-					c.AddSwap()
 				case "Z":
 					c.AddUndo()
+				case "Y":
+					c.AddRedo()
 				default:
+					if m := swapRegex.FindStringSubmatch(x); m != nil {
+						a, _ := strconv.Atoi(m[1])
+						b, _ := strconv.Atoi(m[2])
+						c.AddSwap(a, b)
+						continue
+					}
 					if pathRegex.MatchString(x) {
 						// interpret as a comma-seperated path
 						path, err, invalidIndex := parsePathStr(x)
@@ -159,6 +169,151 @@ func TestCompactHistory(t *testing.T) {
 		})
 	}
 }
+func TestCompactHistory_Topology(t *testing.T) {
+	tests := []struct {
+		name          string
+		topology      Topology
+		columns, rows int
+		paths         [][]int
+	}{
+		{"Orthogonal", TopologyOrthogonal, 4, 3, [][]int{{0, 1, 5, 4}, {11, 7}}},
+		{"Eight-way with diagonals", TopologyEightWay, 4, 3, [][]int{{0, 5, 10}, {3, 6, 9, 8, 4, 1}, {11, 7}}},
+		{"Eight-way on bigger board", TopologyEightWay, 8, 8, [][]int{{63, 54, 45, 46}, {7, 14}}},
+		{"Toroidal wraps around the edges", TopologyToroidal, 4, 3, [][]int{{3, 0, 8, 11}, {4, 7, 3}, {1, 9}}},
+		{"Toroidal on bigger board", TopologyToroidal, 8, 8, [][]int{{56, 0, 7, 63}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := RestoreTableBoard(tt.columns, tt.rows, newTableBoard(t, tt.columns, tt.rows).cells, TableBoardOptions{Topology: tt.topology})
+			c := NewCompactHistory(tt.columns, tt.rows).WithTopology(tt.topology)
+			want := ""
+			for _, path := range tt.paths {
+				if err, invalidIndex := tb.ValidatePath(path); err != nil {
+					t.Fatalf("SanityTest: The test-input had an invalid path %v at index %d: %v", path, invalidIndex, err)
+				}
+				if err := c.AddPath(path); err != nil {
+					t.Fatalf("failed to add path: %v", err)
+				}
+				c.AddSwipe(SwipeDirectionUp)
+				want += strings.Trim(strings.Join(strings.Fields(fmt.Sprint(path)), ","), "[]") + ";U;"
+			}
+			restored := NewCompactHistoryFromBinary(tt.columns, tt.rows, c.BytesCopy()).WithTopology(tt.topology)
+			if got := restored.Describe(); got != want {
+				t.Errorf("Describe() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+func TestCompactHistory_LegacyVersion(t *testing.T) {
+	// These were written before the history was versioned
+	tests := []struct {
+		columns, rows int
+		data          []byte
+		want          string
+	}{
+		{3, 3, []byte{0x58, 0x1c, 0x58, 0x44, 0x72, 0x4a}, "U;0,1,4;S2,8;H;Z;"},
+		{5, 5, []byte{0xd8, 0x14, 0xbd, 0xc, 0x43, 0x51, 0xbc, 0xc0}, "6,1,2,7,12,11;L;24,19;PD13;Y;"},
+		{8, 8, []byte{0x3, 0xf7, 0xb, 0x1f, 0x82, 0x7c}, "63,62,54;S63,9;D;"},
+		{16, 16, []byte{0xf, 0xf7, 0x0, 0x3, 0x16, 0xc8, 0x8, 0xf6}, "255,254,238;0,16;S200,17;R;"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%dx%d", tt.columns, tt.rows), func(t *testing.T) {
+			c := NewCompactHistoryFromBinary(tt.columns, tt.rows, tt.data)
+			if c.version() != historyVersionLegacy {
+				t.Errorf("version() = %d, want %d", c.version(), historyVersionLegacy)
+			}
+			if got := c.Describe(); got != tt.want {
+				t.Errorf("Describe() = %s, want %s", got, tt.want)
+			}
+			// Stored games continue in the same encoding
+			c.AddPath([]int{1, 0})
+			c.AddSwap(tt.columns, 2)
+			if got, want := c.Describe(), tt.want+fmt.Sprintf("1,0;S%d,2;", tt.columns); got != want {
+				t.Errorf("Describe() after adding = %s, want %s", got, want)
+			}
+		})
+	}
+	t.Run("Unknown versions are reported", func(t *testing.T) {
+		c := NewCompactHistory(3, 3)
+		c.c.Append(bVersionMarker, 5, bSwipeUp)
+		if _, err := c.All(); err == nil {
+			t.Errorf("All() should fail for an unknown version")
+		}
+	})
+}
+
+// Writes the instructions in the format of Describe to the history
+func addHistoryString(t *testing.T, c *CompactHistory, history string) {
+	t.Helper()
+	toIndexes := func(s string) []int {
+		var indexes []int
+		for _, v := range strings.Split(s, ",") {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				t.Fatalf("Invalid index in history-string: %s", s)
+			}
+			indexes = append(indexes, i)
+		}
+		return indexes
+	}
+	for _, x := range strings.Split(strings.TrimSuffix(history, ";"), ";") {
+		switch {
+		case x == "U":
+			c.AddSwipe(SwipeDirectionUp)
+		case x == "R":
+			c.AddSwipe(SwipeDirectionRight)
+		case x == "D":
+			c.AddSwipe(SwipeDirectionDown)
+		case x == "L":
+			c.AddSwipe(SwipeDirectionLeft)
+		case x == "H":
+			c.AddHint()
+		case x == "Z":
+			c.AddUndo()
+		case x == "Y":
+			c.AddRedo()
+		case x == "PS":
+			c.AddPower(PowerShuffle, 0)
+		case strings.HasPrefix(x, "PD"):
+			c.AddPower(PowerDoubleCell, toIndexes(x[2:])[0])
+		case strings.HasPrefix(x, "S"):
+			indexes := toIndexes(x[1:])
+			c.AddSwap(indexes[0], indexes[1])
+		default:
+			if err := c.AddPath(toIndexes(x)); err != nil {
+				t.Fatalf("failed to add path %s: %v", x, err)
+			}
+		}
+	}
+}
+
+func TestCompactHistory_BoardSizes(t *testing.T) {
+	sizes := [][2]int{{23, 23}, {40, 17}, {64, 64}, {255, 255}}
+	for columns := 16; columns >= 2; columns-- {
+		for rows := 16; rows >= 2; rows-- {
+			sizes = append(sizes, [2]int{columns, rows})
+		}
+	}
+	rnd := rand.NewSource(1007)
+	for _, size := range sizes {
+		columns, rows := size[0], size[1]
+		t.Run(fmt.Sprintf("%dx%d", columns, rows), func(t *testing.T) {
+			last := columns*rows - 1
+			// The highest index, and the highest index that fits in a single triplet
+			low := 7 % (columns*rows - columns)
+			history := fmt.Sprintf("S%d,0;PD%d;PS;", last, last) +
+				fmt.Sprintf("%d,%d;%d,%d;", last, last-1, low, low+columns) +
+				historyStringCreator(t, columns, rows, rnd, 12)
+			c := NewCompactHistory(columns, rows)
+			addHistoryString(t, &c, history)
+			restored := NewCompactHistoryFromBinary(columns, rows, c.BytesCopy())
+			if got := restored.Describe(); got != history {
+				t.Errorf("Describe() did not round-trip\ngot:  %s\nwant: %s", got, history)
+			}
+		})
+	}
+}
+
 func newTableBoard(t *testing.T, columns, rows int) TableBoard {
 	tb := NewTableBoard(columns, rows)
 	boardSize := columns * rows
@@ -250,7 +405,8 @@ outer:
 			case 0:
 				s += "H;"
 			case 1:
-				s += "S;"
+				boardSize := int64(columns * rows)
+				s += fmt.Sprintf("S%d,%d;", rnd.Int63()%boardSize, rnd.Int63()%boardSize)
 			case 2:
 				s += "Z;"
 			}
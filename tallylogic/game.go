@@ -32,15 +32,20 @@ type IntRandomizer interface {
 
 type Game struct {
 	// Uniquely identifies this board
-	ID           string
-	board        BoardController
-	boardAtStart BoardController
+	ID    string
+	board BoardController
+	// The game as it was before any moves were made
+	start *Checkpoint
+	// Snapshots of the game, used to undo without replaying the whole history
+	checkpoints []Checkpoint
 	// deprecated (I think atleast, I dont believe there is a need for this)
 	selectedCells []int
 	cellGenerator CellGenerator
 	Rules         GameRules
 	score         int64
 	moves         int
+	// The number of undos in the history. Each undo gives back the move it undid.
+	undos         int
 	Description   string
 	Name          string
 	Hinter        hintCalculator
@@ -61,17 +66,25 @@ type GameRules struct {
 	GameMode GameMode
 	SizeX    int
 	SizeY    int
-	// TODO: not implemented
+	// The game is won when a cell reaches this value.
+	// If TargetScore is also set, both must be reached.
 	TargetCellValue uint64
-	// TODO: not implemented
+	// The game is lost when this number of moves is reached, and no further moves can be made.
 	MaxMoves uint64
-	// TODO: not implemented
+	// The game is won when the score reaches this value.
+	// If TargetCellValue is also set, both must be reached.
 	TargetScore uint64
-	// TODO: not implemented
+	// Generates a new cell to an empty spot for every swipe that changes the board
 	RecreateOnSwipe bool
-	// TODO: not implemented
+	// Allows the use of helpers, other than undo.
 	WithSuperPowers bool
-	StartingBricks  int
+	// The number of times cells can be swapped. Swapping requires WithSuperPowers.
+	MaxSwaps uint64
+	// Only allow swapping cells that are neighbours
+	SwapNeighboursOnly bool
+	// The number of super-powers that can be used. Super-powers requires WithSuperPowers.
+	MaxPowers      uint64
+	StartingBricks int
 	// Whether to allow swipes in the same direction or not, for instance twice up.
 	// This can have an effect if there are new items generated for each swipe
 	NoReswipe bool
@@ -120,10 +133,13 @@ func (g Game) Copy() Game {
 		Rules:         g.Rules,
 		score:         g.score,
 		moves:         g.moves,
+		undos:         g.undos,
 		Name:          g.Name,
 		Description:   g.Description,
 		GoalChecker:   g.GoalChecker,
 		DefeatChecker: g.DefeatChecker,
+		start:         g.start,
+		checkpoints:   g.checkpoints,
 	}
 	if g.Hinter.CellRetriever != nil {
 		game.Hinter = NewHintCalculator(
@@ -155,28 +171,40 @@ func RestoreGame(g *types.Game) (Game, error) {
 	default:
 		return Game{}, fmt.Errorf("unsupported game-mode from rules: %v", g.Rules.Mode)
 	}
+	topology, err := TopologyFromRules(g.Rules.Topology)
+	if err != nil {
+		return Game{}, err
+	}
 	game := Game{
 		ID:            g.ID,
 		board:         nil,
 		selectedCells: []int{},
 		cellGenerator: nil,
 		Rules: GameRules{
-			ID:              g.Rules.ID,
-			GameMode:        mode,
-			SizeX:           int(g.Rules.Columns),
-			SizeY:           int(g.Rules.Rows),
-			RecreateOnSwipe: g.Rules.RecreateOnSwipe,
-			TargetCellValue: g.Rules.TargetCellValue,
-			TargetScore:     g.Rules.TargetScore,
-			// WithSuperPowers: g.Rules.WithSuperPowers,
+			ID:                 g.Rules.ID,
+			GameMode:           mode,
+			SizeX:              int(g.Rules.Columns),
+			SizeY:              int(g.Rules.Rows),
+			RecreateOnSwipe:    g.Rules.RecreateOnSwipe,
+			TargetCellValue:    g.Rules.TargetCellValue,
+			TargetScore:        g.Rules.TargetScore,
+			MaxMoves:           g.Rules.MaxMoves,
+			WithSuperPowers:    !g.Rules.NoSuperPowers,
+			MaxSwaps:           g.Rules.MaxSwaps,
+			SwapNeighboursOnly: g.Rules.SwapNeighboursOnly,
+			MaxPowers:          g.Rules.MaxPowers,
 			// StartingBricks:  g.Rules.,
 			NoReswipe: g.Rules.NoReSwipe,
 			Options: NewGameOptions{
 				TableBoardOptions: TableBoardOptions{
 					EvaluateOptions: EvaluateOptions{
-						NoMultiply: g.Rules.NoMultiply,
-						NoAddition: g.Rules.NoAddition,
+						NoMultiply:         g.Rules.NoMultiply,
+						NoAddition:         g.Rules.NoAddition,
+						WithSubtraction:    g.Rules.WithSubtraction,
+						WithDivision:       g.Rules.WithDivision,
+						WithMixedOperators: g.Rules.WithMixedOperators,
 					},
+					Topology: topology,
 				},
 				Seed:  g.Seed,
 				State: g.State,
@@ -188,21 +216,12 @@ func RestoreGame(g *types.Game) (Game, error) {
 		Name:          g.Name,
 		GoalChecker:   nil,
 		DefeatChecker: nil,
-		History:       NewCompactHistoryFromBinary(int(g.Rules.Columns), int(g.Rules.Rows), g.History),
+		History:       NewCompactHistoryFromBinary(int(g.Rules.Columns), int(g.Rules.Rows), g.History).WithTopology(topology),
 	}
 
-	game.DefeatChecker = DefeatCheckerNoMoreMoves{}
-	if game.Rules.TargetCellValue > 0 {
-
-		game.GoalChecker = GoalCheckLargestCell{
-			TargetCellValue: game.Rules.TargetCellValue,
-		}
-	} else if game.Rules.TargetScore > 0 {
-		return game, fmt.Errorf("Not implemented: targetScore")
-	} else {
-		game.GoalChecker = GoalCheck{"Game runs forever (default)"}
-
-	}
+	game.undos = countUndos(game.History)
+	game.DefeatChecker = NewDefeatCheckerForRules(game.Rules)
+	game.GoalChecker = NewGoalCheckerForRules(game.Rules)
 	switch game.Rules.GameMode {
 	case GameModeRandom:
 	case GameModeTutorial:
@@ -234,6 +253,8 @@ func NewGame(mode GameMode, template *GameTemplate, options ...NewGameOptions) (
 			SizeY:           5,
 			RecreateOnSwipe: true,
 			WithSuperPowers: true,
+			MaxSwaps:        DefaultMaxSwaps,
+			MaxPowers:       DefaultMaxPowers,
 			StartingBricks:  5,
 			GameMode:        mode,
 		},
@@ -260,6 +281,12 @@ func NewGame(mode GameMode, template *GameTemplate, options ...NewGameOptions) (
 			game.Name = t.Name
 			game.DefeatChecker = t.DefeatChecker
 			game.GoalChecker = t.GoalChecker
+			if game.DefeatChecker == nil {
+				game.DefeatChecker = NewDefeatCheckerForRules(game.Rules)
+			}
+			if game.GoalChecker == nil {
+				game.GoalChecker = NewGoalCheckerForRules(game.Rules)
+			}
 
 			if game.Description == "" {
 				game.Description = game.GoalChecker.Description()
@@ -291,7 +318,7 @@ func NewGame(mode GameMode, template *GameTemplate, options ...NewGameOptions) (
 	default:
 		return game, fmt.Errorf("Invalid gamemode: %d %s", mode, string(debug.Stack()))
 	}
-	game.History = NewCompactHistory(game.Rules.SizeX, game.Rules.SizeY)
+	game.History = NewCompactHistory(game.Rules.SizeX, game.Rules.SizeY).WithTopology(game.Rules.Options.Topology)
 	allEmpty := true
 	for _, c := range game.Cells() {
 		if c.Value() > 0 {
@@ -306,7 +333,8 @@ func NewGame(mode GameMode, template *GameTemplate, options ...NewGameOptions) (
 		}
 	}
 	game.Hinter = NewHintCalculator(game.board, game.board, game.board)
-	game.boardAtStart = game.board.Copy()
+	start := game.checkpoint(0)
+	game.start = &start
 	if len(game.board.Cells()) != (game.Rules.SizeX * game.Rules.SizeY) {
 		return game, fmt.Errorf("Game has invalid size: %d cells, %dx%d, mode %v template %v", len(game.board.Cells()), game.Rules.SizeX, game.Rules.SizeY, mode, template)
 	}
@@ -349,76 +377,161 @@ func (g *Game) SoftSwipe(direction SwipeDirection) (wouldChange bool) {
 }
 
 func (g *Game) Swipe(direction SwipeDirection) (changed bool) {
+	if g.hasReachedMaxMoves() {
+		return false
+	}
 	changed = g.board.SwipeDirection(direction)
 	g.ClearSelection()
-	if g.Rules.RecreateOnSwipe {
-		g.generateCellToEmptyCell()
-	}
 	if changed {
+		if g.Rules.RecreateOnSwipe {
+			g.generateCellToEmptyCell()
+		}
 		g.inceaseMoveCount()
 		g.History.AddSwipe(direction)
 	}
 	return changed
 }
-func (g *Game) ReplaceBasedOn(game Game) error {
-	g.boardAtStart = game.board
-	return nil
+
+// Reports whether the game has used up all the moves allowed by the rules.
+func (g *Game) hasReachedMaxMoves() bool {
+	return g.Rules.MaxMoves > 0 && uint64(g.movesTowardsLimit()) >= g.Rules.MaxMoves
 }
-func (g *Game) CanUndo() bool {
+
+// Returns the number of moves that count towards Rules.MaxMoves.
+// An undo counts as a move, but it also gives back the move it undid, so it
+// is not counted against the limit.
+func (g Game) movesTowardsLimit() int {
+	moves := g.moves - 2*g.undos
+	if moves < 0 {
+		return 0
+	}
+	return moves
+}
+
+// Returns the number of undos in the history.
+// Only used when restoring a game, since the game keeps count of its undos.
+func countUndos(h CompactHistory) int {
 	undos := 0
-	others := 0
-	g.History.Iterate(
-		func(dir SwipeDirection, i int) error { others++; return nil },
-		func(path []int, i int) error { others++; return nil },
+	h.Iterate(
+		func(dir SwipeDirection, i int) error { return nil },
+		func(path []int, i int) error { return nil },
 		func(helper Helper, i int) error {
 			if helper == helperUndo {
 				undos++
-			} else {
-				others++
 			}
 			return nil
 		},
 	)
-	undone := undos * 2
-	return undone < others
+	return undos
+}
+
+// Sets the start of the game from the game as it was before any moves were made.
+// This is required for undo, unless there is a checkpoint to replay from.
+func (g *Game) ReplaceBasedOn(game Game) error {
+	start := game.checkpoint(0)
+	start.Score = 0
+	g.start = &start
+	return nil
+}
+
+// Returns the number of moves in effect, that is, the moves that are not undone.
+func (g Game) Depth() (int, error) {
+	done, _, err := g.History.UndoStacks()
+	return len(done), err
+}
+func (g *Game) CanUndo() bool {
+	done, _, err := g.History.UndoStacks()
+	return err == nil && len(done) > 0
+}
+func (g *Game) CanRedo() bool {
+	_, undone, err := g.History.UndoStacks()
+	return err == nil && len(undone) > 0
 }
 func (g *Game) Undo() error {
+	return g.UndoSteps(1)
+}
+
+// Undoes the last moves in effect. Each step is added to the history as an
+// undo, and counts as a move.
+//
+// The game is restored from the latest checkpoint before the moves, and the
+// moves in effect after it are replayed. The cell-generator is restored too,
+// so that the board is exactly as it was before the moves were made.
+func (g *Game) UndoSteps(steps int) error {
 	if g.moves == 0 {
 		return fmt.Errorf("Cannot undo at start of game")
 	}
-	if g.boardAtStart == nil {
-		return fmt.Errorf("Invalid state: Could not locate data for board-start.")
+	if steps < 1 {
+		return fmt.Errorf("Cannot undo %d steps", steps)
 	}
-	history, err := g.History.FilterForUndo()
+	done, _, err := g.History.UndoStacks()
 	if err != nil {
 		return fmt.Errorf("failed to undo game: %w", err)
 	}
+	if steps > len(done) {
+		return fmt.Errorf("%w: cannot undo %d steps, with %d moves in effect", ErrNoMoreHistoryToUndo, steps, len(done))
+	}
+	target := len(done) - steps
+	from := g.checkpointAtOrBefore(target)
+	if from == nil {
+		return fmt.Errorf("Invalid state: Could not locate data for board-start.")
+	}
+	// The game is restored if any of the moves cannot be replayed
+	before := *g
 	hbytes := g.History.BytesCopy()
-	g.board = g.boardAtStart.Copy()
-	g.History = NewCompactHistory(g.Rules.SizeX, g.Rules.SizeY)
-	// g.moves = 0
-	g.score = 0
-	for i := 0; i < len(history); i++ {
-		ins := history[i]
-		if ins.IsHelperUndo() {
-			panic("Nested undo!")
+	g.dropCheckpointsAfter(from.Depth)
+	g.restoreCheckpoint(*from)
+	g.History = NewCompactHistory(g.Rules.SizeX, g.Rules.SizeY).WithTopology(g.Rules.Options.Topology)
+	// Only the moves in effect are in the history while replaying, so that
+	// rules like MaxMoves are applied as they were originally.
+	g.moves = from.Depth
+	g.undos = 0
+	for i := from.Depth; i < target; i++ {
+		if !g.Instruct(done[i]) {
+			*g = before
+			return fmt.Errorf("failed to apply instruction  %d %#v", i, done[i])
 		}
-		ok := g.Instruct(ins)
-		g.moves--
-		// time.Sleep(1 * time.Millisecond)
-		if !ok {
-			return fmt.Errorf("failed to apply instruction  %d %#v", i, ins)
+		if (i+1)%CheckpointInterval == 0 {
+			g.checkpoints = append(g.checkpoints, g.checkpoint(i+1))
 		}
-
 	}
 
 	g.History.c = hbytes
-	g.History.AddUndo()
-	g.moves++
+	for i := 0; i < steps; i++ {
+		g.History.AddUndo()
+	}
+	g.moves = before.moves + steps
+	g.undos = before.undos + steps
 
 	return nil
 }
 
+// Redoes the last undone move. This is only possible right after an undo or a
+// redo, since any other move discards the undone moves.
+//
+// Since undo restores the cell-generator, the move has the same outcome as
+// when it was first made.
+func (g *Game) Redo() error {
+	_, undone, err := g.History.UndoStacks()
+	if err != nil {
+		return fmt.Errorf("failed to redo game: %w", err)
+	}
+	if len(undone) == 0 {
+		return ErrNoMoreHistoryToRedo
+	}
+	ins := undone[len(undone)-1]
+	before := g.Copy()
+	hbytes := g.History.BytesCopy()
+	if !g.Instruct(ins) {
+		*g = before
+		return fmt.Errorf("failed to redo instruction %#v", ins)
+	}
+	// The move is already in the history, so it is added as a redo instead
+	g.History.c = hbytes
+	g.History.AddRedo()
+	return nil
+}
+
 // Not all types of boards supports this, so this method will probably be removed
 func (g *Game) selectCellCoord(x, y int) bool {
 	n, ok := g.board.CoordToIndex(x, y)
@@ -471,6 +584,9 @@ func (g *Game) EvaluateSelection() bool {
 // - Moves
 // - History
 func (g *Game) EvaluateForPath(path []int) bool {
+	if g.hasReachedMaxMoves() {
+		return false
+	}
 	points, _, err := g.board.EvaluatesTo(path, true, false)
 	if err != nil {
 		return false
@@ -553,9 +669,21 @@ func (g Game) SoftEvaluatesForPath(path []int) (int64, EvalMethod, error) {
 }
 
 func (g Game) IsGameWon() bool {
+	if g.GoalChecker == nil {
+		return false
+	}
 	return g.GoalChecker.Check(g)
 }
+
+// Reports whether the game is lost.
+// A game that is won is never over.
 func (g Game) IsGameOver() bool {
+	if g.IsGameWon() {
+		return false
+	}
+	if g.DefeatChecker == nil {
+		return g.hasReachedMaxMoves()
+	}
 	return g.DefeatChecker.Check(g)
 }
 func (g Game) Hash() string {
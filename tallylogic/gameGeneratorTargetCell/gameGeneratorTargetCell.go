@@ -2,7 +2,6 @@ package gamegenerator_target_cell
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 	"time"
@@ -150,8 +149,20 @@ func intMaxNonZero(ints ...int) (int, bool) {
 	return biggest, ok
 }
 
+// The games generated always have a target-cell, which the best-first solver
+// uses to find the shortest solution quickly.
+func newSolver(options tallylogic.SolveOptions) tallylogic.Solver {
+	return tallylogic.GameSolverFactory(tallylogic.GameSolverFactoryOptions{
+		SolveOptions: options,
+		BestFirst:    true,
+	})
+}
+
 func (gen gameGeneratorTargetCell) GenerateGame(ctx context.Context) (tallylogic.Game, []tallylogic.Game, error) {
 	for i := 0; i < 1000; i++ {
+		if err := ctx.Err(); err != nil {
+			return tallylogic.Game{}, nil, err
+		}
 		game, err := gen.generateGame()
 		if err != nil {
 			return game, nil, err
@@ -163,23 +174,75 @@ func (gen gameGeneratorTargetCell) GenerateGame(ctx context.Context) (tallylogic
 			MaxSolutions: 1,
 			MaxTime:      time.Millisecond * 100,
 		}
-		solutions, err := tallylogic.SolveGame(options, game, nil)
+		solutions, err := newSolver(options).SolveGame(ctx, game)
+		if err != nil && !tallylogic.IsTruncated(err) {
+			return game, nil, fmt.Errorf("failed to create solution while generating game: %w", err)
+		}
+		// If the solver stopped early without solutions, the game is most likely not solveable.
+		// It is cheaper to just generate a new game
+		if len(solutions) == 0 {
+			continue
+		}
+		return game, solutions, nil
+	}
+	return tallylogic.Game{}, nil, fmt.Errorf("too many retries")
+}
+
+// GenerateGames generates games until the context is done, or the handler returns an error.
+// Each solvable game is sent to the handler as it is found, along with the progress.
+func (gen gameGeneratorTargetCell) GenerateGames(ctx context.Context, onEvent tallylogic.GeneratorEventHandler) error {
+	stats := &tallylogic.SolverStats{}
+	reporter := tallylogic.NewGeneratorProgressReporter(stats, 500*time.Millisecond, onEvent)
+	for {
+		if err := ctx.Err(); err != nil {
+			reporter.Flush()
+			return err
+		}
+		game, err := gen.generateGame()
 		if err != nil {
-			switch {
-			case errors.Is(err, context.DeadlineExceeded):
-				// ignore deadline, game is most likely not solveable
-				// it is cheaper to just generate a new game
-			default:
-				return game, nil, fmt.Errorf("failed to create solution while generating game: %w", err)
+			return err
+		}
+		reporter.Iteration()
+		options := tallylogic.SolveOptions{
+			MinMoves:     gen.MinMoves,
+			MaxMoves:     gen.MaxMoves,
+			MaxSolutions: 1,
+			MaxTime:      time.Millisecond * 100,
+			Stats:        stats,
+		}
+		solutions, err := newSolver(options).SolveGame(ctx, game)
+		// Solutions found before the solver stopped early are still valid
+		if err != nil && len(solutions) == 0 {
+			if err := reporter.Reject(tallylogic.RejectionForSolverError(err)); err != nil {
+				return err
 			}
+			continue
 		}
-		if solutions == nil || len(solutions) == 0 {
+		if len(solutions) == 0 {
+			if err := reporter.Reject(tallylogic.RejectionNoSolution); err != nil {
+				return err
+			}
 			continue
 		}
-		return game, solutions, err
+		err = reporter.Candidate(tallylogic.SolvableGame{
+			GeneratorOptions: tallylogic.GameGeneratorOptions{
+				Rows:            gen.Rows,
+				Columns:         gen.Columns,
+				TargetCellValue: gen.TargetCell,
+				MaxBricks:       gen.MaxCells,
+				MinMoves:        gen.MinMoves,
+				MaxMoves:        gen.MaxMoves,
+				Seed:            gen.Seed,
+			},
+			Game:      game,
+			Solutions: solutions,
+		})
+		if err != nil {
+			return err
+		}
 	}
-	return tallylogic.Game{}, nil, fmt.Errorf("too many retries")
 }
+
 func (gen gameGeneratorTargetCell) generateGame() (tallylogic.Game, error) {
 	cellsForBoard := gen.Rows * gen.Columns
 	// copy the cellsNeeded
@@ -2,6 +2,7 @@ package gamegenerator_target_cell
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/MarvinJWendt/testza"
@@ -85,6 +86,42 @@ func Test_gameGeneratorTargetCell_GenerateGame(t *testing.T) {
 			1,
 			false,
 		},
+		{
+			"Should generate game for 96 on a portrait 3x5",
+			GameGeneratorTargetCellOptions{
+				TargetCell:       96,
+				Rows:             5,
+				Columns:          3,
+				RandomCellChance: -1,
+				MaxMoves:         120,
+			},
+			1,
+			false,
+		},
+		{
+			"Should generate game for 384 on a portrait 4x6",
+			GameGeneratorTargetCellOptions{
+				TargetCell:       384,
+				Rows:             6,
+				Columns:          4,
+				RandomCellChance: -1,
+				MaxMoves:         120,
+			},
+			1,
+			false,
+		},
+		{
+			"Should generate game for 384 on a landscape 6x4",
+			GameGeneratorTargetCellOptions{
+				TargetCell:       384,
+				Rows:             4,
+				Columns:          6,
+				RandomCellChance: -1,
+				MaxMoves:         120,
+			},
+			1,
+			false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -101,11 +138,17 @@ func Test_gameGeneratorTargetCell_GenerateGame(t *testing.T) {
 
 			}
 
-			_, solutions, err := gen.GenerateGame(context.TODO())
+			game, solutions, err := gen.GenerateGame(context.TODO())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("gameGeneratorTargetCell.GenerateGame() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if game.Rules.SizeX != tt.options.Columns || game.Rules.SizeY != tt.options.Rows {
+				t.Errorf("Expected a %dx%d game, but got %dx%d", tt.options.Columns, tt.options.Rows, game.Rules.SizeX, game.Rules.SizeY)
+			}
+			if len(game.Cells()) != tt.options.Columns*tt.options.Rows {
+				t.Errorf("Expected %d cells, but got %d", tt.options.Columns*tt.options.Rows, len(game.Cells()))
+			}
 
 			if len(solutions) != tt.solutionCount {
 				t.Fatalf("Expected %d solutions, but only got %d", tt.solutionCount, len(solutions))
@@ -117,6 +160,53 @@ func Test_gameGeneratorTargetCell_GenerateGame(t *testing.T) {
 		})
 	}
 }
+func Test_gameGeneratorTargetCell_GenerateGames(t *testing.T) {
+	gen, err := NewGameGeneratorForTargetCell(GameGeneratorTargetCellOptions{
+		TargetCell:       96,
+		Rows:             4,
+		Columns:          4,
+		RandomCellChance: -1,
+		MaxMoves:         120,
+	})
+	if err != nil {
+		t.Fatalf("failed to create game: %v", err)
+	}
+	errStop := errors.New("stop")
+	var candidates []tallylogic.SolvableGame
+	var last tallylogic.GeneratorProgress
+	err = gen.GenerateGames(context.TODO(), func(event tallylogic.GeneratorEvent) error {
+		last = event.Progress
+		if event.Candidate == nil {
+			return nil
+		}
+		candidates = append(candidates, *event.Candidate)
+		if len(candidates) >= 3 {
+			return errStop
+		}
+		return nil
+	})
+	testza.AssertErrorIs(t, err, errStop)
+	testza.AssertLen(t, candidates, 3)
+	testza.AssertEqual(t, 3, last.Candidates)
+	testza.AssertTrue(t, last.Iterations >= 3, "Expected at least one iteration per candidate")
+	for _, c := range candidates {
+		testza.AssertLen(t, c.Solutions, 1)
+		testza.AssertEqual(t, uint64(96), c.GeneratorOptions.TargetCellValue)
+		testza.AssertEqual(t, 4, c.Rules.SizeX)
+	}
+
+	t.Run("Should stop when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		err := gen.GenerateGames(ctx, func(event tallylogic.GeneratorEvent) error {
+			if event.Candidate != nil {
+				t.Errorf("Expected no candidates after the context was cancelled")
+			}
+			return nil
+		})
+		testza.AssertErrorIs(t, err, context.Canceled)
+	})
+}
 func Benchmark_gameGeneratorTargetCell_GenerateGame(b *testing.B) {
 	gen, err := NewGameGeneratorForTargetCell(
 		GameGeneratorTargetCellOptions{
@@ -133,7 +133,7 @@ outer:
 	testza.AssertEqual(t, seedOriginal, seedCopy, "Expected the two games seed to be equal")
 	testza.AssertEqual(t, stateOriginal, stateCopy, "Expected the two games seedState to be equal")
 	t.Logf("Wrote %d bytes to %s for game. with length %d", game.History.Size(), outFile, game.History.Length())
-	testza.AssertEqual(t, 2102, game.History.Size(), "game history-size should match expected value (it should be low, like below 1 byte per move)")
+	testza.AssertEqual(t, 2103, game.History.Size(), "game history-size should match expected value (it should be low, like below 1 byte per move)")
 
 }
 
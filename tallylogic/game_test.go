@@ -6,6 +6,7 @@ import (
 	"github.com/MarvinJWendt/testza"
 	"github.com/go-test/deep"
 	"github.com/gookit/color"
+	"github.com/runar-rkmedia/gotally/types"
 )
 
 func BoardHightlighter(g *Game) func(CellValuer, int, string) string {
@@ -213,12 +214,9 @@ func TestGame_Play(t *testing.T) {
 			func(g *Game, t *testing.T) {
 				// TODO: update to use Instruction_
 				instructions := []any{
-					// Combine 4 into 4 (+) resulting in 8
+					// Combine 4 and 4 into 8 (+) resulting in 16
 					[2]int{2, 1},
 					[2]int{2, 2},
-					true,
-					// Combine 8 into 8 (+) resulting in 16
-					[2]int{2, 2},
 					[2]int{1, 2},
 					true,
 					// Combine 4 and 16 into 64 (*) resulting in 128
@@ -243,13 +241,10 @@ func TestGame_Play(t *testing.T) {
 					[2]int{0, 4},
 					[2]int{0, 3},
 					true,
-					// Combine 1 into 1 (+) resulting in 2
+					// Combine 1 and 1 into 2 (+) resulting in 4
 					[2]int{3, 3},
 					[2]int{4, 3},
-					true,
-					// Combine 2 into 2 (+) resulting in 4
 					[2]int{4, 4},
-					[2]int{4, 3},
 					true,
 					SwipeDirectionUp,
 					SwipeDirectionLeft,
@@ -281,8 +276,11 @@ func TestGame_Play(t *testing.T) {
 					}
 
 				}
+				if !NewGoalCheckerForRules(g.Rules).Check(*g) {
+					t.Errorf("Expected the game to be won within %d moves, used %d", g.Rules.MaxMoves, g.Moves())
+				}
 			},
-			960,
+			950,
 		},
 		{
 			"Game.History should reliably replay the game with the seeded randomizer",
@@ -364,3 +362,96 @@ func TestGame_Play(t *testing.T) {
 		})
 	}
 }
+
+func TestGame_Rules(t *testing.T) {
+	t.Run("No moves should be allowed after MaxMoves is reached", func(t *testing.T) {
+		g, err := RestoreGame(&types.Game{
+			Rules: types.Rules{
+				Mode:            types.RuleModeChallenge,
+				Rows:            3,
+				Columns:         3,
+				TargetCellValue: 80,
+				MaxMoves:        2,
+			},
+			Cells: cellCreator(
+				2, 2, 4,
+				0, 0, 0,
+				0, 0, 0,
+			),
+		})
+		testza.AssertNoError(t, err)
+		testza.AssertTrue(t, g.Swipe(SwipeDirectionDown))
+		testza.AssertFalse(t, g.IsGameOver())
+		testza.AssertTrue(t, g.Swipe(SwipeDirectionUp))
+		testza.AssertTrue(t, g.IsGameOver())
+		testza.AssertFalse(t, g.Swipe(SwipeDirectionDown))
+		testza.AssertFalse(t, g.EvaluateForPath([]int{0, 1, 2}))
+		testza.AssertEqual(t, 2, g.Moves())
+	})
+	t.Run("Undo should give back the move it undid", func(t *testing.T) {
+		g, err := RestoreGame(&types.Game{
+			Rules: types.Rules{
+				Mode:            types.RuleModeChallenge,
+				Rows:            3,
+				Columns:         3,
+				TargetCellValue: 80,
+				MaxMoves:        2,
+			},
+			Cells: cellCreator(
+				2, 2, 4,
+				0, 0, 0,
+				0, 0, 0,
+			),
+		})
+		testza.AssertNoError(t, err)
+		g.ReplaceBasedOn(g)
+		testza.AssertTrue(t, g.Swipe(SwipeDirectionDown))
+		testza.AssertNoError(t, g.Undo())
+		testza.AssertFalse(t, g.IsGameOver())
+		testza.AssertTrue(t, g.Swipe(SwipeDirectionDown))
+		testza.AssertFalse(t, g.IsGameOver())
+	})
+	t.Run("Undos should be counted when the game is restored", func(t *testing.T) {
+		tg := types.Game{
+			Rules: types.Rules{
+				Mode:            types.RuleModeChallenge,
+				Rows:            3,
+				Columns:         3,
+				TargetCellValue: 80,
+				MaxMoves:        2,
+			},
+			Cells: cellCreator(
+				2, 2, 4,
+				0, 0, 0,
+				0, 0, 0,
+			),
+		}
+		g, err := RestoreGame(&tg)
+		testza.AssertNoError(t, err)
+		g.ReplaceBasedOn(g)
+		testza.AssertTrue(t, g.Swipe(SwipeDirectionDown))
+		testza.AssertNoError(t, g.Undo())
+
+		tg.History = g.History.BytesCopy()
+		tg.Moves = uint(g.Moves())
+		restored, err := RestoreGame(&tg)
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, 0, restored.movesTowardsLimit())
+		testza.AssertTrue(t, restored.Swipe(SwipeDirectionDown))
+		testza.AssertTrue(t, restored.Swipe(SwipeDirectionUp))
+		testza.AssertTrue(t, restored.IsGameOver())
+	})
+	t.Run("Both TargetCellValue and TargetScore must be reached to win", func(t *testing.T) {
+		checker := NewGoalCheckerForRules(GameRules{TargetCellValue: 8, TargetScore: 100})
+		board := RestoreTableBoard(3, 3, cellCreator(
+			8, 0, 0,
+			0, 0, 0,
+			0, 0, 0,
+		), TableBoardOptions{})
+		g := Game{board: &board, score: 99}
+		testza.AssertFalse(t, checker.Check(g))
+		g.score = 100
+		testza.AssertTrue(t, checker.Check(g))
+		testza.AssertEqual(t, "Get a cell to at least a value of 8 and Get a score of at least 100", checker.Description())
+	})
+}
@@ -9,20 +9,6 @@ import (
 // These are a collection of constructed gamepositions that are intended to
 // give a challenge
 
-func NewDailyBoard() *TableBoard {
-	return &TableBoard{
-		rows:    5,
-		columns: 5,
-		cells: cellCreator(
-			0, 2, 1, 0, 1,
-			64, 4, 4, 1, 2,
-			64, 8, 4, 1, 0,
-			12, 3, 1, 0, 0,
-			16, 0, 0, 0, 0,
-		),
-	}
-}
-
 func GetGameTemplateById(ID string) *GameTemplate {
 	for _, g := range TutorialGames {
 		if g.ID == ID {
@@ -87,11 +73,29 @@ type GoalCheckLargestCell struct {
 type DefeatCheckerNoMoreMoves struct {
 	GoalCheck
 }
+
+// Used as a DefeatChecker, reports true once the game has used up all its moves
 type GoalCheckerMaxMoves struct {
 	GoalCheck
 	MaxMoves int
 }
 
+// Reports true once the score of the game has reached TargetScore
+type GoalCheckScore struct {
+	GoalCheck
+	TargetScore uint64
+}
+
+// Combines several GoalCheckers, where all of them must report true.
+type GoalCheckAll struct {
+	Checkers []GoalChecker
+}
+
+// Combines several GoalCheckers, where any of them may report true.
+type GoalCheckAny struct {
+	Checkers []GoalChecker
+}
+
 func (g GoalCheck) Description() string {
 	return g.description
 }
@@ -107,6 +111,11 @@ func (g DefeatCheckerNoMoreMoves) Check(game Game) bool {
 
 	hash := game.board.Hash()
 
+	// A swap or a super-power may still rescue the game
+	if game.hasSwapCandidate() || game.canUsePower() {
+		return false
+	}
+
 	// Swipe in all directions and see if we get new hints, or if that results in the same board
 	var copy Game
 	for _, dir := range []SwipeDirection{SwipeDirectionUp, SwipeDirectionRight, SwipeDirectionDown, SwipeDirectionLeft} {
@@ -138,8 +147,88 @@ func (g GoalCheckLargestCell) Check(game Game) bool {
 	}
 	return false
 }
+func (g GoalCheckerMaxMoves) Description() string {
+	return fmt.Sprintf("Within %d moves", g.MaxMoves)
+}
 func (g GoalCheckerMaxMoves) Check(game Game) bool {
-	return g.MaxMoves > game.Moves()
+	return game.movesTowardsLimit() >= g.MaxMoves
+}
+func (g GoalCheckScore) Description() string {
+	return fmt.Sprintf("Get a score of at least %d", g.TargetScore)
+}
+func (g GoalCheckScore) Check(game Game) bool {
+	return game.Score() >= int64(g.TargetScore)
+}
+func (g GoalCheckAll) Description() string {
+	return describeGoalCheckers(g.Checkers, " and ")
+}
+func (g GoalCheckAll) Check(game Game) bool {
+	if len(g.Checkers) == 0 {
+		return false
+	}
+	for _, c := range g.Checkers {
+		if !c.Check(game) {
+			return false
+		}
+	}
+	return true
+}
+func (g GoalCheckAny) Description() string {
+	return describeGoalCheckers(g.Checkers, " or ")
+}
+func (g GoalCheckAny) Check(game Game) bool {
+	for _, c := range g.Checkers {
+		if c.Check(game) {
+			return true
+		}
+	}
+	return false
+}
+func describeGoalCheckers(checkers []GoalChecker, sep string) string {
+	s := ""
+	for i, c := range checkers {
+		d := c.Description()
+		if d == "" {
+			continue
+		}
+		if i > 0 && s != "" {
+			s += sep
+		}
+		s += d
+	}
+	return s
+}
+
+// Creates the GoalChecker for the rules.
+// If both TargetCellValue and TargetScore are set, both must be reached to win
+// the game.
+func NewGoalCheckerForRules(rules GameRules) GoalChecker {
+	var checkers []GoalChecker
+	if rules.TargetCellValue > 0 {
+		checkers = append(checkers, GoalCheckLargestCell{TargetCellValue: rules.TargetCellValue})
+	}
+	if rules.TargetScore > 0 {
+		checkers = append(checkers, GoalCheckScore{TargetScore: rules.TargetScore})
+	}
+	switch len(checkers) {
+	case 0:
+		return GoalCheck{"Game runs forever"}
+	case 1:
+		return checkers[0]
+	}
+	return GoalCheckAll{checkers}
+}
+
+// Creates the DefeatChecker for the rules.
+// The game is lost when there are no more moves, or when MaxMoves is reached.
+func NewDefeatCheckerForRules(rules GameRules) GoalChecker {
+	if rules.MaxMoves == 0 {
+		return DefeatCheckerNoMoreMoves{}
+	}
+	return GoalCheckAny{[]GoalChecker{
+		GoalCheckerMaxMoves{MaxMoves: int(rules.MaxMoves)},
+		DefeatCheckerNoMoreMoves{},
+	}}
 }
 
 type GameTemplate struct {
@@ -151,6 +240,12 @@ type GameTemplate struct {
 	DefeatChecker         GoalChecker
 }
 
+// The number of swaps in infinite games. Challenges have no swaps, unless their rules says so.
+const DefaultMaxSwaps = 3
+
+// The number of super-powers in infinite games. Challenges have no super-powers, unless their rules says so.
+const DefaultMaxPowers = 3
+
 func DefaultGameRules(sizeX, sizeY int) GameRules {
 	return GameRules{
 		GameMode:        GameModeRandom,
@@ -158,12 +253,16 @@ func DefaultGameRules(sizeX, sizeY int) GameRules {
 		SizeY:           sizeY,
 		RecreateOnSwipe: true,
 		WithSuperPowers: true,
+		MaxSwaps:        DefaultMaxSwaps,
+		MaxPowers:       DefaultMaxPowers,
 		StartingBricks:  6,
 	}
 }
 func DefaultChallengeGameRules(sizeX, sizeY int, mode GameMode) GameRules {
 	rules := DefaultGameRules(sizeX, sizeY)
 	rules.StartingBricks = 0
+	rules.MaxSwaps = 0
+	rules.MaxPowers = 0
 	rules.RecreateOnSwipe = false
 	rules.GameMode = mode
 	return rules
@@ -174,30 +273,36 @@ func NewGameTemplate(mode GameMode, id, name, description string, rows, columns
 		ID:          id,
 		Name:        name,
 		Description: description,
-		Board:       NewTableBoard(rows, columns),
+		Board:       NewTableBoard(columns, rows),
 		Rows:        rows,
 		Columns:     columns,
-		Rules:       DefaultChallengeGameRules(rows, columns, mode),
+		Rules:       DefaultChallengeGameRules(columns, rows, mode),
 	}
 }
 
 func (t *GameTemplate) SetGoalCheckerLargestValue(targetCellValue uint64) *GameTemplate {
-	t.GoalChecker = GoalCheckLargestCell{
-		TargetCellValue: targetCellValue,
-	}
 	t.Rules.TargetCellValue = targetCellValue
+	t.GoalChecker = NewGoalCheckerForRules(t.Rules)
 	return t
 
 }
 func (t *GameTemplate) SetMaxMoves(moves int) *GameTemplate {
-	t.DefeatChecker = GoalCheckerMaxMoves{
-		MaxMoves: moves,
-	}
 	t.Rules.MaxMoves = uint64(moves)
+	t.DefeatChecker = NewDefeatCheckerForRules(t.Rules)
+	return t
+
+}
+func (t *GameTemplate) SetTargetScore(score uint64) *GameTemplate {
+	t.Rules.TargetScore = score
+	t.GoalChecker = NewGoalCheckerForRules(t.Rules)
 	return t
 
 }
 
+// Can be used in layouts, like SetStartingLayout, for cells that are walls or holes.
+// This allows for irregular boards, like L-shaped boards or boards with holes.
+const BlockedCell = cell.BlockedBaseValue
+
 // Deprecated, use SetStartingLayoutUints
 func (t *GameTemplate) SetStartingLayout(brickValue ...int64) *GameTemplate {
 	t.Board.cells = cellCreator(brickValue...)
@@ -218,20 +323,33 @@ func (t *GameTemplate) Create() GameTemplate {
 		Rows:        t.Rows,
 		Columns:     t.Columns,
 		Rules: GameRules{
-			GameMode:        t.Rules.GameMode,
-			SizeX:           t.Rules.SizeX,
-			SizeY:           t.Rules.SizeY,
-			RecreateOnSwipe: t.Rules.RecreateOnSwipe,
-			WithSuperPowers: t.Rules.WithSuperPowers,
-			StartingBricks:  t.Rules.StartingBricks,
-			MaxMoves:        t.Rules.MaxMoves,
-			TargetCellValue: t.Rules.TargetCellValue,
-			TargetScore:     t.Rules.TargetScore,
+			GameMode:           t.Rules.GameMode,
+			SizeX:              t.Rules.SizeX,
+			SizeY:              t.Rules.SizeY,
+			RecreateOnSwipe:    t.Rules.RecreateOnSwipe,
+			WithSuperPowers:    t.Rules.WithSuperPowers,
+			MaxSwaps:           t.Rules.MaxSwaps,
+			SwapNeighboursOnly: t.Rules.SwapNeighboursOnly,
+			MaxPowers:          t.Rules.MaxPowers,
+			StartingBricks:     t.Rules.StartingBricks,
+			MaxMoves:           t.Rules.MaxMoves,
+			TargetCellValue:    t.Rules.TargetCellValue,
+			TargetScore:        t.Rules.TargetScore,
+			Options: NewGameOptions{
+				TableBoardOptions: TableBoardOptions{
+					EvaluateOptions: t.Rules.Options.EvaluateOptions,
+					Topology:        t.Rules.Options.Topology,
+				},
+			},
 		},
 		Board: TableBoard{
 			rows:    t.Board.rows,
 			columns: t.Board.columns,
 			id:      t.ID,
+			TableBoardOptions: TableBoardOptions{
+				EvaluateOptions: t.Rules.Options.EvaluateOptions,
+				Topology:        t.Rules.Options.Topology,
+			},
 		},
 		GoalChecker:   t.GoalChecker,
 		DefeatChecker: t.DefeatChecker,
@@ -1,6 +1,8 @@
 package tallylogic
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -9,7 +11,6 @@ import (
 	"github.com/runar-rkmedia/gotally/randomizer"
 	"github.com/runar-rkmedia/gotally/tallylogic/cell"
 	"github.com/runar-rkmedia/gotally/tallylogic/cellgenerator"
-	"golang.org/x/net/context"
 )
 
 type GameGeneratorOptions struct {
@@ -139,19 +140,15 @@ func (gb GameGenerator) isUnsolvableQuickCheck(values []uint64, targetValue uint
 	return false
 }
 
-// deprecated
-// DEV-hack for now, see #23 at https://github.com/runar-rkmedia/gotally/issues/23
-func send[T any](name string, ch chan T, msg T) bool {
-	ch <- msg
-	return true
-	// Originial non-blocking send:
-	// select {
-	// case ch <- msg:
-	// 	return true
-	// default:
-	// 	return false
-	// }
-
+// Sends the message, unless the context is done first.
+// Returns false if the message was not sent.
+func sendCtx[T any](ctx context.Context, ch chan T, msg T) bool {
+	select {
+	case ch <- msg:
+		return true
+	case <-ctx.Done():
+		return false
+	}
 }
 
 // GenerateGame randomly generates a new board that is solvable within the requirements set
@@ -164,13 +161,13 @@ func (gb GameGenerator) GenerateGame(ctx context.Context) (Game, []Game, error)
 			MaxSolutions: 1,
 		},
 	}
-	solver := GameSolverFactory(options)
 
+	// Cancelled once the generator returns, which stops the remaining solvers
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	ch := make(chan SolvableGame, 100)
 	jobs := make(chan Game, gb.Concurrency)
 	doneCh := make(chan struct{})
-	quit := make(chan struct{})
-	quit2 := make(chan struct{})
 	errorsCh := make(chan string)
 	errors := map[string]int{}
 	start := time.Now()
@@ -182,29 +179,23 @@ func (gb GameGenerator) GenerateGame(ctx context.Context) (Game, []Game, error)
 			select {
 			case <-ctx.Done():
 
-				return
-			case <-quit:
-				send("q3", quit2, struct{}{})
 				return
 			case game := <-jobs:
 				go func(game Game) {
-					sb, err := gb.solveGame(solver, game, quit2)
+					// The boards are solved concurrently, so each has its own stats
+					attempt := options
+					attempt.Stats = &SolverStats{}
+					sb, err := gb.solveGame(ctx, GameSolverFactory(attempt), attempt.Stats, game)
 					if err != nil {
-						errorsCh <- err.Error()
+						sendCtx(ctx, errorsCh, err.Error())
 						return
 
 					}
 					if sb != nil {
-						ch <- *sb
-						send("q4", quit2, struct{}{})
+						sendCtx(ctx, ch, *sb)
 						return
-					} else {
-						ok1 := send("q1", quit2, struct{}{})
-						ok2 := send("q2", doneCh, struct{}{})
-						if !ok1 || !ok2 {
-							return
-						}
 					}
+					sendCtx(ctx, doneCh, struct{}{})
 				}(game)
 			}
 		}
@@ -283,14 +274,12 @@ outer:
 			solvableGames++
 			gb.GameSolutionChannel <- sg
 			if solvableGames >= gb.MinGames {
-				quit <- struct{}{}
 				return sg.Game, sg.Solutions, nil
 			}
 		case errMsg := <-errorsCh:
 			errorCount++
 			errors[errMsg]++
 			if (done + errorCount) > gb.MaxIterations {
-				quit <- struct{}{}
 				return Game{}, []Game{}, fmt.Errorf("Too many errors %v", errors)
 			}
 			generateJob()
@@ -311,15 +300,19 @@ type SolvableGame struct {
 	Solutions []Game
 }
 
-func (gb GameGenerator) solveGame(solver Solver, game Game, quitCh chan struct{}) (*SolvableGame, error) {
-	solutions, err := solver.SolveGame(game, quitCh)
-	if err != nil {
-		return nil, err
-	}
+// Solves a generated board, within a span for the attempt.
+// The stats must only be used by this solver while the board is solved, so
+// that the boards it visited can be counted.
+func (gb GameGenerator) solveGame(ctx context.Context, solver Solver, stats *SolverStats, game Game) (*SolvableGame, error) {
+	ctx, span := tracer.Start(ctx, "GameGenerator.solveGame")
+	visits := stats.Visits()
+	solutions, err := solver.SolveGame(ctx, game)
+	endSolveSpan(span, stats.Visits()-visits, solutions, err)
+	// Solutions found before the solver stopped early are still valid
 	if len(solutions) > 0 {
 		return &SolvableGame{gb.GameGeneratorOptions, game, solutions}, nil
 	}
-	return nil, nil
+	return nil, err
 }
 
 func (gb GameGenerator) GenerateBoardValues() []cell.Cell {
@@ -360,3 +353,231 @@ type GeneratedSolution struct {
 	Moves            int
 	VisualSolution   string `toml:",multiline,literal"`
 }
+
+// Creates the record of a solvable game, for writing to disk.
+// The solutions are ranked, so that the best solution comes first.
+func NewGeneratedGame(sg SolvableGame, name string) (GeneratedGame, error) {
+	if len(sg.Solutions) == 0 {
+		return GeneratedGame{}, fmt.Errorf("the game has no solutions")
+	}
+	// Same ranking as the hints given by the server
+	ranker := SolutionRankerForPreference(HintPreferenceShort)
+	if err := ranker.Rank(sg.Game, sg.Solutions); err != nil {
+		return GeneratedGame{}, fmt.Errorf("failed to rank solutions: %w", err)
+	}
+	cells := sg.Cells()
+	out := GeneratedGame{
+		GeneratorOptions: sg.GeneratorOptions,
+		Solutions:        make([]GeneratedSolution, len(sg.Solutions)),
+		Name:             name,
+		Preview:          sg.Print(),
+		Hash:             sg.Hash(),
+		Cells:            make([]int64, len(cells)),
+	}
+	stats, err := sg.Stats()
+	if err != nil {
+		return out, fmt.Errorf("failed to generate gamestats: %w", err)
+	}
+	out.Stats = stats
+	solutionStats, err := NewSolutionsStats(sg.Game, sg.Solutions)
+	if err != nil {
+		return out, fmt.Errorf("failed to generate solutionStats: %w", err)
+	}
+	out.SolutionStats = solutionStats
+	for i, c := range cells {
+		out.Cells[i] = c.Value()
+	}
+	for i, s := range sg.Solutions {
+		out.Solutions[i] = GeneratedSolution{
+			History:          s.History,
+			HighestCellValue: s.HighestCellValue(),
+			Score:            s.Score(),
+			Moves:            s.Moves(),
+		}
+		gameCopy := sg.Copy()
+		history, err := s.History.All()
+		if err != nil {
+			return out, fmt.Errorf("failed to iterate of history: %w", err)
+		}
+		for _, ins := range history {
+			gameCopy.Instruct(ins)
+			switch {
+			case ins.IsPath:
+				s := "\n" + gameCopy.DescribePath(ins.Path)
+				s += gameCopy.PrintForSelectionNoColor(ins.Path) + "\n"
+				out.Solutions[i].VisualSolution += s
+			case ins.IsSwipe:
+				out.Solutions[i].VisualSolution += "\n" + string(ins.Direction) + gameCopy.Print() + "\n"
+			default:
+				return out, fmt.Errorf("NotImplemented: %#v", ins)
+			}
+		}
+		out.Solutions[i].VisualSolution += "\nEnd: \n" + gameCopy.Print()
+	}
+	return out, nil
+}
+
+// Reasons for why a generated board was rejected
+const (
+	RejectionDuplicate      = "duplicate"
+	RejectionQuickCheck     = "unsolvable-quick-check"
+	RejectionNoSolution     = "no-solution"
+	RejectionSolverTimeout  = "solver-timeout"
+	RejectionSolverOverflow = "solver-overflow"
+)
+
+// Progress for a generator, reported while it is generating games.
+type GeneratorProgress struct {
+	// Number of boards generated
+	Iterations int
+	// Number of unique boards visited by the solvers
+	SolverVisits int
+	// Number of solvable games found
+	Candidates int
+	// The number of rejected boards, grouped by the reason
+	Rejections map[string]int
+}
+
+type GeneratorEvent struct {
+	Progress GeneratorProgress
+	// Set when a solvable game was found
+	Candidate *SolvableGame
+}
+
+// Receives the events from a generator. Returning an error stops the generator.
+type GeneratorEventHandler func(event GeneratorEvent) error
+
+// Keeps track of the progress for a generator, and sends it to the handler.
+// Progress without a candidate is only sent at the interval, to not flood the handler.
+type GeneratorProgressReporter struct {
+	progress GeneratorProgress
+	stats    *SolverStats
+	onEvent  GeneratorEventHandler
+	interval time.Duration
+	last     time.Time
+}
+
+func NewGeneratorProgressReporter(stats *SolverStats, interval time.Duration, onEvent GeneratorEventHandler) *GeneratorProgressReporter {
+	return &GeneratorProgressReporter{
+		progress: GeneratorProgress{Rejections: map[string]int{}},
+		stats:    stats,
+		onEvent:  onEvent,
+		interval: interval,
+		last:     time.Now(),
+	}
+}
+
+// Records that a new board was generated
+func (r *GeneratorProgressReporter) Iteration() {
+	r.progress.Iterations++
+}
+
+// Records that the last board was rejected
+func (r *GeneratorProgressReporter) Reject(reason string) error {
+	r.progress.Rejections[reason]++
+	if time.Since(r.last) < r.interval {
+		return nil
+	}
+	return r.send(nil)
+}
+
+// Records that a solvable game was found, and sends it to the handler
+func (r *GeneratorProgressReporter) Candidate(sg SolvableGame) error {
+	r.progress.Candidates++
+	return r.send(&sg)
+}
+
+// Sends the current progress to the handler
+func (r *GeneratorProgressReporter) Flush() error {
+	return r.send(nil)
+}
+
+func (r *GeneratorProgressReporter) Progress() GeneratorProgress {
+	p := r.progress
+	p.SolverVisits = r.stats.Visits()
+	p.Rejections = make(map[string]int, len(r.progress.Rejections))
+	for k, v := range r.progress.Rejections {
+		p.Rejections[k] = v
+	}
+	return p
+}
+
+func (r *GeneratorProgressReporter) send(candidate *SolvableGame) error {
+	r.last = time.Now()
+	return r.onEvent(GeneratorEvent{r.Progress(), candidate})
+}
+
+// GenerateGames generates games, until the context is done, MaxIterations is
+// reached, or the handler returns an error.
+// Each solvable game is sent to the handler as it is found, along with the progress.
+// In contrast to GenerateGame, the boards are generated and solved one at a time.
+func (gb GameGenerator) GenerateGames(ctx context.Context, onEvent GeneratorEventHandler) error {
+	stats := &SolverStats{}
+	// The boards are solved one at a time, so each board is spread across all cores
+	solver := GameSolverFactory(GameSolverFactoryOptions{
+		Parallel: true,
+		SolveOptions: SolveOptions{
+			MinMoves:     gb.MinMoves,
+			MaxMoves:     gb.MaxMoves,
+			MaxSolutions: 1,
+			MaxTime:      time.Second,
+			Stats:        stats,
+		},
+	})
+	reporter := NewGeneratorProgressReporter(stats, 500*time.Millisecond, onEvent)
+	seen := map[string]struct{}{}
+	for i := 0; gb.MaxIterations <= 0 || i < gb.MaxIterations; i++ {
+		if err := ctx.Err(); err != nil {
+			reporter.Flush()
+			return err
+		}
+		game := gb.generateGame()
+		reporter.Iteration()
+		hash := game.board.Hash()
+		if _, ok := seen[hash]; ok {
+			if err := reporter.Reject(RejectionDuplicate); err != nil {
+				return err
+			}
+			continue
+		}
+		seen[hash] = struct{}{}
+		if gb.TargetCellValue > 0 {
+			cells := game.Cells()
+			cellvalues := make([]uint64, len(cells))
+			for i := 0; i < len(cells); i++ {
+				cellvalues[i] = uint64(cells[i].Value())
+			}
+			if gb.isUnsolvableQuickCheck(cellvalues, gb.TargetCellValue) {
+				if err := reporter.Reject(RejectionQuickCheck); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		sg, err := gb.solveGame(ctx, solver, stats, game)
+		if err != nil {
+			if err := reporter.Reject(RejectionForSolverError(err)); err != nil {
+				return err
+			}
+			continue
+		}
+		if sg == nil {
+			if err := reporter.Reject(RejectionNoSolution); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := reporter.Candidate(*sg); err != nil {
+			return err
+		}
+	}
+	return reporter.Flush()
+}
+
+// Returns the rejection-reason for an error returned by a solver
+func RejectionForSolverError(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return RejectionSolverTimeout
+	}
+	return RejectionSolverOverflow
+}
@@ -1,12 +1,12 @@
 package tallylogic
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/runar-rkmedia/gotally/tallylogic/cell"
-	"golang.org/x/net/context"
 )
 
 func (g *hintCalculator) GetHint() *Hint {
@@ -20,6 +20,27 @@ func (g *hintCalculator) GetHints() map[string]Hint {
 }
 
 func (g *hintCalculator) GetNHints(maxHints int) map[string]Hint {
+	return g.getNHints(maxHints, nil)
+}
+
+// Returns the first hint with a path through any of the indexes, or nil if there is none.
+func (g *hintCalculator) GetHintThrough(indexes ...int) *Hint {
+	hints := g.getNHints(1, func(h Hint) bool {
+		for _, i := range indexes {
+			if contains(h.Path, i) {
+				return true
+			}
+		}
+		return false
+	})
+	for _, v := range hints {
+		return &v
+	}
+	return nil
+}
+
+// Like GetNHints, but only the hints that matches are included, if match is set.
+func (g *hintCalculator) getNHints(maxHints int, match func(h Hint) bool) map[string]Hint {
 	cells := g.Cells()
 	length := len(cells)
 	valueForIndex := make([]int64, length)
@@ -49,6 +70,9 @@ func (g *hintCalculator) GetNHints(maxHints int) map[string]Hint {
 				return hints
 			}
 		case h := <-ch:
+			if match != nil && !match(h) {
+				continue
+			}
 			hints[h.pathHash] = h
 			if maxHints > 0 && maxHints >= len(hints) {
 				cancel()
@@ -160,11 +184,16 @@ outer:
 			continue
 		}
 		if value > 0 {
-			ch <- NewHint(
+			// The receiver stops reading once it has the hints it wants
+			select {
+			case ch <- NewHint(
 				value*2,
 				method,
 				newPath,
-			)
+			):
+			case <-ctx.Done():
+				return
+			}
 		}
 		g.getHints(ctx, ch, valueForIndex, neighboursForIndex, newPath)
 	}
@@ -198,8 +227,15 @@ func (h Hint) String() string {
 	}
 
 	method := "multiplication"
-	if h.Method == EvalMethodSum {
+	switch h.Method {
+	case EvalMethodSum:
 		method = "addition"
+	case EvalMethodSubtraction:
+		method = "subtraction"
+	case EvalMethodDivision:
+		method = "division"
+	case EvalMethodMixed:
+		method = "mixed operators"
 	}
 	return fmt.Sprintf("Combine path by %s for %d (%v)", method, h.Value, h.Path)
 }
@@ -46,6 +46,57 @@ func Test_hintCalculator_GetHints(t *testing.T) {
 			},
 			3,
 		},
+		{
+			"Get hints for subtraction",
+			&TableBoard{
+				cells: cellCreator(
+					0, 5, 3,
+					0, 0, 2,
+					0, 0, 0,
+				),
+				rows:    3,
+				columns: 3,
+				TableBoardOptions: TableBoardOptions{
+					EvaluateOptions: EvaluateOptions{WithSubtraction: true},
+				},
+			},
+			[]Hint{
+				{
+					Value:  4,
+					Method: EvalMethodSubtraction,
+					Path:   []int{1, 2, 5},
+				},
+				{
+					Value:  10,
+					Method: EvalMethodSum,
+					Path:   []int{5, 2, 1},
+				},
+			},
+			2,
+		},
+		{
+			"Get hints for diagonal paths",
+			&TableBoard{
+				cells: cellCreator(
+					0, 0, 3,
+					0, 1, 0,
+					4, 0, 0,
+				),
+				rows:    3,
+				columns: 3,
+				TableBoardOptions: TableBoardOptions{
+					Topology: TopologyEightWay,
+				},
+			},
+			[]Hint{
+				{
+					Value:  8,
+					Method: EvalMethodSum,
+					Path:   []int{2, 4, 6},
+				},
+			},
+			1,
+		},
 		{
 			"Test for challenge 0",
 			mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[0])().board,
@@ -8,6 +8,13 @@ func (g *Game) DescribeInstruction(instruction Instruction_) string {
 	switch {
 	case instruction.IsPath:
 		return fmt.Sprintf("Combining path: %v", instruction.Path)
+	case instruction.IsHelperSwap():
+		return fmt.Sprintf("Swapping cells %d and %d", instruction.Path[0], instruction.Path[1])
+	case instruction.IsHelperPower():
+		if len(instruction.Path) == 0 {
+			return fmt.Sprintf("Using super-power %s", instruction.Power)
+		}
+		return fmt.Sprintf("Using super-power %s on cell %d", instruction.Power, instruction.Path[0])
 	case instruction.IsSwipe:
 		switch instruction.Direction {
 		case SwipeDirectionUp:
@@ -23,6 +30,15 @@ func (g *Game) DescribeInstruction(instruction Instruction_) string {
 	panic(fmt.Sprintf("NotImplemented:Game:DescribeInstruction: %#v", instruction))
 }
 
+// Returns the index of the cell for instructions that has a single index, like
+// most super-powers, or zero.
+func instructionIndex(instruction Instruction_) int {
+	if len(instruction.Path) == 0 {
+		return 0
+	}
+	return instruction.Path[0]
+}
+
 // This is used to Instruct the game using small data-values Not really sure
 // how this will work in the end, But I am thinking to have a
 // data-expander/compressor that will have a precalculated set of instructions
@@ -48,7 +64,18 @@ func (g *Game) Instruct(instruction Instruction_) bool {
 	case instruction.IsHelper:
 		switch instruction.Helper {
 		case helperUndo:
-			g.Undo()
+			return g.Undo() == nil
+		case helperRedo:
+			return g.Redo() == nil
+		case helperSwap:
+			return g.SwapCells(instruction.Path[0], instruction.Path[1]) == nil
+		case helperPower:
+			return g.UsePower(instruction.Power, instructionIndex(instruction)) == nil
+		default:
+			// Helpers, other than undo, are super-powers
+			if !g.Rules.WithSuperPowers {
+				return false
+			}
 		}
 	default:
 		panic(fmt.Sprintf("Unknown instruction %#v", instruction))
@@ -0,0 +1,235 @@
+package tallylogic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+)
+
+var (
+	ErrPowerNotAllowed  = errors.New("Super-powers are not allowed by the rules")
+	ErrPowerNoneLeft    = errors.New("There are no super-powers left")
+	ErrPowerUnknown     = errors.New("Unknown super-power")
+	ErrPowerEmptyCell   = errors.New("Super-powers cannot be used on empty cells")
+	ErrPowerCannotSplit = errors.New("The cell has only one factor, and cannot be split")
+	ErrPowerNoRoom      = errors.New("There are not enough empty cells to split the cell into its factors")
+	ErrPowerNoEffect    = errors.New("The super-power would not change the board")
+)
+
+// A super-power changes the board in ways that the other moves cannot.
+// Super-powers require WithSuperPowers, and the number of uses is limited by MaxPowers.
+type Power byte
+
+// The values are stored in the CompactHistory, and must fit in a triplet.
+// Zero is not used, since trailing zero-triplets are treated as padding.
+const (
+	// Removes the cell from the board
+	PowerRemoveCell Power = iota + 1
+	// Doubles the value of the cell
+	PowerDoubleCell
+	// Splits the cell into its prime factors. The cell keeps the largest
+	// factor, and the others are placed in the empty cells closest to it.
+	PowerSplitCell
+	// Shuffles all the cells of the board, including the empty ones
+	PowerShuffle
+)
+
+func (p Power) String() string {
+	switch p {
+	case PowerRemoveCell:
+		return "RemoveCell"
+	case PowerDoubleCell:
+		return "DoubleCell"
+	case PowerSplitCell:
+		return "SplitCell"
+	case PowerShuffle:
+		return "Shuffle"
+	}
+	return fmt.Sprintf("Power(%d)", p)
+}
+
+// Used by CompactHistory.Describe
+func (p Power) short() string {
+	switch p {
+	case PowerRemoveCell:
+		return "R"
+	case PowerDoubleCell:
+		return "D"
+	case PowerSplitCell:
+		return "F"
+	case PowerShuffle:
+		return "S"
+	}
+	return "?"
+}
+func (p Power) valid() bool {
+	return p >= PowerRemoveCell && p <= PowerShuffle
+}
+
+// Whether the power is used on a single cell
+func (p Power) targetsCell() bool {
+	return p != PowerShuffle
+}
+
+// Uses the super-power on the cell at the index, if the rules allows it.
+// The index is ignored for powers that are not used on a cell, like PowerShuffle.
+//
+// Using a super-power counts as a move, and uses one of the super-powers
+// allowed by the rules until it is undone.
+func (g *Game) UsePower(power Power, index int) error {
+	if err := g.validatePower(power, index); err != nil {
+		return err
+	}
+	switch power {
+	case PowerRemoveCell:
+		g.board.AddCellToBoard(cell.NewEmptyCell(), index, true)
+	case PowerDoubleCell:
+		g.board.AddCellToBoard(g.board.GetCellAtIndex(index).Doubled(), index, true)
+	case PowerSplitCell:
+		factors := g.board.GetCellAtIndex(index).Factors().Factors()
+		largest := len(factors) - 1
+		g.board.AddCellToBoard(cell.NewCell(int64(factors[largest]), 0), index, true)
+		for i, target := range g.closestEmptyCells(index, largest) {
+			g.board.AddCellToBoard(cell.NewCell(int64(factors[i]), 0), target, true)
+		}
+	case PowerShuffle:
+		// Blocked cells are not shuffled
+		indexes := g.unblockedIndexes()
+		shuffled := make([]cell.Cell, len(indexes))
+		for i, index := range indexes {
+			shuffled[i] = *g.board.GetCellAtIndex(index)
+		}
+		// The cell-generator is used, so that the shuffle can be replayed
+		for i := len(shuffled) - 1; i > 0; i-- {
+			j := g.cellGenerator.Intn(i + 1)
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		}
+		for i, c := range shuffled {
+			g.board.AddCellToBoard(c, indexes[i], true)
+		}
+	}
+	g.ClearSelection()
+	g.inceaseMoveCount()
+	g.History.AddPower(power, index)
+	return nil
+}
+
+// Returns the number of super-powers the player can still use.
+func (g Game) PowersLeft() int {
+	if !g.Rules.WithSuperPowers {
+		return 0
+	}
+	left := int(g.Rules.MaxPowers) - g.powersUsed()
+	if left < 0 {
+		return 0
+	}
+	return left
+}
+
+// Returns the number of super-powers in the history that are not undone.
+func (g Game) powersUsed() int {
+	done, _, _ := g.History.UndoStacks()
+	used := 0
+	for _, ins := range done {
+		if ins.IsHelperPower() {
+			used++
+		}
+	}
+	return used
+}
+
+// Reports whether any super-power can be used. Removing a cell is always
+// possible as long as there is a cell to remove, and may make room for a swipe.
+func (g Game) canUsePower() bool {
+	if g.PowersLeft() == 0 || g.hasReachedMaxMoves() {
+		return false
+	}
+	for i := range g.Cells() {
+		if g.validatePowerTarget(PowerRemoveCell, i) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports why the super-power cannot be used, or nil if it can.
+func (g Game) validatePower(power Power, index int) error {
+	if !power.valid() {
+		return fmt.Errorf("%w: %d", ErrPowerUnknown, power)
+	}
+	if !g.Rules.WithSuperPowers || g.Rules.MaxPowers == 0 {
+		return ErrPowerNotAllowed
+	}
+	if g.PowersLeft() == 0 {
+		return ErrPowerNoneLeft
+	}
+	if g.hasReachedMaxMoves() {
+		return ErrNoMovesLeft
+	}
+	return g.validatePowerTarget(power, index)
+}
+
+// Like validatePower, but only checks the board, not whether the game has any super-powers left.
+func (g Game) validatePowerTarget(power Power, index int) error {
+	if !power.targetsCell() {
+		values := map[int64]struct{}{}
+		for _, index := range g.unblockedIndexes() {
+			values[g.board.GetCellAtIndex(index).Value()] = struct{}{}
+		}
+		if len(values) < 2 {
+			return ErrPowerNoEffect
+		}
+		return nil
+	}
+	if !g.board.ValidCellIndex(index) {
+		return fmt.Errorf("%w: cannot use %s on %d", ErrIndexInvalid, power, index)
+	}
+	c := g.board.GetCellAtIndex(index)
+	if c.IsBlocked() {
+		return ErrCellIsBlocked
+	}
+	if c.IsEmpty() {
+		return ErrPowerEmptyCell
+	}
+	if power == PowerSplitCell {
+		factors := c.Factors().Factors()
+		if len(factors) < 2 {
+			return ErrPowerCannotSplit
+		}
+		if len(g.board.ListEmptyCells()) < len(factors)-1 {
+			return ErrPowerNoRoom
+		}
+	}
+	return nil
+}
+
+// Returns the indexes of the cells that are not blocked
+func (g Game) unblockedIndexes() []int {
+	indexes := []int{}
+	for i, c := range g.Cells() {
+		if !c.IsBlocked() {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// Returns up to n empty cells, the closest to the index first.
+// Cells at the same distance are ordered by their index.
+func (g Game) closestEmptyCells(index, n int) []int {
+	empty := g.board.ListEmptyCells()
+	x, y := g.board.IndexToCord(index)
+	distance := func(i int) int {
+		ix, iy := g.board.IndexToCord(i)
+		return abs(ix-x) + abs(iy-y)
+	}
+	sort.SliceStable(empty, func(a, b int) bool {
+		return distance(empty[a]) < distance(empty[b])
+	})
+	if len(empty) > n {
+		return empty[:n]
+	}
+	return empty
+}
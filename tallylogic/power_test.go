@@ -0,0 +1,188 @@
+package tallylogic
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func powerTestCells() []cell.Cell {
+	return cellCreator(
+		12, 5, 0,
+		7, 3, 0,
+		5, 7, 4,
+	)
+}
+
+func powerTestRules(rules types.Rules) types.Rules {
+	rules.Mode = types.RuleModeChallenge
+	rules.Rows = 3
+	rules.Columns = 3
+	rules.TargetCellValue = 64
+	return rules
+}
+
+func restorePowerGameForTest(t *testing.T, rules types.Rules) Game {
+	t.Helper()
+	g, err := RestoreGame(&types.Game{
+		Rules: powerTestRules(rules),
+		Cells: powerTestCells(),
+	})
+	testza.AssertNoError(t, err)
+	g.ReplaceBasedOn(g)
+	return g
+}
+
+func cellValuesForTest(cells []cell.Cell) []int64 {
+	values := make([]int64, len(cells))
+	for i, c := range cells {
+		values[i] = c.Value()
+	}
+	return values
+}
+
+func TestGame_UsePower(t *testing.T) {
+	t.Run("Should use super-powers within the limit of the rules", func(t *testing.T) {
+		g := restorePowerGameForTest(t, types.Rules{MaxPowers: 4})
+		testza.AssertEqual(t, 4, g.PowersLeft())
+
+		testza.AssertNoError(t, g.UsePower(PowerDoubleCell, 1))
+		testza.AssertEqual(t, int64(10), g.Cells()[1].Value())
+		testza.AssertEqual(t, 1, g.Moves())
+		testza.AssertEqual(t, 3, g.PowersLeft())
+
+		testza.AssertNoError(t, g.UsePower(PowerRemoveCell, 4))
+		testza.AssertTrue(t, g.Cells()[4].IsEmpty())
+		testza.AssertErrorIs(t, g.UsePower(PowerRemoveCell, 4), ErrPowerEmptyCell)
+		testza.AssertErrorIs(t, g.UsePower(PowerDoubleCell, 9), ErrIndexInvalid)
+
+		testza.AssertNoError(t, g.UsePower(PowerShuffle, 0))
+		for i, c := range g.Cells() {
+			if !c.IsEmpty() {
+				testza.AssertNoError(t, g.UsePower(PowerRemoveCell, i))
+				break
+			}
+		}
+		testza.AssertErrorIs(t, g.UsePower(PowerDoubleCell, 0), ErrPowerNoneLeft)
+		testza.AssertEqual(t, 4, g.Moves())
+	})
+	t.Run("Should split a cell into its factors, closest first", func(t *testing.T) {
+		g := restorePowerGameForTest(t, types.Rules{MaxPowers: 3})
+		testza.AssertErrorIs(t, g.UsePower(PowerSplitCell, 3), ErrPowerCannotSplit)
+		testza.AssertNoError(t, g.UsePower(PowerSplitCell, 0))
+		testza.AssertEqual(t, []int64{
+			3, 5, 2,
+			7, 3, 2,
+			5, 7, 4,
+		}, cellValuesForTest(g.Cells()))
+		testza.AssertErrorIs(t, g.UsePower(PowerSplitCell, 8), ErrPowerNoRoom)
+	})
+	t.Run("Should shuffle the board", func(t *testing.T) {
+		g := restorePowerGameForTest(t, types.Rules{MaxPowers: 1})
+		before := cellValuesForTest(g.Cells())
+		testza.AssertNoError(t, g.UsePower(PowerShuffle, 0))
+		after := cellValuesForTest(g.Cells())
+		testza.AssertNotEqual(t, before, after)
+		testza.AssertEqual(t, countValuesForTest(before), countValuesForTest(after), "Expected the same cells after the shuffle")
+
+		g, err := RestoreGame(&types.Game{
+			Rules: powerTestRules(types.Rules{MaxPowers: 1}),
+			Cells: cellCreator(2, 2, 2, 2, 2, 2, 2, 2, 2),
+		})
+		testza.AssertNoError(t, err)
+		testza.AssertErrorIs(t, g.UsePower(PowerShuffle, 0), ErrPowerNoEffect)
+	})
+	t.Run("Should not move or target blocked cells", func(t *testing.T) {
+		g, err := RestoreGame(&types.Game{
+			Rules: powerTestRules(types.Rules{MaxPowers: 3}),
+			Cells: cellCreator(
+				12, 5, 0,
+				7, BlockedCell, 0,
+				5, 7, 4,
+			),
+		})
+		testza.AssertNoError(t, err)
+		testza.AssertErrorIs(t, g.UsePower(PowerRemoveCell, 4), ErrCellIsBlocked)
+		testza.AssertErrorIs(t, g.UsePower(PowerDoubleCell, 4), ErrCellIsBlocked)
+		for i := 0; i < 3; i++ {
+			testza.AssertNoError(t, g.UsePower(PowerShuffle, 0))
+			testza.AssertTrue(t, g.Cells()[4].IsBlocked(), "Expected the blocked cell to stay in place")
+		}
+	})
+	t.Run("Should not allow super-powers unless the rules allows it", func(t *testing.T) {
+		g := restorePowerGameForTest(t, types.Rules{})
+		testza.AssertErrorIs(t, g.UsePower(PowerRemoveCell, 0), ErrPowerNotAllowed)
+		g = restorePowerGameForTest(t, types.Rules{MaxPowers: 2, NoSuperPowers: true})
+		testza.AssertErrorIs(t, g.UsePower(PowerRemoveCell, 0), ErrPowerNotAllowed)
+		testza.AssertEqual(t, 0, g.PowersLeft())
+		testza.AssertErrorIs(t, g.UsePower(Power(0), 0), ErrPowerUnknown)
+		testza.AssertErrorIs(t, g.UsePower(Power(7), 0), ErrPowerUnknown)
+	})
+	t.Run("Should store the super-powers in the history", func(t *testing.T) {
+		for _, size := range [][2]int{{3, 3}, {5, 5}, {12, 12}} {
+			h := NewCompactHistory(size[0], size[1])
+			h.AddPower(PowerRemoveCell, 0)
+			h.AddPower(PowerDoubleCell, 8)
+			h.AddPower(PowerShuffle, 0)
+			h.AddPower(PowerSplitCell, 7)
+			h.AddSwipe(SwipeDirectionUp)
+			testza.AssertEqual(t, "PR0;PD8;PS;PF7;U;", h.Describe())
+			all, err := h.All()
+			testza.AssertNoError(t, err)
+			testza.AssertLen(t, all, 5)
+			testza.AssertTrue(t, all[1].Equal(NewPowerInstruction_(PowerDoubleCell, 8)))
+			testza.AssertFalse(t, all[1].Equal(NewPowerInstruction_(PowerRemoveCell, 8)))
+			testza.AssertTrue(t, all[2].Equal(NewPowerInstruction_(PowerShuffle, 0)))
+		}
+	})
+	t.Run("Undo should give back the super-power", func(t *testing.T) {
+		g := restorePowerGameForTest(t, types.Rules{MaxPowers: 1})
+		start := g.Print()
+		testza.AssertNoError(t, g.UsePower(PowerShuffle, 0))
+		shuffled := g.Print()
+		testza.AssertEqual(t, 0, g.PowersLeft())
+		testza.AssertNoError(t, g.Undo())
+		testza.AssertEqual(t, start, g.Print())
+		testza.AssertEqual(t, 1, g.PowersLeft())
+		testza.AssertNoError(t, g.Redo())
+		testza.AssertEqual(t, shuffled, g.Print(), "Expected the redo to shuffle the board the same way")
+	})
+	t.Run("Should replay super-powers", func(t *testing.T) {
+		g := restorePowerGameForTest(t, types.Rules{MaxPowers: 3})
+		seed, state := g.Seed()
+		atStart := types.Game{
+			Rules: powerTestRules(types.Rules{MaxPowers: 3}),
+			Cells: powerTestCells(),
+			Seed:  seed,
+			State: state,
+		}
+		testza.AssertNoError(t, g.UsePower(PowerSplitCell, 0))
+		testza.AssertNoError(t, g.UsePower(PowerShuffle, 0))
+		testza.AssertNoError(t, g.UsePower(PowerDoubleCell, 4))
+		seed, state = g.Seed()
+		replay, err := ReplayGame(atStart, g.History.BytesCopy())
+		testza.AssertNoError(t, err)
+		testza.AssertTrue(t, replay.Steps[0].Instruction.Equal(NewPowerInstruction_(PowerSplitCell, 0)))
+		testza.AssertNoError(t, replay.Verify(types.Game{
+			Seed:  seed,
+			State: state,
+			Score: uint64(g.Score()),
+			Moves: uint(g.Moves()),
+			Cells: g.Cells(),
+		}))
+	})
+	t.Run("The game is not over while a super-power can rescue it", func(t *testing.T) {
+		testza.AssertTrue(t, restoreSwapGameForTest(t, types.Rules{}).IsGameOver())
+		testza.AssertFalse(t, restoreSwapGameForTest(t, types.Rules{MaxPowers: 1}).IsGameOver())
+	})
+}
+
+func countValuesForTest(values []int64) map[int64]int {
+	count := map[int64]int{}
+	for _, v := range values {
+		count[v]++
+	}
+	return count
+}
@@ -0,0 +1,246 @@
+package tallylogic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// There are usually a lot of solutions available for a game,
+// but some of them leads to more fun games than others.
+// In general, multiplication is more fun than addition
+// and swiping is a bit dull.
+// Long hints seem like magic, so we prefer shorter hints.
+// However, too short hints are also boring.
+//
+// A SolutionRanker orders solutions by a weighted sum of scorers, where each
+// scorer looks at a single measurable fact about the solution.
+
+// Measurable facts about a solution, relative to the game it was solved from.
+type SolutionFacts struct {
+	// Number of instructions in the solution, including swipes and combines
+	Instructions int
+	Swipes       int
+	Combines     int
+	// Combines where the cells were multiplied together
+	ProductCombines int
+	// Combines where the cells were added together
+	SumCombines int
+	// Index of the first combine within the instructions, or -1 if there are none.
+	FirstCombineIndex int
+	// Score gained from the original game
+	ScoreGained int64
+}
+
+// Gathers the facts about a solution by replaying it on a copy of the
+// original game.
+func NewSolutionFacts(original Game, solution Game) (SolutionFacts, error) {
+	facts := SolutionFacts{
+		FirstCombineIndex: -1,
+		ScoreGained:       solution.Score() - original.Score(),
+	}
+	history, err := solution.History.All()
+	if err != nil {
+		return facts, fmt.Errorf("failed to retrieve history for solution: %w", err)
+	}
+	game := original.Copy()
+	facts.Instructions = len(history)
+	for i, ins := range history {
+		switch {
+		case ins.IsSwipe:
+			facts.Swipes++
+		case ins.IsPath:
+			if facts.FirstCombineIndex < 0 {
+				facts.FirstCombineIndex = i
+			}
+			facts.Combines++
+			_, method, err := game.board.EvaluatesTo(ins.Path, false, false)
+			if err != nil {
+				return facts, fmt.Errorf("failed to evaluate path at instruction %d: %w", i, err)
+			}
+			switch method {
+			case EvalMethodProduct:
+				facts.ProductCombines++
+			case EvalMethodSum:
+				facts.SumCombines++
+			}
+		}
+		if !game.Instruct(ins) {
+			return facts, fmt.Errorf("failed to replay instruction %d of solution", i)
+		}
+	}
+	return facts, nil
+}
+
+// A SolutionScorer gives a solution a score from its facts.
+// A higher score is better.
+type SolutionScorer interface {
+	Name() string
+	Score(facts SolutionFacts) float64
+}
+
+type solutionScorer struct {
+	name  string
+	score func(facts SolutionFacts) float64
+}
+
+func (s solutionScorer) Name() string {
+	return s.name
+}
+func (s solutionScorer) Score(facts SolutionFacts) float64 {
+	return s.score(facts)
+}
+
+var (
+	// Prefers solutions with fewer instructions
+	ScorerFewInstructions SolutionScorer = solutionScorer{"few-instructions", func(f SolutionFacts) float64 {
+		return -float64(f.Instructions)
+	}}
+	// Prefers solutions with fewer swipes
+	ScorerFewSwipes SolutionScorer = solutionScorer{"few-swipes", func(f SolutionFacts) float64 {
+		return -float64(f.Swipes)
+	}}
+	// Prefers solutions that combine cells early
+	ScorerEarlyCombine SolutionScorer = solutionScorer{"early-combine", func(f SolutionFacts) float64 {
+		if f.FirstCombineIndex < 0 {
+			return -float64(f.Instructions)
+		}
+		return -float64(f.FirstCombineIndex)
+	}}
+	// Prefers solutions with few swipes for every combine
+	ScorerSwipesToCombineRatio SolutionScorer = solutionScorer{"swipes-to-combine-ratio", func(f SolutionFacts) float64 {
+		if f.Combines == 0 {
+			return -float64(f.Swipes)
+		}
+		return -float64(f.Swipes) / float64(f.Combines)
+	}}
+	// Prefers solutions where a larger share of the combines are products
+	// The value is between 0 and 1.
+	ScorerProductCombines SolutionScorer = solutionScorer{"product-combines", func(f SolutionFacts) float64 {
+		if f.Combines == 0 {
+			return 0
+		}
+		return float64(f.ProductCombines) / float64(f.Combines)
+	}}
+	// Prefers solutions with a high score-gain.
+	// The value is logarithmic, so that it does not drown out other scorers.
+	ScorerScoreGained SolutionScorer = solutionScorer{"score-gained", func(f SolutionFacts) float64 {
+		if f.ScoreGained <= 0 {
+			return 0
+		}
+		return math.Log2(1 + float64(f.ScoreGained))
+	}}
+)
+
+type WeightedScorer struct {
+	SolutionScorer
+	Weight float64
+}
+
+type SolutionRanker struct {
+	Name    string
+	Scorers []WeightedScorer
+}
+
+func NewSolutionRanker(name string, scorers ...WeightedScorer) SolutionRanker {
+	return SolutionRanker{name, scorers}
+}
+
+// Returns the weighted score for the facts. A higher score is better.
+func (r SolutionRanker) Score(facts SolutionFacts) float64 {
+	var score float64
+	for _, s := range r.Scorers {
+		score += s.Weight * s.Score(facts)
+	}
+	return score
+}
+
+// Sorts the solutions in place, with the best solution first.
+// Solutions that score equally keep their original order.
+func (r SolutionRanker) Rank(original Game, solutions []Game) error {
+	scores := make([]float64, len(solutions))
+	order := make([]int, len(solutions))
+	for i := range solutions {
+		facts, err := NewSolutionFacts(original, solutions[i])
+		if err != nil {
+			return fmt.Errorf("failed to gather facts for solution %d: %w", i, err)
+		}
+		scores[i] = r.Score(facts)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+	ranked := make([]Game, len(solutions))
+	for i, o := range order {
+		ranked[i] = solutions[o]
+	}
+	copy(solutions, ranked)
+	return nil
+}
+
+type HintPreference int
+
+const (
+	// Prefers the shortest solution, counting swipes and combines.
+	HintPreferenceShort HintPreference = iota + 1
+	// Prefers the solution giving the highest score
+	HintPreferenceHighestScore
+	// Prefers the solution with the least amount of swipes
+	HintPreferenceMinimumSwipes
+	// Prefers the solution with the lowest swipes to combine ratio
+	HintPreferenceMinimumSwipesToCombineRatio
+	// Prefers the solution that combines cells first
+	HintPreferenceFirstCombine
+)
+
+func (p HintPreference) String() string {
+	switch p {
+	case HintPreferenceShort:
+		return "short"
+	case HintPreferenceHighestScore:
+		return "highest-score"
+	case HintPreferenceMinimumSwipes:
+		return "minimum-swipes"
+	case HintPreferenceMinimumSwipesToCombineRatio:
+		return "minimum-swipes-to-combine-ratio"
+	case HintPreferenceFirstCombine:
+		return "first-combine"
+	}
+	return fmt.Sprintf("err: Invalid hint-preference: %d", p)
+}
+
+// Each strategy has a primary scorer, and some lightly weighted scorers to
+// break ties.
+var solutionRankers = map[HintPreference]SolutionRanker{
+	HintPreferenceShort: NewSolutionRanker(HintPreferenceShort.String(),
+		WeightedScorer{ScorerFewInstructions, 1},
+		WeightedScorer{ScorerProductCombines, 0.1},
+		WeightedScorer{ScorerFewSwipes, 0.01},
+	),
+	HintPreferenceHighestScore: NewSolutionRanker(HintPreferenceHighestScore.String(),
+		WeightedScorer{ScorerScoreGained, 1},
+		WeightedScorer{ScorerFewInstructions, 0.001},
+	),
+	HintPreferenceMinimumSwipes: NewSolutionRanker(HintPreferenceMinimumSwipes.String(),
+		WeightedScorer{ScorerFewSwipes, 1},
+		WeightedScorer{ScorerFewInstructions, 0.01},
+	),
+	HintPreferenceMinimumSwipesToCombineRatio: NewSolutionRanker(HintPreferenceMinimumSwipesToCombineRatio.String(),
+		WeightedScorer{ScorerSwipesToCombineRatio, 1},
+		WeightedScorer{ScorerFewInstructions, 0.01},
+	),
+	HintPreferenceFirstCombine: NewSolutionRanker(HintPreferenceFirstCombine.String(),
+		WeightedScorer{ScorerEarlyCombine, 1},
+		WeightedScorer{ScorerFewInstructions, 0.01},
+	),
+}
+
+// Returns the ranker for the preference.
+// Unknown preferences falls back to HintPreferenceShort
+func SolutionRankerForPreference(preference HintPreference) SolutionRanker {
+	if r, ok := solutionRankers[preference]; ok {
+		return r
+	}
+	return solutionRankers[HintPreferenceShort]
+}
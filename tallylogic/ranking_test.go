@@ -0,0 +1,133 @@
+package tallylogic
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+)
+
+func playForTest(t *testing.T, g Game, instructions ...Instruction_) Game {
+	t.Helper()
+	game := g.Copy()
+	game.History = NewCompactHistoryFromGame(game)
+	for i, ins := range instructions {
+		if !game.Instruct(ins) {
+			t.Fatalf("failed to play instruction %d: %#v\n%s", i, ins, game.Print())
+		}
+	}
+	return game
+}
+
+func TestNewSolutionFacts(t *testing.T) {
+	g := createGame(
+		4, 10, 12,
+		2, 10, 8,
+		1, 7, 0,
+	)
+	solution := playForTest(t, g,
+		// 7+1+2=10
+		NewPathInstruction_([]int{7, 6, 3, 4}),
+		// 12+8=20
+		NewPathInstruction_([]int{2, 5, 4}),
+		// 4*10=40
+		NewPathInstruction_([]int{0, 1, 4}),
+	)
+	facts, err := NewSolutionFacts(g, solution)
+	testza.AssertNoError(t, err)
+	testza.AssertEqual(t, SolutionFacts{
+		Instructions:      3,
+		Swipes:            0,
+		Combines:          3,
+		ProductCombines:   1,
+		SumCombines:       2,
+		FirstCombineIndex: 0,
+		ScoreGained:       solution.Score(),
+	}, facts)
+	testza.AssertTrue(t, facts.ScoreGained > 0)
+}
+
+func TestSolutionRanker(t *testing.T) {
+	tests := []struct {
+		name       string
+		preference HintPreference
+		// The first facts should be ranked higher than the second
+		better, worse SolutionFacts
+	}{
+		{
+			"Short prefers fewer instructions",
+			HintPreferenceShort,
+			SolutionFacts{Instructions: 2, Combines: 2, SumCombines: 2},
+			SolutionFacts{Instructions: 3, Combines: 3, ProductCombines: 3},
+		},
+		{
+			"Short prefers products when the length is equal",
+			HintPreferenceShort,
+			SolutionFacts{Instructions: 2, Combines: 2, ProductCombines: 1, SumCombines: 1},
+			SolutionFacts{Instructions: 2, Combines: 2, SumCombines: 2},
+		},
+		{
+			"HighestScore prefers the highest score, even if it is longer",
+			HintPreferenceHighestScore,
+			SolutionFacts{Instructions: 8, ScoreGained: 101},
+			SolutionFacts{Instructions: 2, ScoreGained: 100},
+		},
+		{
+			"MinimumSwipes prefers fewer swipes",
+			HintPreferenceMinimumSwipes,
+			SolutionFacts{Instructions: 5, Swipes: 1, Combines: 4},
+			SolutionFacts{Instructions: 3, Swipes: 2, Combines: 1},
+		},
+		{
+			"MinimumSwipesToCombineRatio compares the ratio of each solution",
+			HintPreferenceMinimumSwipesToCombineRatio,
+			SolutionFacts{Instructions: 6, Swipes: 2, Combines: 4},
+			SolutionFacts{Instructions: 2, Swipes: 1, Combines: 1},
+		},
+		{
+			"FirstCombine prefers an early combine",
+			HintPreferenceFirstCombine,
+			SolutionFacts{Instructions: 4, Swipes: 2, Combines: 2, FirstCombineIndex: 0},
+			SolutionFacts{Instructions: 2, Swipes: 1, Combines: 1, FirstCombineIndex: 1},
+		},
+		{
+			"FirstCombine prefers shorter solutions when the first combine is at the same index",
+			HintPreferenceFirstCombine,
+			SolutionFacts{Instructions: 2, Combines: 2, FirstCombineIndex: 0},
+			SolutionFacts{Instructions: 3, Combines: 3, FirstCombineIndex: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := SolutionRankerForPreference(tt.preference)
+			testza.AssertEqual(t, tt.preference.String(), r.Name)
+			better, worse := r.Score(tt.better), r.Score(tt.worse)
+			if better <= worse {
+				t.Errorf("Expected %#v (%f) to be ranked higher than %#v (%f)", tt.better, better, tt.worse, worse)
+			}
+		})
+	}
+	t.Run("Rank should sort the best solution first", func(t *testing.T) {
+		g := createGame(
+			4, 10, 12,
+			2, 10, 8,
+			1, 7, 0,
+		)
+		short := playForTest(t, g,
+			NewPathInstruction_([]int{7, 6, 3, 4}),
+		)
+		long := playForTest(t, g,
+			NewPathInstruction_([]int{7, 6, 3, 4}),
+			NewPathInstruction_([]int{2, 5, 4}),
+			NewPathInstruction_([]int{0, 1, 4}),
+		)
+
+		solutions := []Game{short, long}
+		err := SolutionRankerForPreference(HintPreferenceHighestScore).Rank(g, solutions)
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, 3, solutions[0].History.Length())
+
+		err = SolutionRankerForPreference(HintPreferenceShort).Rank(g, solutions)
+		testza.AssertNoError(t, err)
+		testza.AssertEqual(t, 1, solutions[0].History.Length())
+	})
+}
@@ -0,0 +1,147 @@
+package tallylogic
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+var (
+	ErrReplayInvalidInstruction = errors.New("Instruction could not be applied during replay")
+	ErrReplayMismatch           = errors.New("Replayed game does not match the stored game")
+)
+
+// The state of the game after an instruction was applied during a replay
+type ReplayStep struct {
+	// Index of the instruction within the history
+	Index       int
+	Instruction Instruction_
+	Cells       []cell.Cell
+	Score       int64
+	Moves       int
+}
+
+type Replay struct {
+	// The state of the game before any instructions were applied
+	Start ReplayStep
+	// The state of the game after each instruction
+	Steps []ReplayStep
+	// The game after all instructions are applied
+	Game Game
+}
+
+// Replays a game from its starting state.
+//
+// The starting board, seed and state are read from atStart, typically the
+// game as it was stored at the start (data_at_start). Each instruction in the
+// history is then applied in order, which is deterministic, since the
+// cell-generator is restored with the same seed and state.
+//
+// ErrReplayInvalidInstruction is returned if an instruction cannot be applied,
+// which means the history does not belong to the game, or is corrupted.
+func ReplayGame(atStart types.Game, history []byte) (Replay, error) {
+	atStart.Score = 0
+	atStart.Moves = 0
+	atStart.History = nil
+	// The board uses the cells directly, and must not change the callers cells
+	atStart.Cells = append([]cell.Cell(nil), atStart.Cells...)
+	game, err := RestoreGame(&atStart)
+	if err != nil {
+		return Replay{}, fmt.Errorf("failed to restore game for replay: %w", err)
+	}
+	// Required for undo
+	game.ReplaceBasedOn(game)
+	stored := NewCompactHistoryFromBinary(game.Rules.SizeX, game.Rules.SizeY, history).WithTopology(game.Rules.Options.Topology)
+	instructions, err := stored.All()
+	if err != nil {
+		return Replay{}, fmt.Errorf("failed to read history for replay: %w", err)
+	}
+	replay := Replay{
+		Start: newReplayStep(game, -1, Instruction_{}),
+		Steps: make([]ReplayStep, 0, len(instructions)),
+	}
+	for i, ins := range instructions {
+		if err := game.replayInstruction(ins); err != nil {
+			return replay, fmt.Errorf("%w: instruction %d (%s): %v", ErrReplayInvalidInstruction, i, ins, err)
+		}
+		replay.Steps = append(replay.Steps, newReplayStep(game, i, ins))
+	}
+	replay.Game = game
+	return replay, nil
+}
+
+func newReplayStep(g Game, index int, ins Instruction_) ReplayStep {
+	cells := g.Cells()
+	c := make([]cell.Cell, len(cells))
+	copy(c, cells)
+	return ReplayStep{
+		Index:       index,
+		Instruction: ins,
+		Cells:       c,
+		Score:       g.score,
+		Moves:       g.moves,
+	}
+}
+
+// Applies the instruction like the player originally did.
+// In contrast to Instruct, this reports an error if the instruction had no effect.
+func (g *Game) replayInstruction(ins Instruction_) error {
+	switch {
+	case ins.IsSwipe:
+		if !g.Swipe(ins.Direction) {
+			return fmt.Errorf("the swipe did not change the board")
+		}
+	case ins.IsPath:
+		if !g.EvaluateForPath(ins.Path) {
+			return fmt.Errorf("the path could not be combined")
+		}
+	case ins.IsHelperUndo():
+		return g.Undo()
+	case ins.IsHelperRedo():
+		return g.Redo()
+	case ins.IsHelperSwap():
+		return g.SwapCells(ins.Path[0], ins.Path[1])
+	case ins.IsHelperPower():
+		return g.UsePower(ins.Power, instructionIndex(ins))
+	case ins.IsHelperHint():
+		// Hints do not change the board
+		if !g.Rules.WithSuperPowers {
+			return fmt.Errorf("hints are not allowed by the rules")
+		}
+		g.History.AddHint()
+	default:
+		return fmt.Errorf("replay is not supported for this instruction")
+	}
+	return nil
+}
+
+// Verifies that the replayed game ends up in the same state as the stored game.
+// ErrReplayMismatch is returned with the first difference found.
+func (r Replay) Verify(stored types.Game) error {
+	g := r.Game
+	if g.board == nil {
+		return fmt.Errorf("%w: the replay has no game", ErrReplayMismatch)
+	}
+	cells := g.Cells()
+	if len(cells) != len(stored.Cells) {
+		return fmt.Errorf("%w: got %d cells, stored %d", ErrReplayMismatch, len(cells), len(stored.Cells))
+	}
+	for i := range cells {
+		if cells[i].Value() != stored.Cells[i].Value() {
+			return fmt.Errorf("%w: cell %d is %d, stored %d", ErrReplayMismatch, i, cells[i].Value(), stored.Cells[i].Value())
+		}
+	}
+	if g.score != int64(stored.Score) {
+		return fmt.Errorf("%w: score is %d, stored %d", ErrReplayMismatch, g.score, stored.Score)
+	}
+	if g.moves != int(stored.Moves) {
+		return fmt.Errorf("%w: moves is %d, stored %d", ErrReplayMismatch, g.moves, stored.Moves)
+	}
+	seed, state := g.Seed()
+	if seed != stored.Seed || state != stored.State {
+		return fmt.Errorf("%w: the randomizer has seed %d and state %d, stored %d and %d", ErrReplayMismatch, seed, state, stored.Seed, stored.State)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package tallylogic
+
+import (
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func TestReplayGame(t *testing.T) {
+	atStart := types.Game{
+		Seed:  123,
+		State: 456,
+		Rules: types.Rules{
+			Mode:            types.RuleModeInfiniteNormal,
+			Rows:            3,
+			Columns:         3,
+			RecreateOnSwipe: true,
+		},
+		Cells: cellCreator(
+			2, 2, 4,
+			1, 0, 3,
+			0, 0, 0,
+		),
+	}
+	// Plays the game, like a player would
+	playing := atStart
+	playing.Cells = append([]cell.Cell(nil), atStart.Cells...)
+	game, err := RestoreGame(&playing)
+	testza.AssertNoError(t, err)
+	game.ReplaceBasedOn(game)
+	testza.AssertTrue(t, game.EvaluateForPath([]int{0, 1, 2}))
+	testza.AssertTrue(t, game.Swipe(SwipeDirectionDown))
+	testza.AssertTrue(t, game.Swipe(SwipeDirectionUp))
+	testza.AssertNoError(t, game.Undo())
+	testza.AssertTrue(t, game.Swipe(SwipeDirectionRight))
+	seed, state := game.Seed()
+	stored := types.Game{
+		Seed:    seed,
+		State:   state,
+		Score:   uint64(game.Score()),
+		Moves:   uint(game.Moves()),
+		Cells:   game.Cells(),
+		History: game.History.BytesCopy(),
+	}
+
+	t.Run("Should replay to the stored game", func(t *testing.T) {
+		replay, err := ReplayGame(atStart, stored.History)
+		testza.AssertNoError(t, err)
+		testza.AssertLen(t, replay.Steps, game.History.Length())
+		testza.AssertEqual(t, 0, replay.Start.Moves)
+		testza.AssertEqual(t, int64(8), replay.Steps[0].Cells[2].Value())
+		testza.AssertEqual(t, game.Print(), replay.Game.Print())
+		testza.AssertNoError(t, replay.Verify(stored))
+	})
+	t.Run("Should detect a tampered score", func(t *testing.T) {
+		tampered := stored
+		tampered.Score += 100
+		replay, err := ReplayGame(atStart, stored.History)
+		testza.AssertNoError(t, err)
+		testza.AssertErrorIs(t, replay.Verify(tampered), ErrReplayMismatch)
+	})
+	t.Run("Should detect tampered cells", func(t *testing.T) {
+		tampered := stored
+		tampered.Cells = cellCreator(
+			0, 0, 0,
+			0, 0, 0,
+			0, 0, 64,
+		)
+		replay, err := ReplayGame(atStart, stored.History)
+		testza.AssertNoError(t, err)
+		testza.AssertErrorIs(t, replay.Verify(tampered), ErrReplayMismatch)
+	})
+	t.Run("Should fail on instructions that cannot be applied", func(t *testing.T) {
+		history := NewCompactHistory(3, 3)
+		// The first and last cell are not neighbours
+		history.AddPath([]int{0, 1, 4, 7, 8})
+		_, err := ReplayGame(atStart, history.Bytes())
+		testza.AssertErrorIs(t, err, ErrReplayInvalidInstruction)
+	})
+}
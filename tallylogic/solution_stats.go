@@ -71,6 +71,12 @@ func calculateStat(original Game, solution Game) (SolutionStat, error) {
 					s.InstructionTags[i] = InstructionTag{IsAddition: true, Ok: true}
 				case EvalMethodProduct:
 					s.InstructionTags[i] = InstructionTag{IsMultiplication: true, Ok: true}
+				case EvalMethodSubtraction:
+					s.InstructionTags[i] = InstructionTag{IsSubtraction: true, Ok: true}
+				case EvalMethodDivision:
+					s.InstructionTags[i] = InstructionTag{IsDivision: true, Ok: true}
+				case EvalMethodMixed:
+					s.InstructionTags[i] = InstructionTag{IsMixed: true, Ok: true}
 				}
 				if !gameCopy.EvaluateForPath(path) {
 					return fmt.Errorf("failed to instuct game to combine")
@@ -113,6 +119,9 @@ type InstructionTag struct {
 	Ok               bool
 	IsMultiplication bool
 	IsAddition       bool
+	IsSubtraction    bool
+	IsDivision       bool
+	IsMixed          bool
 	IsSwipe          bool
 	TwoPow           int64
 }
@@ -1,14 +1,27 @@
 package tallylogic
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("tallylogic")
+
 type Hinter interface {
 	GetHints() map[string]Hint
 }
 type Solver interface {
-	SolveGame(g Game, quitCh chan struct{}) ([]Game, error)
+	// Solves the game, until the search is complete, a limit is reached, or
+	// the context is done. If the solver stops early, the solutions found so
+	// far are returned along with a TruncatedErr.
+	SolveGame(ctx context.Context, g Game) ([]Game, error)
 }
 
 type SolveOptions struct {
@@ -19,11 +32,44 @@ type SolveOptions struct {
 	MaxSolutions                 int
 	InfiniteGameMaxScoreIncrease int
 	MaxTime                      time.Duration
+	// Optional. If set, the solver will record statistics here.
+	Stats *SolverStats
+}
+
+// Statistics gathered by solvers. It is safe for concurrent use, so the same
+// stats can be shared between solvers.
+type SolverStats struct {
+	visits int64
+}
+
+func (s *SolverStats) addVisit() {
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.visits, 1)
+}
+
+// Returns the number of unique boards visited by the solvers.
+func (s *SolverStats) Visits() int {
+	if s == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(&s.visits))
 }
 
 type GameSolverFactoryOptions struct {
 	SolveOptions
 	BreadthFirst bool
+	// Use the heuristic best-first solver. Takes precedence over BreadthFirst
+	BestFirst bool
+	// Only used with BestFirst. If set, only this many boards are kept in the
+	// queue, which is faster, but may miss solutions.
+	BeamWidth int
+	// Use the parallel solver, which spreads the boards across a pool of
+	// workers. BestFirst takes precedence over Parallel
+	Parallel bool
+	// Only used with Parallel. Defaults to the number of CPUs
+	Workers int
 }
 
 type SolverErr struct {
@@ -35,7 +81,68 @@ func NewSolverErr(err error, shouldQuit bool) SolverErr {
 	return SolverErr{err, shouldQuit}
 }
 
+func (e SolverErr) Unwrap() error {
+	return e.error
+}
+
+// Returned along with the solutions found so far, when a solver stops before
+// the search is complete, because a limit was reached, or the context was
+// cancelled or its deadline exceeded.
+//
+// Reaching MaxSolutions is not considered truncated.
+type TruncatedErr struct {
+	// Why the solver stopped, e.g. a SolverErr or a context-error
+	Reason error
+	// The number of solutions found before the solver stopped
+	Solutions int
+}
+
+func (e TruncatedErr) Error() string {
+	return fmt.Sprintf("solver stopped early with %d solutions: %v", e.Solutions, e.Reason)
+}
+
+func (e TruncatedErr) Unwrap() error {
+	return e.Reason
+}
+
+// Reports whether the error is a TruncatedErr, meaning any solutions returned
+// with it are valid, but there may be more.
+func IsTruncated(err error) bool {
+	var t TruncatedErr
+	return errors.As(err, &t)
+}
+
+// Returns a TruncatedErr for the reason, or nil if there is no reason
+func truncated(reason error, solutions []Game) error {
+	if reason == nil {
+		return nil
+	}
+	return TruncatedErr{Reason: reason, Solutions: len(solutions)}
+}
+
+// Ends the span around a solve, with the number of boards visited, and whether
+// the solver stopped early.
+func endSolveSpan(span trace.Span, visits int, solutions []Game, err error) {
+	span.SetAttributes(
+		attribute.Int("solver.visits", visits),
+		attribute.Int("solver.solutions", len(solutions)),
+		attribute.Bool("solver.truncated", IsTruncated(err)),
+	)
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
 func GameSolverFactory(options GameSolverFactoryOptions) Solver {
+	if options.BestFirst {
+		s := NewBestFirstSolver(options.SolveOptions, options.BeamWidth)
+		return &s
+	}
+	if options.Parallel {
+		s := NewParallelSolver(options.SolveOptions, options.Workers)
+		return &s
+	}
 	if options.BreadthFirst {
 		s := NewBruteBreadthSolver(options.SolveOptions)
 		return &s
@@ -43,14 +150,14 @@ func GameSolverFactory(options GameSolverFactoryOptions) Solver {
 	s := NewBruteDepthSolver(options.SolveOptions)
 	return &s
 }
-func SolveGame(options SolveOptions, game Game, quitCh chan struct{}) ([]Game, error) {
+func SolveGame(ctx context.Context, options SolveOptions, game Game) ([]Game, error) {
 	// The breadth-first is not very good at solving infinite games
 	// so we use the depth-first for these games
 	if game.Rules.GameMode == GameModeRandom {
 		s := NewBruteDepthSolver(options)
-		return s.SolveGame(game, quitCh)
+		return s.SolveGame(ctx, game)
 	}
 
 	s := NewBruteBreadthSolver(options)
-	return s.SolveGame(game, quitCh)
+	return s.SolveGame(ctx, game)
 }
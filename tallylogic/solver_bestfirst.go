@@ -0,0 +1,282 @@
+package tallylogic
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// A best-first (A*) solver, which expands the boards that are estimated to be
+// closest to a solution first.
+//
+// The estimate is the number of instructions used so far, plus a heuristic
+// for the number of combines still required. The heuristic never
+// overestimates, so the first solution found is also one of the shortest.
+//
+// If BeamWidth is set, only the most promising boards are kept in the queue,
+// which turns the solver into a beam-search. This is faster, but may miss
+// solutions.
+type bestFirstSolver struct {
+	SolveOptions
+	BeamWidth int
+}
+
+func NewBestFirstSolver(options SolveOptions, beamWidth int) bestFirstSolver {
+	if options.MaxDepth == 0 {
+		options.MaxDepth = 1_000
+	}
+	if options.MaxVisits == 0 {
+		options.MaxVisits = 10_000
+	}
+	if options.MaxTime == 0 {
+		options.MaxTime = 10 * time.Second
+	}
+	return bestFirstSolver{
+		SolveOptions: options,
+		BeamWidth:    beamWidth,
+	}
+}
+
+type bestFirstNode struct {
+	Game
+	// Number of instructions from the original game
+	cost int
+	// Estimated number of instructions left, see combinesRequired
+	estimate int
+	// Used to keep the ordering stable for nodes with equal priority
+	order int
+}
+
+type bestFirstQueue []*bestFirstNode
+
+func (q bestFirstQueue) Len() int { return len(q) }
+func (q bestFirstQueue) Less(i, j int) bool {
+	fi, fj := q[i].cost+q[i].estimate, q[j].cost+q[j].estimate
+	if fi != fj {
+		return fi < fj
+	}
+	// Prefer the node that has come the furthest
+	if q[i].estimate != q[j].estimate {
+		return q[i].estimate < q[j].estimate
+	}
+	return q[i].order < q[j].order
+}
+func (q bestFirstQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *bestFirstQueue) Push(x any)   { *q = append(*q, x.(*bestFirstNode)) }
+func (q *bestFirstQueue) Pop() any {
+	old := *q
+	n := len(old)
+	node := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return node
+}
+
+// Keeps only the best nodes in the queue
+func (q *bestFirstQueue) trim(width int) {
+	sort.Sort(*q)
+	for i := width; i < len(*q); i++ {
+		(*q)[i] = nil
+	}
+	*q = (*q)[:width]
+	heap.Init(q)
+}
+
+func (b *bestFirstSolver) SolveGame(ctx context.Context, g Game) (solutions []Game, err error) {
+	// The heuristic does not apply to infinite games,
+	// so we use the depth-first for these games, like SolveGame does.
+	if g.Rules.GameMode == GameModeRandom {
+		s := NewBruteDepthSolver(b.SolveOptions)
+		return s.SolveGame(ctx, g)
+	}
+	ctx, span := tracer.Start(ctx, "bestFirstSolver.SolveGame")
+	ctx, cancel := context.WithTimeout(ctx, b.MaxTime)
+	defer cancel()
+
+	target := heuristicTargetCellValue(g)
+	game := g.Copy()
+	game.History = NewCompactHistoryFromGame(game)
+	// Transposition-table, with the lowest cost each board has been reached with
+	seen := map[string]int{game.board.Hash(): 0}
+	defer func() { endSolveSpan(span, len(seen), solutions, err) }()
+	b.Stats.addVisit()
+	queue := &bestFirstQueue{}
+	order := 0
+	push := func(game Game, cost int) {
+		estimate := combinesRequired(game.board, target)
+		if estimate < 0 {
+			// The target can no longer be reached from this board
+			return
+		}
+		if !b.withinMoveLimits(game, cost, estimate) {
+			return
+		}
+		order++
+		heap.Push(queue, &bestFirstNode{game, cost, estimate, order})
+		if b.BeamWidth > 0 && queue.Len() > b.BeamWidth*2 {
+			queue.trim(b.BeamWidth)
+		}
+	}
+	visit := func(game Game, cost int) error {
+		hash := game.board.Hash()
+		if c, ok := seen[hash]; ok && c <= cost {
+			return nil
+		}
+		seen[hash] = cost
+		b.Stats.addVisit()
+		if len(seen) > b.MaxVisits {
+			return NewSolverErr(fmt.Errorf("Game-visits threshold triggered (seen %d) (MaxVisits %d, depth %d)", len(seen), b.MaxVisits, cost), false)
+		}
+		push(game, cost)
+		return nil
+	}
+	push(game, 0)
+
+	solutions = []Game{}
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return solutions, truncated(err, solutions)
+		}
+		node := heap.Pop(queue).(*bestFirstNode)
+		if node.IsGameWon() {
+			if node.cost >= b.MinMoves {
+				solutions = append(solutions, node.Game)
+				if b.MaxSolutions > 0 && len(solutions) >= b.MaxSolutions {
+					return solutions, nil
+				}
+			}
+			// There is no point in continuing from a won game
+			continue
+		}
+		if node.cost >= b.MaxDepth || node.hasReachedMaxMoves() {
+			continue
+		}
+		for _, h := range sortedHints(node.GetHint()) {
+			gameCopy := node.Copy()
+			if !gameCopy.EvaluateForPath(h.Path) {
+				return solutions, truncated(NewSolverErr(fmt.Errorf("Failed in game-solving for hint"), true), solutions)
+			}
+			if err := visit(gameCopy, node.cost+1); err != nil {
+				return solutions, truncated(err, solutions)
+			}
+		}
+		for _, dir := range []SwipeDirection{SwipeDirectionUp, SwipeDirectionRight, SwipeDirectionDown, SwipeDirectionLeft} {
+			gameCopy := node.Copy()
+			if !gameCopy.Swipe(dir) {
+				continue
+			}
+			if err := visit(gameCopy, node.cost+1); err != nil {
+				return solutions, truncated(err, solutions)
+			}
+		}
+		for _, swap := range node.swapCandidates() {
+			gameCopy := node.Copy()
+			if err := gameCopy.SwapCells(swap[0], swap[1]); err != nil {
+				return solutions, truncated(NewSolverErr(fmt.Errorf("Failed in game-solving for swap: %w", err), true), solutions)
+			}
+			if err := visit(gameCopy, node.cost+1); err != nil {
+				return solutions, truncated(err, solutions)
+			}
+		}
+	}
+	return solutions, nil
+}
+
+// Reports whether a solution may still be found within the limits, where
+// the estimate is the lowest number of instructions left.
+func (b *bestFirstSolver) withinMoveLimits(g Game, cost, estimate int) bool {
+	if b.MaxMoves > 0 && cost+estimate > b.MaxMoves {
+		return false
+	}
+	if g.Rules.MaxMoves > 0 && g.movesTowardsLimit()+estimate > int(g.Rules.MaxMoves) {
+		return false
+	}
+	return true
+}
+
+// Hints are returned as a map, which has a random order. For the solver to
+// be deterministic, they are sorted.
+func sortedHints(hints map[string]Hint) []Hint {
+	keys := make([]string, 0, len(hints))
+	for k := range hints {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	list := make([]Hint, len(keys))
+	for i, k := range keys {
+		list[i] = hints[k]
+	}
+	return list
+}
+
+// Returns the target-cell-value the heuristic can use for the game,
+// or 0 if the heuristic cannot be used.
+//
+// The heuristic relies on cells only changing by being doubled, and being
+// removed, which is not true if new cells are created.
+func heuristicTargetCellValue(g Game) uint64 {
+	if g.Rules.RecreateOnSwipe {
+		return 0
+	}
+	switch gc := g.GoalChecker.(type) {
+	case GoalCheckLargestCell:
+		return gc.TargetCellValue
+	case GoalCheckAll:
+		for _, c := range gc.Checkers {
+			if lc, ok := c.(GoalCheckLargestCell); ok {
+				return lc.TargetCellValue
+			}
+		}
+	}
+	return 0
+}
+
+// Returns a lower bound for the number of combines needed for any cell to
+// reach the target, or -1 if the target cannot be reached.
+//
+// A combine doubles a single cell, and removes the other cells in the path.
+// For a cell to reach the target, it must be doubled at least k times, and
+// since every combine removes at least one other cell, there must be at least
+// k other cells on the board.
+func combinesRequired(board BoardController, target uint64) int {
+	if target == 0 {
+		return 0
+	}
+	cells := board.Cells()
+	count := 0
+	for _, c := range cells {
+		if !c.HasValue() {
+			continue
+		}
+		if uint64(c.Value()) >= target {
+			return 0
+		}
+		count++
+	}
+	best := -1
+	for _, c := range cells {
+		if !c.HasValue() {
+			continue
+		}
+		k := doublingsRequired(uint64(c.Value()), target)
+		if k > count-1 {
+			continue
+		}
+		if best < 0 || k < best {
+			best = k
+		}
+	}
+	return best
+}
+
+// Returns the number of times the value must be doubled to reach the target
+func doublingsRequired(value, target uint64) int {
+	k := 0
+	for value < target {
+		value *= 2
+		k++
+	}
+	return k
+}
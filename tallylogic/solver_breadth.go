@@ -72,9 +72,10 @@ func (j *jobs) has(depth int) bool {
 	return true
 }
 
-func (b *bruteBreadthSolver) SolveGame(g Game, quitCh chan struct{}) ([]Game, error) {
-
+func (b *bruteBreadthSolver) SolveGame(ctx context.Context, g Game) (solutions []Game, err error) {
+	ctx, span := tracer.Start(ctx, "bruteBreadthSolver.SolveGame")
 	seen := map[string]struct{}{}
+	defer func() { endSolveSpan(span, len(seen), solutions, err) }()
 	depthJobs := jobs{make(map[int][]Game), sync.RWMutex{}}
 	jobsCh := make(chan gameJob)
 	errCh := make(chan error)
@@ -83,21 +84,27 @@ func (b *bruteBreadthSolver) SolveGame(g Game, quitCh chan struct{}) ([]Game, er
 	seen[game.Hash()] = struct{}{}
 	game.History = NewCompactHistoryFromGame(game)
 	solutionsChan := make(chan Game)
-	ctx, cancel := context.WithTimeout(context.Background(), b.MaxTime)
+	ctx, cancel := context.WithTimeout(ctx, b.MaxTime)
 	defer cancel()
-	solutions := []Game{}
-	var err error
+	solutions = []Game{}
+	// The reason the solver stopped early, if any
+	var reason error
 	var iterations = 1
+	// Closed once there are no more boards to expand
+	completeCh := make(chan struct{})
 	go func() {
 		currentDepth := -1
 		b.solveGame(ctx, game, jobsCh, solutionsChan, errCh, currentDepth, &g)
 		currentDepth++
 		for {
 			if !depthJobs.has(currentDepth) {
-				cancel()
+				close(completeCh)
 				return
 			}
 			for {
+				if ctx.Err() != nil {
+					return
+				}
 				l := depthJobs.get(currentDepth)
 				if l == nil {
 					break
@@ -112,15 +119,13 @@ func (b *bruteBreadthSolver) SolveGame(g Game, quitCh chan struct{}) ([]Game, er
 	}()
 	for {
 		select {
-		case <-quitCh:
-			cancel()
 		case error := <-errCh:
 			if error == nil {
 				continue
 			}
 			if s, ok := error.(SolverErr); ok {
 				if s.ShouldQuit {
-					err = error
+					reason = error
 					cancel()
 				}
 			}
@@ -131,22 +136,23 @@ func (b *bruteBreadthSolver) SolveGame(g Game, quitCh chan struct{}) ([]Game, er
 			}
 
 			seen[job.hash] = struct{}{}
+			b.Stats.addVisit()
 			if job.depth > b.MaxDepth {
 				fmt.Println(job.Game.Print())
-				err = NewSolverErr(fmt.Errorf("Game-seen threshold triggered (seen %d) (depth %d)", len(seen), job.depth), false)
+				reason = NewSolverErr(fmt.Errorf("Game-seen threshold triggered (seen %d) (depth %d)", len(seen), job.depth), false)
 				cancel()
 				continue
 			}
 			// TODO: is there really a difference between this and the depth?
 			if b.MaxMoves > 0 && b.MaxMoves < (g.Moves()-g.moves) {
 				fmt.Println(job.Game.Print())
-				err = NewSolverErr(fmt.Errorf("Max-moves threshold triggered: %d, maxmoves %d, depth %d", g.Moves(), b.MaxMoves, job.depth), true)
+				reason = NewSolverErr(fmt.Errorf("Max-moves threshold triggered: %d, maxmoves %d, depth %d", g.Moves(), b.MaxMoves, job.depth), true)
 				cancel()
 				continue
 			}
 			if len(seen) > b.MaxVisits {
 				fmt.Println("Original game", g.Print())
-				err = NewSolverErr(fmt.Errorf("Game-visits threshold triggered (seen %d) (MaxVisits %d, depth %d)", len(seen), b.MaxVisits, job.depth), false)
+				reason = NewSolverErr(fmt.Errorf("Game-visits threshold triggered (seen %d) (MaxVisits %d, depth %d)", len(seen), b.MaxVisits, job.depth), false)
 				cancel()
 				continue
 			}
@@ -159,30 +165,24 @@ func (b *bruteBreadthSolver) SolveGame(g Game, quitCh chan struct{}) ([]Game, er
 			depthJobs.Unlock()
 		case solvedGame := <-solutionsChan:
 			if b.MaxSolutions > 0 && len(solutions) >= b.MaxSolutions {
-				cancel()
 				continue
 			}
 			solutions = append(solutions, solvedGame)
 			if solvedGame.Rules.GameMode == GameModeRandom && len(solutions) > 0 {
 				if solvedGame.score-g.score > int64(b.InfiniteGameMaxScoreIncrease) {
-					if err != nil && errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
-						err = nil
-					}
-					return solutions, err
+					return solutions, nil
 				}
 			}
 			if b.MaxSolutions > 0 && len(solutions) >= b.MaxSolutions {
-				cancel()
+				return solutions, nil
 			}
+		case <-completeCh:
+			return solutions, nil
 		case <-ctx.Done():
-			contextErr := ctx.Err()
-			if err != nil {
-				return solutions, err
-			}
-			if contextErr != nil && len(solutions) > 0 && errors.Is(contextErr, context.DeadlineExceeded) || errors.Is(contextErr, context.Canceled) {
-				contextErr = nil
+			if reason == nil {
+				reason = ctx.Err()
 			}
-			return solutions, contextErr
+			return solutions, truncated(reason, solutions)
 		}
 	}
 
@@ -204,7 +204,12 @@ func (b *bruteBreadthSolver) solveGame(
 		if errors.Is(err, context.DeadlineExceeded) {
 			return
 		}
-		send[error]("deadline", errCh, NewSolverErr(fmt.Errorf("context: err %w", err), true))
+		sendCtx[error](ctx, errCh, NewSolverErr(fmt.Errorf("context: err %w", err), true))
+		return
+	}
+	// The game is lost according to its own rules, so there is no point in
+	// going any deeper.
+	if g.hasReachedMaxMoves() {
 		return
 	}
 
@@ -213,18 +218,18 @@ func (b *bruteBreadthSolver) solveGame(
 		gameCopy := g.Copy()
 		ok := gameCopy.EvaluateForPath(h.Path)
 		if !ok {
-			errCh <- NewSolverErr(fmt.Errorf("Failed in game-solving for hint"), true)
+			sendCtx[error](ctx, errCh, NewSolverErr(fmt.Errorf("Failed in game-solving for hint"), true))
 			return
 		}
 		if gameCopy.IsGameWon() {
-			send("solution-gamewon", solutions, gameCopy)
+			sendCtx(ctx, solutions, gameCopy)
 		}
 		if gameCopy.Rules.GameMode == GameModeRandom {
-			send("solution-random", solutions, gameCopy)
+			sendCtx(ctx, solutions, gameCopy)
 		}
 		hash := gameCopy.board.Hash()
 		// hash := gameCopy.Print()
-		send("hint-job", jobsCh, gameJob{gameCopy, hash, depth, "hint"})
+		sendCtx(ctx, jobsCh, gameJob{gameCopy, hash, depth, "hint"})
 	}
 	for _, dir := range []SwipeDirection{SwipeDirectionUp, SwipeDirectionRight, SwipeDirectionDown, SwipeDirectionLeft} {
 		if !originalGame.Rules.NoReswipe && g.History.Length() > 0 {
@@ -261,7 +266,16 @@ func (b *bruteBreadthSolver) solveGame(
 		}
 		// hash := gameCopy.Print()
 		hash := gameCopy.board.Hash()
-		send("swipe-job", jobsCh, gameJob{gameCopy, hash, depth, "swipe"})
+		sendCtx(ctx, jobsCh, gameJob{gameCopy, hash, depth, "swipe"})
+	}
+	for _, swap := range g.swapCandidates() {
+		gameCopy := g.Copy()
+		if err := gameCopy.SwapCells(swap[0], swap[1]); err != nil {
+			sendCtx[error](ctx, errCh, NewSolverErr(fmt.Errorf("Failed in game-solving for swap: %w", err), true))
+			return
+		}
+		hash := gameCopy.board.Hash()
+		sendCtx(ctx, jobsCh, gameJob{gameCopy, hash, depth, "swap"})
 	}
 
 }
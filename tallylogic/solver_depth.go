@@ -29,48 +29,52 @@ func NewBruteDepthSolver(options SolveOptions) bruteDepthSolver {
 	}
 }
 
-func (b *bruteDepthSolver) SolveGame(g Game, quitCh chan struct{}) ([]Game, error) {
-
+func (b *bruteDepthSolver) SolveGame(ctx context.Context, g Game) (solutions []Game, err error) {
+	ctx, span := tracer.Start(ctx, "bruteDepthSolver.SolveGame")
 	seen := map[string]struct{}{}
+	// The search has stopped before returning, so seen is no longer written to
+	defer func() { endSolveSpan(span, len(seen), solutions, err) }()
 	game := g.Copy()
 	game.History = NewCompactHistoryFromGame(game)
 	solutionsChan := make(chan Game)
-	ctx, cancel := context.WithTimeout(context.Background(), b.MaxTime)
+	ctx, cancel := context.WithTimeout(ctx, b.MaxTime)
 	defer cancel()
-	solutions := []Game{}
-	var err error
+	solutions = []Game{}
+	doneCh := make(chan error, 1)
 	go func() {
-		err = b.solveGame(ctx, game, g.moves, solutionsChan, -1, &seen, &g)
-		cancel()
+		doneCh <- b.solveGame(ctx, game, g.moves, solutionsChan, -1, &seen, &g)
 	}()
 	for {
 		select {
-		case <-quitCh:
-			cancel()
-			continue
 		case solvedGame := <-solutionsChan:
 			if b.MaxSolutions > 0 && len(solutions) >= b.MaxSolutions {
-				cancel()
 				continue
 			}
 			solutions = append(solutions, solvedGame)
 			if solvedGame.Rules.GameMode == GameModeRandom && len(solutions) > 0 {
 				if solvedGame.score-g.score > int64(b.InfiniteGameMaxScoreIncrease) {
-					if err != nil && errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
-						err = nil
-					}
-					return solutions, err
+					cancel()
+					<-doneCh
+					return solutions, nil
 				}
 			}
 			if b.MaxSolutions > 0 && len(solutions) >= b.MaxSolutions {
 				cancel()
+				<-doneCh
+				return solutions, nil
+			}
+		case err := <-doneCh:
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return solutions, truncated(ctxErr, solutions)
 			}
-		case <-ctx.Done():
-			err := ctx.Err()
-			if err != nil && len(solutions) > 0 && errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
-				err = nil
+			// Errors that do not quit only affect a single branch
+			if s, ok := err.(SolverErr); ok && s.ShouldQuit {
+				return solutions, truncated(err, solutions)
 			}
-			return solutions, err
+			if len(seen) > b.MaxVisits {
+				return solutions, truncated(NewSolverErr(fmt.Errorf("Game-seen overflow (seen %d)", len(seen)), false), solutions)
+			}
+			return solutions, nil
 		}
 	}
 }
@@ -103,11 +107,18 @@ func (b *bruteDepthSolver) solveGame(
 	if b.MaxMoves > 0 && b.MaxMoves < (g.Moves()-startingMoves) {
 		return NewSolverErr(fmt.Errorf("Max-moves threshold triggered: %d, maxmoves %d", g.Moves(), b.MaxMoves), true)
 	}
+	// The game is lost according to its own rules, so there is no point in
+	// going any deeper. This is checked before the board is marked as seen,
+	// since the same board may be reached in fewer moves through another path.
+	if g.hasReachedMaxMoves() {
+		return NewSolverErr(fmt.Errorf("Game reached the MaxMoves of its rules: %d", g.Rules.MaxMoves), false)
+	}
 	hash := g.board.Hash()
 	if _, ok := (*seen)[hash]; ok {
 		return NewSolverErr(fmt.Errorf("Already seen"), false)
 	}
 	(*seen)[hash] = struct{}{}
+	b.Stats.addVisit()
 	hints := g.GetHint()
 	for _, h := range hints {
 		gameCopy := g.Copy()
@@ -116,13 +127,15 @@ func (b *bruteDepthSolver) solveGame(
 			return NewSolverErr(fmt.Errorf("Failed in game-solving for hint"), true)
 		}
 		if gameCopy.IsGameWon() {
-			if !send("solution", solutions, gameCopy) {
+			if !sendCtx(ctx, solutions, gameCopy) {
 				return nil
 			}
 			continue
 		}
 		if gameCopy.Rules.GameMode == GameModeRandom {
-			solutions <- gameCopy
+			if !sendCtx(ctx, solutions, gameCopy) {
+				return nil
+			}
 		}
 		err := b.solveGame(ctx, gameCopy, startingMoves, solutions, depth, seen, originalGame)
 		if err != nil {
@@ -187,6 +200,16 @@ func (b *bruteDepthSolver) solveGame(
 		}
 
 	}
+	for _, swap := range g.swapCandidates() {
+		gameCopy := g.Copy()
+		if err := gameCopy.SwapCells(swap[0], swap[1]); err != nil {
+			return NewSolverErr(fmt.Errorf("Failed in game-solving for swap: %w", err), true)
+		}
+		err := b.solveGame(ctx, gameCopy, startingMoves, solutions, depth, seen, originalGame)
+		if s, ok := err.(SolverErr); ok && s.ShouldQuit {
+			return err
+		}
+	}
 	return nil
 
 }
@@ -0,0 +1,312 @@
+package tallylogic
+
+import (
+	"context"
+	"fmt"
+	"hash/maphash"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// A solver which spreads the boards across a pool of workers.
+//
+// The boards are expanded in breadth-first order, and every worker shares
+// the same set of visited boards, so a board is only expanded once.
+// Won games are not expanded any further.
+//
+// A board that can be reached in several ways is claimed by whichever worker
+// reaches it first, so the histories of the solutions may vary between runs.
+// The solutions are returned sorted by length.
+type parallelSolver struct {
+	SolveOptions
+	Workers int
+}
+
+// Creates a parallel solver. If workers is zero or below, one worker per CPU is used.
+func NewParallelSolver(options SolveOptions, workers int) parallelSolver {
+	if options.MaxDepth == 0 {
+		options.MaxDepth = 1_000
+	}
+	if options.MaxVisits == 0 {
+		options.MaxVisits = 10_000
+	}
+	if options.MaxTime == 0 {
+		options.MaxTime = 10 * time.Second
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return parallelSolver{
+		SolveOptions: options,
+		Workers:      workers,
+	}
+}
+
+// A set of board-hashes, which is safe for concurrent use.
+// The set is sharded to reduce lock-contention between the workers.
+type visitedSet struct {
+	seed   maphash.Seed
+	shards [64]visitedShard
+	count  int64
+}
+
+type visitedShard struct {
+	sync.Mutex
+	hashes map[string]struct{}
+}
+
+func newVisitedSet() *visitedSet {
+	v := &visitedSet{seed: maphash.MakeSeed()}
+	for i := range v.shards {
+		v.shards[i].hashes = map[string]struct{}{}
+	}
+	return v
+}
+
+// Adds the hash to the set, and reports whether it was not already in the set.
+func (v *visitedSet) add(hash string) bool {
+	shard := &v.shards[maphash.String(v.seed, hash)%uint64(len(v.shards))]
+	shard.Lock()
+	defer shard.Unlock()
+	if _, ok := shard.hashes[hash]; ok {
+		return false
+	}
+	shard.hashes[hash] = struct{}{}
+	atomic.AddInt64(&v.count, 1)
+	return true
+}
+func (v *visitedSet) len() int {
+	return int(atomic.LoadInt64(&v.count))
+}
+
+type parallelJob struct {
+	Game
+	depth int
+}
+
+// A first-in-first-out queue of jobs, shared by the workers.
+// The queue is finished once it is empty, and no jobs are being processed,
+// since processing a job may add new jobs.
+type workQueue struct {
+	sync.Mutex
+	cond *sync.Cond
+	jobs []parallelJob
+	// Number of jobs that are queued or being processed
+	pending int
+	closed  bool
+}
+
+func newWorkQueue() *workQueue {
+	q := &workQueue{}
+	q.cond = sync.NewCond(q)
+	return q
+}
+
+func (q *workQueue) push(job parallelJob) {
+	q.Lock()
+	defer q.Unlock()
+	if q.closed {
+		return
+	}
+	q.jobs = append(q.jobs, job)
+	q.pending++
+	q.cond.Signal()
+}
+
+// Returns the next job, waiting for one if other jobs are still being processed.
+// ok is false once the queue is finished or closed.
+func (q *workQueue) pop() (job parallelJob, ok bool) {
+	q.Lock()
+	defer q.Unlock()
+	for len(q.jobs) == 0 && q.pending > 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed || len(q.jobs) == 0 {
+		return job, false
+	}
+	job = q.jobs[0]
+	q.jobs[0] = parallelJob{}
+	q.jobs = q.jobs[1:]
+	return job, true
+}
+
+// Marks a popped job as processed
+func (q *workQueue) done() {
+	q.Lock()
+	defer q.Unlock()
+	q.pending--
+	if q.pending == 0 {
+		q.cond.Broadcast()
+	}
+}
+
+// Stops the queue, any waiting workers will return
+func (q *workQueue) close() {
+	q.Lock()
+	defer q.Unlock()
+	q.closed = true
+	q.jobs = nil
+	q.cond.Broadcast()
+}
+
+// State shared by the workers for a single call to SolveGame
+type parallelSolve struct {
+	*parallelSolver
+	queue     *workQueue
+	visited   *visitedSet
+	mu        sync.Mutex
+	solutions []Game
+	// Set when MaxSolutions is reached
+	enough bool
+	// The reason the solver stopped early, if any
+	err error
+}
+
+func (s *parallelSolve) stop(err error) {
+	s.mu.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.mu.Unlock()
+	s.queue.close()
+}
+
+func (s *parallelSolve) addSolution(g Game) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.MaxSolutions > 0 && len(s.solutions) >= s.MaxSolutions {
+		return
+	}
+	s.solutions = append(s.solutions, g)
+	if s.MaxSolutions > 0 && len(s.solutions) >= s.MaxSolutions {
+		s.enough = true
+		s.queue.close()
+	}
+}
+
+func (b *parallelSolver) SolveGame(ctx context.Context, g Game) (solutions []Game, err error) {
+	// The breadth-first is not very good at solving infinite games
+	// so we use the depth-first for these games, like SolveGame does.
+	if g.Rules.GameMode == GameModeRandom {
+		s := NewBruteDepthSolver(b.SolveOptions)
+		return s.SolveGame(ctx, g)
+	}
+	ctx, span := tracer.Start(ctx, "parallelSolver.SolveGame")
+	ctx, cancel := context.WithTimeout(ctx, b.MaxTime)
+	defer cancel()
+	s := &parallelSolve{
+		parallelSolver: b,
+		queue:          newWorkQueue(),
+		visited:        newVisitedSet(),
+	}
+	defer func() { endSolveSpan(span, s.visited.len(), solutions, err) }()
+	go func() {
+		<-ctx.Done()
+		s.queue.close()
+	}()
+
+	game := g.Copy()
+	game.History = NewCompactHistoryFromGame(game)
+	s.visited.add(game.board.Hash())
+	b.Stats.addVisit()
+	s.queue.push(parallelJob{game, 0})
+
+	var wg sync.WaitGroup
+	for i := 0; i < b.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				job, ok := s.queue.pop()
+				if !ok {
+					return
+				}
+				s.expand(job)
+				s.queue.done()
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	solutions = s.solutions
+	// The workers find the solutions in a random order
+	sort.SliceStable(solutions, func(i, j int) bool {
+		li, lj := solutions[i].History.Length(), solutions[j].History.Length()
+		if li != lj {
+			return li < lj
+		}
+		return solutions[i].History.Describe() < solutions[j].History.Describe()
+	})
+	if s.enough {
+		return solutions, nil
+	}
+	if s.err == nil {
+		s.err = ctx.Err()
+	}
+	return solutions, truncated(s.err, solutions)
+}
+
+func (s *parallelSolve) expand(job parallelJob) {
+	if job.depth >= s.MaxDepth || job.hasReachedMaxMoves() {
+		return
+	}
+	for _, h := range sortedHints(job.GetHint()) {
+		gameCopy := job.Copy()
+		if !gameCopy.EvaluateForPath(h.Path) {
+			s.stop(NewSolverErr(fmt.Errorf("Failed in game-solving for hint"), true))
+			return
+		}
+		if !s.visit(gameCopy, job.depth+1) {
+			return
+		}
+	}
+	for _, dir := range []SwipeDirection{SwipeDirectionUp, SwipeDirectionRight, SwipeDirectionDown, SwipeDirectionLeft} {
+		gameCopy := job.Copy()
+		if !gameCopy.Swipe(dir) {
+			continue
+		}
+		if !s.visit(gameCopy, job.depth+1) {
+			return
+		}
+	}
+	for _, swap := range job.swapCandidates() {
+		gameCopy := job.Copy()
+		if err := gameCopy.SwapCells(swap[0], swap[1]); err != nil {
+			s.stop(NewSolverErr(fmt.Errorf("Failed in game-solving for swap: %w", err), true))
+			return
+		}
+		if !s.visit(gameCopy, job.depth+1) {
+			return
+		}
+	}
+}
+
+// Records the game, and queues it if it has not been seen before.
+// Returns false if the solver should stop.
+func (s *parallelSolve) visit(g Game, depth int) bool {
+	// Dropped before it is marked as visited, so that the board can still be reached in fewer moves
+	if s.MaxMoves > 0 && depth > s.MaxMoves {
+		return true
+	}
+	if !s.visited.add(g.board.Hash()) {
+		return true
+	}
+	s.Stats.addVisit()
+	if seen := s.visited.len(); seen > s.MaxVisits {
+		s.stop(NewSolverErr(fmt.Errorf("Game-visits threshold triggered (seen %d) (MaxVisits %d, depth %d)", seen, s.MaxVisits, depth), false))
+		return false
+	}
+	if g.IsGameWon() {
+		if depth >= s.MinMoves {
+			s.addSolution(g)
+		}
+		return true
+	}
+	s.queue.push(parallelJob{g, depth})
+	return true
+}
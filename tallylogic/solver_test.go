@@ -1,6 +1,8 @@
 package tallylogic
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"testing"
@@ -8,6 +10,10 @@ import (
 
 	"github.com/MarvinJWendt/testza"
 	"github.com/runar-rkmedia/gotally/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func init() {
@@ -48,14 +54,14 @@ func Test_bruteSolver_SolveGame(t *testing.T) {
 			"Solve a simple game",
 			GameSolverFactoryOptions{BreadthFirst: false},
 			mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[0]),
-			12,
+			8,
 			nil,
 		},
 		{
 			"Solve a simple game",
 			GameSolverFactoryOptions{BreadthFirst: true},
 			mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[0]),
-			12,
+			10,
 			nil,
 		},
 		{
@@ -72,7 +78,7 @@ func Test_bruteSolver_SolveGame(t *testing.T) {
 			// I have not confirmed why there is a difference in the count of soltuions produced between breadth-first and depth-first
 			// but I dont have the time to figure it out.
 			// Could it be that when they decide that they have seen a game before, they are visiting vastly different nodes?
-			211,
+			71,
 			nil,
 		},
 		{
@@ -101,6 +107,80 @@ func Test_bruteSolver_SolveGame(t *testing.T) {
 			},
 		},
 
+		{
+			"Solve a simple game",
+			GameSolverFactoryOptions{BestFirst: true},
+			mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[0]),
+			8,
+			nil,
+		},
+		{
+			"Solve next game",
+			GameSolverFactoryOptions{BestFirst: true},
+			mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[1]),
+			// Won games are not expanded further, so this finds fewer, but shorter solutions
+			34,
+			nil,
+		},
+		{
+			// The best-first solver should find the shortest solution first
+			"Solve challenge game 0130-current-paul-robin",
+			GameSolverFactoryOptions{BestFirst: true, SolveOptions: SolveOptions{MaxSolutions: 1}},
+			func() Game {
+				return createGame(
+					4, 10, 12,
+					2, 10, 8,
+					1, 7, 0,
+				)
+			},
+			1,
+			[]string{
+				"7,6,3,4;2,5,4;0,1,4;",
+				"3,6,7,4;2,5,4;0,1,4;",
+			},
+		},
+		{
+			"Solve challenge game 0130-current-paul-robin",
+			GameSolverFactoryOptions{BestFirst: true, BeamWidth: 50, SolveOptions: SolveOptions{MaxSolutions: 1}},
+			func() Game {
+				return createGame(
+					4, 10, 12,
+					2, 10, 8,
+					1, 7, 0,
+				)
+			},
+			1,
+			nil,
+		},
+		{
+			"Solve a simple game",
+			GameSolverFactoryOptions{Parallel: true},
+			mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[0]),
+			8,
+			nil,
+		},
+		{
+			"Solve next game",
+			GameSolverFactoryOptions{Parallel: true, Workers: 4},
+			mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[1]),
+			// Won games are not expanded further, so this finds fewer, but shorter solutions
+			34,
+			nil,
+		},
+		{
+			"Solve challenge game 0130-current-paul-robin",
+			GameSolverFactoryOptions{Parallel: true, SolveOptions: SolveOptions{MaxSolutions: 1}},
+			func() Game {
+				return createGame(
+					4, 10, 12,
+					2, 10, 8,
+					1, 7, 0,
+				)
+			},
+			1,
+			nil,
+		},
+
 		{
 			// Infinite games cannot be solved, but it should calculate the "best" moves that it can make
 			// to get the best points, or the boards complexity is reduced.
@@ -120,9 +200,16 @@ func Test_bruteSolver_SolveGame(t *testing.T) {
 	for _, tt := range tests {
 		tt.SolveOptions.MaxTime = 10 * time.Second
 		prefix := ""
-		if tt.BreadthFirst {
+		switch {
+		case tt.BestFirst && tt.BeamWidth > 0:
+			prefix = "beam "
+		case tt.BestFirst:
+			prefix = "best-first "
+		case tt.Parallel:
+			prefix = "parallel "
+		case tt.BreadthFirst:
 			prefix = "breadth "
-		} else {
+		default:
 			prefix = "depth "
 		}
 		t.Run(prefix+tt.name, func(t *testing.T) {
@@ -140,7 +227,7 @@ func Test_bruteSolver_SolveGame(t *testing.T) {
 			t.Logf("BruteSolver: %#v", b)
 			start := time.Now()
 			timeTaken := time.Now().Sub(start)
-			solutions, err := b.SolveGame(gg, nil)
+			solutions, err := b.SolveGame(context.Background(), gg)
 			t.Logf("Found %d solutions in %s", len(solutions), timeTaken)
 			if err != nil {
 				t.Error(err)
@@ -186,6 +273,13 @@ func Test_bruteSolver_SolveGame(t *testing.T) {
 
 			// intentionally no prefix for testname here, since we want to compare them
 			t.Log(gg.board.String())
+			if gg.Rules.MaxMoves > 0 {
+				for i, solution := range solutions {
+					if uint64(solution.Moves()) > gg.Rules.MaxMoves {
+						t.Errorf("Solution %d used %d moves, but the rules only allow %d: %s", i, solution.Moves(), gg.Rules.MaxMoves, solution.History.Describe())
+					}
+				}
+			}
 			if gg.Rules.NoReswipe {
 				for _, solution := range solutions {
 					history, err := solution.History.All()
@@ -242,6 +336,251 @@ func Test_bruteSolver_SolveGame(t *testing.T) {
 	}
 }
 
+func Test_bestFirstSolver_ShortestFirst(t *testing.T) {
+	for i := range TutorialGames[:2] {
+		game := mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[i])()
+		brute := GameSolverFactory(GameSolverFactoryOptions{BreadthFirst: true})
+		all, err := brute.SolveGame(context.Background(), game)
+		testza.AssertNoError(t, err)
+		shortest := -1
+		for _, s := range all {
+			if shortest < 0 || s.History.Length() < shortest {
+				shortest = s.History.Length()
+			}
+		}
+		bestFirst := GameSolverFactory(GameSolverFactoryOptions{BestFirst: true, SolveOptions: SolveOptions{MaxSolutions: 1}})
+		solutions, err := bestFirst.SolveGame(context.Background(), game)
+		testza.AssertNoError(t, err)
+		testza.AssertLen(t, solutions, 1)
+		testza.AssertEqual(t, shortest, solutions[0].History.Length(), "Expected the first solution to be one of the shortest, for tutorial %d", i)
+	}
+}
+
+func Test_parallelSolver(t *testing.T) {
+	game := mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[1])()
+	breadth := GameSolverFactory(GameSolverFactoryOptions{BreadthFirst: true})
+	all, err := breadth.SolveGame(context.Background(), game)
+	testza.AssertNoError(t, err)
+	shortest := -1
+	for _, s := range all {
+		if shortest < 0 || s.History.Length() < shortest {
+			shortest = s.History.Length()
+		}
+	}
+	for _, workers := range []int{1, 2, 8} {
+		t.Run(fmt.Sprintf("Should find the shortest solution first with %d workers", workers), func(t *testing.T) {
+			solver := NewParallelSolver(SolveOptions{}, workers)
+			solutions, err := solver.SolveGame(context.Background(), game)
+			testza.AssertNoError(t, err)
+			testza.AssertGreater(t, len(solutions), 0)
+			testza.AssertEqual(t, shortest, solutions[0].History.Length())
+		})
+	}
+	t.Run("Should respect MaxSolutions", func(t *testing.T) {
+		solver := NewParallelSolver(SolveOptions{MaxSolutions: 3}, 8)
+		solutions, err := solver.SolveGame(context.Background(), game)
+		testza.AssertNoError(t, err)
+		testza.AssertLen(t, solutions, 3)
+	})
+	t.Run("Should respect MaxVisits", func(t *testing.T) {
+		stats := &SolverStats{}
+		solver := NewParallelSolver(SolveOptions{MaxVisits: 5, Stats: stats}, 8)
+		solutions, err := solver.SolveGame(context.Background(), game)
+		testza.AssertLen(t, solutions, 0)
+		testza.AssertTrue(t, IsTruncated(err), "Expected a TruncatedErr, got %v", err)
+		var solverErr SolverErr
+		testza.AssertTrue(t, errors.As(err, &solverErr), "Expected a SolverErr, got %v", err)
+		testza.AssertFalse(t, solverErr.ShouldQuit)
+		testza.AssertTrue(t, stats.Visits() <= 6+8*8, "Expected the workers to stop shortly after the threshold, got %d visits", stats.Visits())
+	})
+	t.Run("Should respect MaxMoves", func(t *testing.T) {
+		// The shortest solution uses 3 moves
+		game := createGame(
+			4, 10, 12,
+			2, 10, 8,
+			1, 7, 0,
+		)
+		solver := NewParallelSolver(SolveOptions{MaxMoves: 2}, 8)
+		solutions, err := solver.SolveGame(context.Background(), game)
+		testza.AssertNoError(t, err)
+		testza.AssertLen(t, solutions, 0)
+
+		solver = NewParallelSolver(SolveOptions{MaxMoves: 3}, 8)
+		solutions, err = solver.SolveGame(context.Background(), game)
+		testza.AssertNoError(t, err)
+		testza.AssertGreater(t, len(solutions), 0)
+		for _, s := range solutions {
+			testza.AssertTrue(t, s.Moves() <= 3, "Expected at most 3 moves, got %s", s.History.Describe())
+		}
+	})
+}
+
+func Test_Solvers_Truncated(t *testing.T) {
+	hard := createGame(
+		4, 10, 12,
+		2, 10, 8,
+		1, 7, 0,
+	)
+	// A target that is far away, so the solvers do not complete on their own.
+	// The best-first solver would give up at once if the target was out of reach for its heuristic.
+	hard.GoalChecker = GoalCheckLargestCell{TargetCellValue: 1280}
+	solvers := map[string]GameSolverFactoryOptions{
+		"depth":      {},
+		"breadth":    {BreadthFirst: true},
+		"best-first": {BestFirst: true},
+		"parallel":   {Parallel: true},
+	}
+	for _, name := range []string{"depth", "breadth", "best-first", "parallel"} {
+		options := solvers[name]
+		options.MaxVisits = 1_000_000
+		t.Run(name+" should stop when the context is cancelled", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := GameSolverFactory(options).SolveGame(ctx, hard)
+			testza.AssertTrue(t, IsTruncated(err), "Expected a TruncatedErr, got %v", err)
+			testza.AssertErrorIs(t, err, context.Canceled)
+		})
+		t.Run(name+" should stop at the deadline", func(t *testing.T) {
+			// The deadline has already passed, since the best-first solver may exhaust this board before any deadline worth waiting for
+			ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+			defer cancel()
+			_, err := GameSolverFactory(options).SolveGame(ctx, hard)
+			testza.AssertTrue(t, IsTruncated(err), "Expected a TruncatedErr, got %v", err)
+			testza.AssertErrorIs(t, err, context.DeadlineExceeded)
+		})
+	}
+	t.Run("Should return partial solutions", func(t *testing.T) {
+		game := mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[1])()
+		stats := &SolverStats{}
+		options := GameSolverFactoryOptions{BreadthFirst: true, SolveOptions: SolveOptions{Stats: stats}}
+		all, err := GameSolverFactory(options).SolveGame(context.Background(), game)
+		testza.AssertNoError(t, err)
+		options.Stats = nil
+		options.MaxVisits = stats.Visits() / 2
+		solutions, err := GameSolverFactory(options).SolveGame(context.Background(), game)
+		var truncatedErr TruncatedErr
+		testza.AssertTrue(t, errors.As(err, &truncatedErr), "Expected a TruncatedErr, got %v", err)
+		testza.AssertGreater(t, len(solutions), 0)
+		testza.AssertLess(t, len(solutions), len(all))
+		testza.AssertEqual(t, len(solutions), truncatedErr.Solutions)
+	})
+}
+
+// The global tracer only delegates to the first provider that is set, so the recorder is shared
+var solverSpanRecorder = func() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+}()
+
+func Test_Solvers_Spans(t *testing.T) {
+	recorder := solverSpanRecorder
+	game := createGame(
+		4, 10, 12,
+		2, 10, 8,
+		1, 7, 0,
+	)
+	attributes := func(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+		m := map[attribute.Key]attribute.Value{}
+		for _, a := range span.Attributes() {
+			m[a.Key] = a.Value
+		}
+		return m
+	}
+	solvers := map[string]GameSolverFactoryOptions{
+		"bruteDepthSolver":   {},
+		"bruteBreadthSolver": {BreadthFirst: true},
+		"bestFirstSolver":    {BestFirst: true},
+		"parallelSolver":     {Parallel: true},
+	}
+	for name, options := range solvers {
+		t.Run(name+" should record a span for the solve", func(t *testing.T) {
+			options.MaxSolutions = 1
+			_, err := GameSolverFactory(options).SolveGame(context.Background(), game)
+			testza.AssertNoError(t, err)
+			spans := recorder.Ended()
+			span := spans[len(spans)-1]
+			testza.AssertEqual(t, name+".SolveGame", span.Name())
+			attrs := attributes(span)
+			testza.AssertGreater(t, attrs["solver.visits"].AsInt64(), int64(0))
+			testza.AssertEqual(t, int64(1), attrs["solver.solutions"].AsInt64())
+			testza.AssertFalse(t, attrs["solver.truncated"].AsBool())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err = GameSolverFactory(options).SolveGame(ctx, game)
+			testza.AssertTrue(t, IsTruncated(err))
+			spans = recorder.Ended()
+			testza.AssertTrue(t, attributes(spans[len(spans)-1])["solver.truncated"].AsBool())
+		})
+	}
+}
+
+func Test_combinesRequired(t *testing.T) {
+	tests := []struct {
+		name   string
+		target uint64
+		cells  []int64
+		want   int
+	}{
+		{"No target", 0, []int64{1, 2, 0, 0}, 0},
+		{"Target is already reached", 8, []int64{1, 8, 0, 0}, 0},
+		{"Single doubling", 8, []int64{4, 4, 0, 0}, 1},
+		{"Uses the cell closest to the target", 64, []int64{4, 4, 16, 4}, 2},
+		{"Not enough cells left to combine", 64, []int64{4, 16, 0, 0}, -1},
+		{"Empty board", 8, []int64{0, 0, 0, 0}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := NewTableBoard(2, 2)
+			board.cells = cellCreator(tt.cells...)
+			testza.AssertEqual(t, tt.want, combinesRequired(&board, tt.target))
+		})
+	}
+}
+
+// Compares the solvers on the boards from the solver-tests
+func Benchmark_Solvers(b *testing.B) {
+	games := map[string]func() Game{
+		"tutorial-0": mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[0]),
+		"tutorial-1": mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[1]),
+		"challenge-paul-robin": func() Game {
+			return createGame(
+				4, 10, 12,
+				2, 10, 8,
+				1, 7, 0,
+			)
+		},
+	}
+	solvers := map[string]GameSolverFactoryOptions{
+		"depth":      {},
+		"breadth":    {BreadthFirst: true},
+		"best-first": {BestFirst: true},
+		"beam-50":    {BestFirst: true, BeamWidth: 50},
+		"parallel":   {Parallel: true},
+	}
+	for _, gameName := range []string{"tutorial-0", "tutorial-1", "challenge-paul-robin"} {
+		game := games[gameName]()
+		for _, solverName := range []string{"depth", "breadth", "best-first", "beam-50", "parallel"} {
+			options := solvers[solverName]
+			options.MaxSolutions = 1
+			options.MaxTime = 10 * time.Second
+			b.Run(gameName+"/"+solverName, func(b *testing.B) {
+				solver := GameSolverFactory(options)
+				for i := 0; i < b.N; i++ {
+					s, err := solver.SolveGame(context.Background(), game)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if len(s) == 0 {
+						b.Fatal("Found no solutions")
+					}
+				}
+			})
+		}
+	}
+}
+
 func Benchmark_Solver(b *testing.B) {
 	game := mustCreateNewGameForTest(GameModeTutorial, &TutorialGames[1])()
 	options := GameSolverFactoryOptions{
@@ -252,7 +591,7 @@ func Benchmark_Solver(b *testing.B) {
 	brute := GameSolverFactory(options)
 	b.RunParallel(func(p *testing.PB) {
 		for p.Next() {
-			s, err := brute.SolveGame(game, nil)
+			s, err := brute.SolveGame(context.Background(), game)
 			if err != nil {
 				b.Error(err)
 			}
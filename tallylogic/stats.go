@@ -43,7 +43,7 @@ func (game *Game) StatsQuick() (GameStats, error) {
 	cellValues := []uint64{}
 	combinedFactors := cell.NewFactors(0)
 	for _, c := range cells {
-		if !c.IsEmpty() {
+		if c.HasValue() {
 			cellValues = append(cellValues, uint64(c.Value()))
 		}
 		factors := c.Factors().Factors()
@@ -0,0 +1,157 @@
+package tallylogic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrSwapNotAllowed    = errors.New("Swapping cells is not allowed by the rules")
+	ErrSwapNoneLeft      = errors.New("There are no swaps left")
+	ErrSwapNotNeighbours = errors.New("Only neighbouring cells can be swapped")
+	ErrSwapEmptyCell     = errors.New("Empty cells cannot be swapped")
+	ErrSwapSameValue     = errors.New("The cells have the same value, swapping them would not change the board")
+	ErrNoMovesLeft       = errors.New("The game has used all of its moves")
+)
+
+// Swaps the cells at the two indexes, if the rules allows it.
+//
+// A swap counts as a move, and uses one of the swaps allowed by the rules
+// until it is undone.
+func (g *Game) SwapCells(a, b int) error {
+	if err := g.validateSwap(a, b); err != nil {
+		return err
+	}
+	if err := g.board.SwapCells(a, b); err != nil {
+		return err
+	}
+	g.ClearSelection()
+	g.inceaseMoveCount()
+	g.History.AddSwap(a, b)
+	return nil
+}
+
+// Returns the number of swaps the player can still use.
+func (g Game) SwapsLeft() int {
+	if !g.Rules.WithSuperPowers || g.Rules.MaxSwaps == 0 {
+		return 0
+	}
+	left := int(g.Rules.MaxSwaps) - g.swapsUsed()
+	if left < 0 {
+		return 0
+	}
+	return left
+}
+
+// Returns the number of swaps in the history that are not undone.
+func (g Game) swapsUsed() int {
+	done, _, _ := g.History.UndoStacks()
+	used := 0
+	for _, ins := range done {
+		if ins.IsHelperSwap() {
+			used++
+		}
+	}
+	return used
+}
+
+// Reports why the cells cannot be swapped, or nil if they can.
+func (g Game) validateSwap(a, b int) error {
+	if !g.Rules.WithSuperPowers || g.Rules.MaxSwaps == 0 {
+		return ErrSwapNotAllowed
+	}
+	if g.SwapsLeft() == 0 {
+		return ErrSwapNoneLeft
+	}
+	if g.hasReachedMaxMoves() {
+		return ErrNoMovesLeft
+	}
+	return g.validateSwapCells(a, b)
+}
+
+// Like validateSwap, but only checks the cells, not whether the game has any swaps left.
+func (g Game) validateSwapCells(a, b int) error {
+	if !g.board.ValidCellIndex(a) || !g.board.ValidCellIndex(b) || a == b {
+		return fmt.Errorf("%w: cannot swap %d and %d", ErrIndexInvalid, a, b)
+	}
+	if g.Rules.SwapNeighboursOnly && !g.board.AreNeighboursByIndex(a, b) {
+		return ErrSwapNotNeighbours
+	}
+	ca, cb := g.board.GetCellAtIndex(a), g.board.GetCellAtIndex(b)
+	if ca.IsBlocked() || cb.IsBlocked() {
+		return ErrCellIsBlocked
+	}
+	if ca.IsEmpty() || cb.IsEmpty() {
+		return ErrSwapEmptyCell
+	}
+	if ca.Value() == cb.Value() {
+		return ErrSwapSameValue
+	}
+	return nil
+}
+
+// Returns the swaps that are worth trying, in a consistant order.
+//
+// The number of possible swaps is large, so only the swaps that lets one of
+// the swapped cells be combined right away are returned. This is used by the
+// solvers.
+func (g Game) swapCandidates() [][2]int {
+	return g.findSwapCandidates(0)
+}
+
+// Reports whether any swap is worth trying. This is used to check whether the
+// player can still rescue the game, and stops at the first swap found.
+func (g Game) hasSwapCandidate() bool {
+	return len(g.findSwapCandidates(1)) > 0
+}
+
+// Returns up to max swap-candidates, or all of them if max is 0.
+func (g Game) findSwapCandidates(max int) [][2]int {
+	if g.SwapsLeft() == 0 || g.hasReachedMaxMoves() {
+		return nil
+	}
+	length := len(g.Cells())
+	candidates := [][2]int{}
+	for a := 0; a < length; a++ {
+		for _, b := range g.swapPartners(a) {
+			if g.validateSwapCells(a, b) != nil {
+				continue
+			}
+			board := g.board.Copy()
+			board.SwapCells(a, b)
+			hinter := NewHintCalculator(board, board, board)
+			if hinter.GetHintThrough(a, b) == nil {
+				continue
+			}
+			candidates = append(candidates, [2]int{a, b})
+			if max > 0 && len(candidates) >= max {
+				return candidates
+			}
+		}
+	}
+	return candidates
+}
+
+// Returns the indexes after a that a can be swapped with, in ascending order.
+// If only neighbours can be swapped, the other cells are not considered at all.
+func (g Game) swapPartners(a int) []int {
+	length := len(g.Cells())
+	if !g.Rules.SwapNeighboursOnly {
+		partners := make([]int, 0, length-a-1)
+		for b := a + 1; b < length; b++ {
+			partners = append(partners, b)
+		}
+		return partners
+	}
+	neighbours, _ := g.board.NeighboursForCellIndex(a)
+	partners := make([]int, 0, len(neighbours))
+	for _, b := range neighbours {
+		// Small toroidal boards may list the same neighbour twice
+		if b > a && !contains(partners, b) {
+			partners = append(partners, b)
+		}
+	}
+	sort.Ints(partners)
+	return partners
+}
@@ -0,0 +1,160 @@
+package tallylogic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MarvinJWendt/testza"
+	"github.com/runar-rkmedia/gotally/tallylogic/cell"
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+func swapTestCells() []cell.Cell {
+	return cellCreator(
+		4, 5, 3,
+		7, 3, 5,
+		5, 7, 4,
+	)
+}
+
+func swapTestRules(rules types.Rules) types.Rules {
+	rules.Mode = types.RuleModeChallenge
+	rules.Rows = 3
+	rules.Columns = 3
+	rules.TargetCellValue = 8
+	return rules
+}
+
+func restoreSwapGameForTest(t *testing.T, rules types.Rules) Game {
+	t.Helper()
+	g, err := RestoreGame(&types.Game{
+		Rules: swapTestRules(rules),
+		// There is nothing to combine, and the board is full, so only a swap can help
+		Cells: swapTestCells(),
+	})
+	testza.AssertNoError(t, err)
+	g.ReplaceBasedOn(g)
+	return g
+}
+
+func TestGame_SwapCells(t *testing.T) {
+	t.Run("Should swap cells within the limit of the rules", func(t *testing.T) {
+		g := restoreSwapGameForTest(t, types.Rules{MaxSwaps: 2})
+		testza.AssertEqual(t, 2, g.SwapsLeft())
+		testza.AssertNoError(t, g.SwapCells(0, 1))
+		testza.AssertEqual(t, int64(5), g.Cells()[0].Value())
+		testza.AssertEqual(t, int64(4), g.Cells()[1].Value())
+		testza.AssertEqual(t, 1, g.Moves())
+		testza.AssertEqual(t, 1, g.SwapsLeft())
+		testza.AssertErrorIs(t, g.SwapCells(1, 8), ErrSwapSameValue)
+		testza.AssertErrorIs(t, g.SwapCells(0, 0), ErrIndexInvalid)
+		testza.AssertErrorIs(t, g.SwapCells(0, 9), ErrIndexInvalid)
+		testza.AssertNoError(t, g.SwapCells(1, 2))
+		testza.AssertErrorIs(t, g.SwapCells(3, 4), ErrSwapNoneLeft)
+		testza.AssertEqual(t, "S0,1;S1,2;", g.History.Describe())
+	})
+	t.Run("Should not allow swaps unless the rules allows it", func(t *testing.T) {
+		g := restoreSwapGameForTest(t, types.Rules{})
+		testza.AssertErrorIs(t, g.SwapCells(0, 1), ErrSwapNotAllowed)
+		g = restoreSwapGameForTest(t, types.Rules{MaxSwaps: 2, NoSuperPowers: true})
+		testza.AssertErrorIs(t, g.SwapCells(0, 1), ErrSwapNotAllowed)
+		testza.AssertEqual(t, 0, g.SwapsLeft())
+	})
+	t.Run("Should only swap neighbours if the rules says so", func(t *testing.T) {
+		g := restoreSwapGameForTest(t, types.Rules{MaxSwaps: 2, SwapNeighboursOnly: true})
+		testza.AssertErrorIs(t, g.SwapCells(0, 8), ErrSwapNotNeighbours)
+		testza.AssertNoError(t, g.SwapCells(7, 8))
+	})
+	t.Run("Should not swap empty cells", func(t *testing.T) {
+		g := restoreSwapGameForTest(t, types.Rules{MaxSwaps: 2})
+		g.board.AddCellToBoard(cell.NewCell(0, 0), 4, true)
+		testza.AssertErrorIs(t, g.SwapCells(1, 4), ErrSwapEmptyCell)
+	})
+	t.Run("Undo should give back the swap", func(t *testing.T) {
+		g := restoreSwapGameForTest(t, types.Rules{MaxSwaps: 1})
+		start := g.Print()
+		testza.AssertNoError(t, g.SwapCells(0, 1))
+		testza.AssertEqual(t, 0, g.SwapsLeft())
+		testza.AssertNoError(t, g.Undo())
+		testza.AssertEqual(t, start, g.Print())
+		testza.AssertEqual(t, 1, g.SwapsLeft())
+		testza.AssertNoError(t, g.SwapCells(1, 8))
+		testza.AssertNoError(t, g.Undo())
+		testza.AssertTrue(t, g.Instruct(NewSwapInstruction_(1, 8)))
+		testza.AssertEqual(t, int64(4), g.Cells()[1].Value())
+	})
+	t.Run("Should replay swaps", func(t *testing.T) {
+		g := restoreSwapGameForTest(t, types.Rules{MaxSwaps: 1})
+		seed, state := g.Seed()
+		atStart := types.Game{
+			Rules: swapTestRules(types.Rules{MaxSwaps: 1}),
+			Cells: swapTestCells(),
+			Seed:  seed,
+			State: state,
+		}
+		testza.AssertNoError(t, g.SwapCells(1, 8))
+		testza.AssertTrue(t, g.EvaluateForPath([]int{0, 1}))
+		testza.AssertTrue(t, g.IsGameWon())
+		seed, state = g.Seed()
+		replay, err := ReplayGame(atStart, g.History.BytesCopy())
+		testza.AssertNoError(t, err)
+		testza.AssertTrue(t, replay.Steps[0].Instruction.Equal(NewSwapInstruction_(1, 8)))
+		testza.AssertNoError(t, replay.Verify(types.Game{
+			Seed:  seed,
+			State: state,
+			Score: uint64(g.Score()),
+			Moves: uint(g.Moves()),
+			Cells: g.Cells(),
+		}))
+	})
+	t.Run("Should only list the swaps that can be combined right away", func(t *testing.T) {
+		g := restoreSwapGameForTest(t, types.Rules{MaxSwaps: 1})
+		candidates := g.swapCandidates()
+		testza.AssertGreater(t, len(candidates), 0)
+		testza.AssertTrue(t, g.hasSwapCandidate())
+		neighbours := [][2]int{}
+		for _, swap := range candidates {
+			board := g.board.Copy()
+			board.SwapCells(swap[0], swap[1])
+			hinter := NewHintCalculator(board, board, board)
+			testza.AssertNotNil(t, hinter.GetHintThrough(swap[0], swap[1]), swap)
+			if g.board.AreNeighboursByIndex(swap[0], swap[1]) {
+				neighbours = append(neighbours, swap)
+			}
+		}
+		testza.AssertEqual(t, neighbours, restoreSwapGameForTest(t, types.Rules{MaxSwaps: 1, SwapNeighboursOnly: true}).swapCandidates())
+
+		testza.AssertLen(t, restoreSwapGameForTest(t, types.Rules{}).swapCandidates(), 0)
+		testza.AssertFalse(t, restoreSwapGameForTest(t, types.Rules{}).hasSwapCandidate())
+	})
+	t.Run("The game is not over while a swap can rescue it", func(t *testing.T) {
+		testza.AssertTrue(t, restoreSwapGameForTest(t, types.Rules{}).IsGameOver())
+		testza.AssertFalse(t, restoreSwapGameForTest(t, types.Rules{MaxSwaps: 1}).IsGameOver())
+	})
+}
+
+func Test_Solvers_Swap(t *testing.T) {
+	solvers := map[string]GameSolverFactoryOptions{
+		"breadth":    {BreadthFirst: true},
+		"depth":      {},
+		"best-first": {BestFirst: true},
+		"parallel":   {Parallel: true},
+	}
+	for name, options := range solvers {
+		t.Run(fmt.Sprintf("The %s-solver should use swaps when the rules allows it", name), func(t *testing.T) {
+			options.MaxSolutions = 1
+			solver := GameSolverFactory(options)
+			solutions, err := solver.SolveGame(context.Background(), restoreSwapGameForTest(t, types.Rules{}))
+			testza.AssertNoError(t, err)
+			testza.AssertLen(t, solutions, 0)
+
+			solutions, err = solver.SolveGame(context.Background(), restoreSwapGameForTest(t, types.Rules{MaxSwaps: 1}))
+			testza.AssertNoError(t, err)
+			testza.AssertLen(t, solutions, 1)
+			first := solutions[0].History.At(0)
+			testza.AssertTrue(t, first.IsHelperSwap(), solutions[0].History.Describe())
+			testza.AssertTrue(t, solutions[0].IsGameWon())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package tallylogic
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/runar-rkmedia/gotally/types"
+)
+
+// The topology decides which cells are neighbours, and can therefore be
+// combined in a path.
+type Topology byte
+
+const (
+	// Cells are neighbours with the cells above, below and to the sides of them
+	TopologyOrthogonal Topology = iota
+	// Like TopologyOrthogonal, and also with the diagonal cells
+	TopologyEightWay
+	// Like TopologyOrthogonal, but the edges wrap around, so that the cells on
+	// one edge are neighbours with the cells on the opposite edge.
+	// Swipes do not wrap around.
+	TopologyToroidal
+)
+
+func (t Topology) String() string {
+	switch t {
+	case TopologyOrthogonal:
+		return "Orthogonal"
+	case TopologyEightWay:
+		return "EightWay"
+	case TopologyToroidal:
+		return "Toroidal"
+	}
+	return fmt.Sprintf("Topology(%d)", t)
+}
+
+// Returns the topology as it is stored in the rules.
+func (t Topology) RuleTopology() types.Topology {
+	switch t {
+	case TopologyEightWay:
+		return types.TopologyEightWay
+	case TopologyToroidal:
+		return types.TopologyToroidal
+	}
+	return types.TopologyOrthogonal
+}
+
+// Maps the topology stored in the rules. An empty topology is orthogonal.
+func TopologyFromRules(t types.Topology) (Topology, error) {
+	switch t {
+	case "", types.TopologyOrthogonal:
+		return TopologyOrthogonal, nil
+	case types.TopologyEightWay:
+		return TopologyEightWay, nil
+	case types.TopologyToroidal:
+		return TopologyToroidal, nil
+	}
+	return 0, fmt.Errorf("unsupported topology from rules: %v", t)
+}
+
+// Returns the neighbours of the cell in the topology, sorted by their index.
+// Unlike neighboursForCellIndex, blocked cells are included.
+func (tb TableBoard) topologyNeighbours(index int) []int {
+	column, row := tb.IndexToCord(index)
+	var neighbours []int
+	add := func(dc, dr int) {
+		c, r := column+dc, row+dr
+		if tb.Topology == TopologyToroidal {
+			c = (c + tb.columns) % tb.columns
+			r = (r + tb.rows) % tb.rows
+		}
+		if c < 0 || r < 0 || c >= tb.columns || r >= tb.rows {
+			return
+		}
+		i := r*tb.columns + c
+		if i == index || contains(neighbours, i) {
+			return
+		}
+		neighbours = append(neighbours, i)
+	}
+	diagonals := tb.Topology == TopologyEightWay
+	if diagonals {
+		add(-1, -1)
+	}
+	// Neighbour above
+	add(0, -1)
+	if diagonals {
+		add(1, -1)
+	}
+	// Neighbours to the left and to the right
+	add(-1, 0)
+	add(1, 0)
+	if diagonals {
+		add(-1, 1)
+	}
+	// Neighbour below
+	add(0, 1)
+	if diagonals {
+		add(1, 1)
+	}
+	// Wrapped neighbours are not in order
+	if tb.Topology == TopologyToroidal {
+		sort.Ints(neighbours)
+	}
+	return neighbours
+}
@@ -21,3 +21,19 @@ func ReverseSlice[T comparable](s []T) []T {
 	}
 	return r
 }
+
+func contains[T comparable](list []T, v T) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/runar-rkmedia/gotally/tallylogic/cell"
@@ -62,10 +63,111 @@ func (p CreateUserSessionPayload) Validate() error {
 	return nil
 }
 
+type CreateSessionPayload struct {
+	SessionID    string
+	UserID       string
+	InvalidAfter time.Time
+}
+
+func (p CreateSessionPayload) Validate() error {
+	if p.SessionID == "" {
+		return fmt.Errorf("%w: SessionID", ErrArgumentMissing)
+	}
+	if p.UserID == "" {
+		return fmt.Errorf("%w: UserID", ErrArgumentMissing)
+	}
+	if p.InvalidAfter.Before(time.Now()) {
+		return fmt.Errorf("%w: InvalidAfter cannot be in the past", ErrArgumentInvalid)
+	}
+	return nil
+}
+
+type RefreshSessionPayload struct {
+	SessionID string
+	// The new expiry for the session. Setting it in the past expires the session.
+	InvalidAfter time.Time
+}
+
+func (p RefreshSessionPayload) Validate() error {
+	if p.SessionID == "" {
+		return fmt.Errorf("%w: SessionID", ErrArgumentMissing)
+	}
+	if p.InvalidAfter.IsZero() {
+		return fmt.Errorf("%w: InvalidAfter", ErrArgumentMissing)
+	}
+	return nil
+}
+
+type RenewSessionPayload struct {
+	// The expired session, which is replaced by the new session
+	ExpiredSessionID string
+	SessionID        string
+	InvalidAfter     time.Time
+}
+
+func (p RenewSessionPayload) Validate() error {
+	if p.ExpiredSessionID == "" {
+		return fmt.Errorf("%w: ExpiredSessionID", ErrArgumentMissing)
+	}
+	if p.SessionID == "" {
+		return fmt.Errorf("%w: SessionID", ErrArgumentMissing)
+	}
+	if p.InvalidAfter.Before(time.Now()) {
+		return fmt.Errorf("%w: InvalidAfter cannot be in the past", ErrArgumentInvalid)
+	}
+	return nil
+}
+
+type ReapSessionsPayload struct {
+	// Sessions that expired before this time are deleted
+	ExpiredBefore time.Time
+}
+
+func (p ReapSessionsPayload) Validate() error {
+	if p.ExpiredBefore.IsZero() {
+		return fmt.Errorf("%w: ExpiredBefore", ErrArgumentMissing)
+	}
+	return nil
+}
+
 type GetGameChallengePayload struct {
 	// Optionally include stats for a user, from previous games
 	StatsForUserID string
 }
+type GetGameTemplatePayload struct {
+	ID string
+}
+
+func (p GetGameTemplatePayload) Validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("%w: ID", ErrArgumentMissing)
+	}
+	return nil
+}
+
+type GetChallengeLeaderboardPayload struct {
+	TemplateID string
+	// Optionally include the entry for a user, even if it is outside the page
+	CurrentUserID string
+	// Only rank the first attempt of each user, as for the daily challenges
+	FirstAttemptOnly bool
+	Limit            int
+	Offset           int
+}
+
+func (p GetChallengeLeaderboardPayload) Validate() error {
+	if p.TemplateID == "" {
+		return fmt.Errorf("%w: TemplateID", ErrArgumentMissing)
+	}
+	if p.Limit <= 0 {
+		return fmt.Errorf("%w: Limit must be above 0, got %d", ErrArgumentInvalid, p.Limit)
+	}
+	if p.Offset < 0 {
+		return fmt.Errorf("%w: Offset cannot be negative, got %d", ErrArgumentInvalid, p.Offset)
+	}
+	return nil
+}
+
 type CreateGameTemplatePayload struct {
 	ID              string
 	CreatedAt       time.Time
@@ -78,6 +180,8 @@ type CreateGameTemplatePayload struct {
 	IdealScore      int
 	Name            string
 	Cells           []cell.Cell
+	// Set for the daily challenges, formatted as DailyDateFormat
+	DailyDate string
 	Rules
 }
 
@@ -104,7 +208,33 @@ func (p CreateGameTemplatePayload) Validate() error {
 	if p.TargetCellValue == 0 {
 		return fmt.Errorf("%w: Target cell value must be set", ErrArgumentInvalid)
 	}
+	if err := ValidateCells(p.Cells); err != nil {
+		return err
+	}
+	if p.DailyDate != "" {
+		if _, err := ParseDailyDate(p.DailyDate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+// The highest value of a cell received from clients, well below where products of cells overflow.
+const MaxCellValue int64 = 1 << 40
+
+// Validates cells received from clients. Only blocked cells may have a negative base-value,
+// and the values are limited by MaxCellValue.
+func ValidateCells(cells []cell.Cell) error {
+	for i, c := range cells {
+		base, power := c.Raw()
+		if base < cell.BlockedBaseValue {
+			return fmt.Errorf("%w: Cell %d has an invalid base-value %d", ErrArgumentInvalid, i, base)
+		}
+		if power < 0 || power > 40 || base > MaxCellValue>>power {
+			return fmt.Errorf("%w: Cell %d has a value above %d", ErrArgumentInvalid, i, MaxCellValue)
+		}
+	}
 	return nil
 }
 
@@ -121,6 +251,11 @@ type UpdateGamePayload struct {
 	Cells   []cell.Cell
 	History []byte
 	PlayState
+	// The number of moves in effect, that is, not undone.
+	// Checkpoints after this depth are deleted, since they belong to undone moves.
+	Depth int
+	// Checkpoints to store, replacing any existing ones at the same depth
+	Checkpoints []GameCheckpoint
 }
 
 func (payload UpdateGamePayload) Validate() error {
@@ -139,6 +274,27 @@ func (payload UpdateGamePayload) Validate() error {
 	if payload.Moves > 0 && len(payload.History) == 0 {
 		return fmt.Errorf("%w: History", ErrArgumentMissing)
 	}
+	for _, c := range payload.Checkpoints {
+		if c.Depth <= 0 || c.Depth > payload.Depth {
+			return fmt.Errorf("%w: Checkpoints must be within the depth of the game, got %d with depth %d", ErrArgumentInvalid, c.Depth, payload.Depth)
+		}
+		if len(c.Cells) == 0 {
+			return fmt.Errorf("%w: Checkpoint.Cells", ErrArgumentMissing)
+		}
+	}
+	return nil
+}
+
+type GetGameCheckpointPayload struct {
+	GameID string
+	// The latest checkpoint at or before this depth is returned
+	MaxDepth int
+}
+
+func (payload GetGameCheckpointPayload) Validate() error {
+	if payload.GameID == "" {
+		return fmt.Errorf("%w: GameID", ErrArgumentMissing)
+	}
 	return nil
 }
 
@@ -162,7 +318,184 @@ func (payload RestartGamePayload) Validate() error {
 	return nil
 }
 
+type ListGamesPayload struct {
+	UserID string
+	// Optional filters. A game matches if it has any of the listed values
+	PlayStates []PlayState
+	Modes      []RuleMode
+	TemplateID string
+	Limit      int
+	Offset     int
+}
+
+func (payload ListGamesPayload) Validate() error {
+	if payload.UserID == "" {
+		return fmt.Errorf("%w: UserID", ErrArgumentMissing)
+	}
+	if payload.Limit <= 0 {
+		return fmt.Errorf("%w: Limit must be above 0, got %d", ErrArgumentInvalid, payload.Limit)
+	}
+	if payload.Offset < 0 {
+		return fmt.Errorf("%w: Offset cannot be negative, got %d", ErrArgumentInvalid, payload.Offset)
+	}
+	return nil
+}
+
+type ResumeGamePayload struct {
+	UserID string
+	GameID string
+}
+
+func (payload ResumeGamePayload) Validate() error {
+	if payload.GameID == "" {
+		return fmt.Errorf("%w: GameID", ErrArgumentMissing)
+	}
+	if payload.UserID == "" {
+		return fmt.Errorf("%w: UserID", ErrArgumentMissing)
+	}
+	return nil
+}
+
+type GetDailyChallengePayload struct {
+	// Formatted as DailyDateFormat
+	Date string
+	// Optionally include the ranked attempt of the user
+	UserID string
+}
+
+func (payload GetDailyChallengePayload) Validate() error {
+	if payload.Date == "" {
+		return fmt.Errorf("%w: Date", ErrArgumentMissing)
+	}
+	_, err := ParseDailyDate(payload.Date)
+	return err
+}
+
+type ListDailyChallengesPayload struct {
+	// Optionally include the ranked attempts of the user
+	UserID string
+	Limit  int
+	Offset int
+}
+
+func (payload ListDailyChallengesPayload) Validate() error {
+	if payload.Limit <= 0 {
+		return fmt.Errorf("%w: Limit must be above 0, got %d", ErrArgumentInvalid, payload.Limit)
+	}
+	if payload.Offset < 0 {
+		return fmt.Errorf("%w: Offset cannot be negative, got %d", ErrArgumentInvalid, payload.Offset)
+	}
+	return nil
+}
+
+type VoteForBoardPayload struct {
+	UserID string
+	// The game being voted on. If the game is based on a template, the vote is
+	// registered for the template instead.
+	GameID  string
+	FunVote int
+}
+
+func (payload VoteForBoardPayload) Validate() error {
+	if payload.UserID == "" {
+		return fmt.Errorf("%w: UserID", ErrArgumentMissing)
+	}
+	if payload.GameID == "" {
+		return fmt.Errorf("%w: GameID", ErrArgumentMissing)
+	}
+	if payload.FunVote < 1 || payload.FunVote > 5 {
+		return fmt.Errorf("%w: FunVote must be between 1 and 5, got %d", ErrArgumentInvalid, payload.FunVote)
+	}
+
+	return nil
+}
+
+type RegisterUserPayload struct {
+	UserID       string
+	Username     string
+	PasswordHash string
+}
+
+var usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{3,21}$`)
+
+func (payload RegisterUserPayload) Validate() error {
+	if payload.UserID == "" {
+		return fmt.Errorf("%w: UserID", ErrArgumentMissing)
+	}
+	if payload.Username == "" {
+		return fmt.Errorf("%w: Username", ErrArgumentMissing)
+	}
+	if !usernameRegex.MatchString(payload.Username) {
+		return fmt.Errorf("%w: Username must be 3-21 characters, and contain only letters, digits, '-' and '_'", ErrArgumentInvalid)
+	}
+	if payload.PasswordHash == "" {
+		return fmt.Errorf("%w: PasswordHash", ErrArgumentMissing)
+	}
+
+	return nil
+}
+
+type GetRegisteredUserPayload struct {
+	Username string
+}
+
+func (payload GetRegisteredUserPayload) Validate() error {
+	if payload.Username == "" {
+		return fmt.Errorf("%w: Username", ErrArgumentMissing)
+	}
+	return nil
+}
+
+type CreateLinkCodePayload struct {
+	Code         string
+	UserID       string
+	InvalidAfter time.Time
+}
+
+func (payload CreateLinkCodePayload) Validate() error {
+	if payload.Code == "" {
+		return fmt.Errorf("%w: Code", ErrArgumentMissing)
+	}
+	if payload.UserID == "" {
+		return fmt.Errorf("%w: UserID", ErrArgumentMissing)
+	}
+	if payload.InvalidAfter.Before(time.Now()) {
+		return fmt.Errorf("%w: InvalidAfter cannot be in the past", ErrArgumentInvalid)
+	}
+	return nil
+}
+
+type UseLinkCodePayload struct {
+	Code string
+	// The new session to create for the user that created the code
+	SessionID    string
+	InvalidAfter time.Time
+}
+
+func (payload UseLinkCodePayload) Validate() error {
+	if payload.Code == "" {
+		return fmt.Errorf("%w: Code", ErrArgumentMissing)
+	}
+	if payload.SessionID == "" {
+		return fmt.Errorf("%w: SessionID", ErrArgumentMissing)
+	}
+	if payload.InvalidAfter.Before(time.Now()) {
+		return fmt.Errorf("%w: InvalidAfter cannot be in the past", ErrArgumentInvalid)
+	}
+	return nil
+}
+
 var (
-	ErrArgumentMissing = errors.New("missing argument")
-	ErrArgumentInvalid = errors.New("invalid argument")
+	ErrArgumentMissing   = errors.New("missing argument")
+	ErrArgumentInvalid   = errors.New("invalid argument")
+	ErrUsernameTaken     = errors.New("username is already taken")
+	ErrAlreadyRegistered = errors.New("user is already registered")
+	// The link-code does not exist, has expired or has already been used
+	ErrLinkCodeInvalid = errors.New("link-code is invalid")
+	// The game does not exist, or belongs to a different user
+	ErrGameNotFound = errors.New("game not found")
+	// The game has been won or lost, and cannot be played any further
+	ErrGameFinished = errors.New("game is finished")
+	// There is no template for the requested id or date
+	ErrTemplateNotFound = errors.New("template not found")
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"runtime/debug"
 	"time"
@@ -15,8 +16,20 @@ type Vote struct {
 	CreatedAt time.Time
 	UpdatedAt *time.Time
 
-	UserID  string
-	FunVote int
+	UserID string
+	// Set when the vote is for a game not based on a template
+	GameID string
+	// Set when the vote is for a template, like a challenge
+	TemplateID string
+	FunVote    int
+}
+
+// Accumulated votes for a board or template
+type VoteStats struct {
+	// Number of votes
+	Count uint64
+	// Sum of all the fun-votes
+	FunVoteTotal uint64
 }
 type Session struct {
 	ID        string
@@ -29,6 +42,16 @@ type Session struct {
 	ActiveGame *Game
 }
 
+// The number of rows deleted while reaping expired sessions
+type ReapResult struct {
+	Sessions  int64
+	LinkCodes int64
+	// Games belonging to the orphaned users
+	Games int64
+	// Guest-users without any sessions, that never made a move
+	Users int64
+}
+
 type GameTemplate struct {
 	ID              string
 	CreatedAt       time.Time
@@ -40,8 +63,44 @@ type GameTemplate struct {
 	Description     string
 	Name            string
 	Cells           []cell.Cell
+	// Set for the daily challenges, formatted as DailyDateFormat
+	DailyDate string
 	Rules
 	Stats []PlayStats
+	Votes VoteStats
+}
+
+// The layout of the dates for the daily challenges. Days are in UTC.
+const DailyDateFormat = "2006-01-02"
+
+// Returns the date of the daily challenge for the time
+func DailyDate(t time.Time) string {
+	return t.UTC().Format(DailyDateFormat)
+}
+
+func ParseDailyDate(date string) (time.Time, error) {
+	t, err := time.Parse(DailyDateFormat, date)
+	if err != nil {
+		return t, fmt.Errorf("%w: date must be formatted as YYYY-MM-DD, got '%s'", ErrArgumentInvalid, date)
+	}
+	return t, nil
+}
+
+// The challenge of a single day, which is the same board for every user
+type DailyChallenge struct {
+	GameTemplate
+	// The users first attempt at the challenge, which is the only one that is ranked
+	RankedAttempt *DailyAttempt
+}
+type DailyAttempt struct {
+	PlayStats
+	PlayState PlayState
+}
+type DailyChallengeList struct {
+	// The requested page of challenges, the most recent day first
+	Challenges []DailyChallenge
+	// Number of days with a challenge
+	Total int
 }
 
 type PlayStats struct {
@@ -51,6 +110,37 @@ type PlayStats struct {
 	Score    uint64
 	Moves    uint64
 }
+type GameList struct {
+	// The requested page of games, the most recently created first
+	Games []ListedGame
+	// Number of games matching the filters
+	Total int
+}
+
+// A game in a listing, with the board as it currently is
+type ListedGame struct {
+	Game
+	TemplateID string
+	// Set for the game the user is currently playing
+	Active bool
+}
+type ChallengeLeaderboard struct {
+	// The requested page of entries
+	Entries []LeaderboardEntry
+	// Number of users that have won the challenge. For the daily challenges,
+	// the number of users that won on their first attempt
+	TotalPlayers int
+	// Entry for the current user, if they have won the challenge
+	CurrentUser *LeaderboardEntry
+}
+
+// A users best attempt at a challenge
+type LeaderboardEntry struct {
+	// Users with equal moves, score and time share the same rank
+	Rank int
+	PlayStats
+	WonAt time.Time
+}
 type SessionUser struct {
 	Session
 	User
@@ -62,6 +152,32 @@ type User struct {
 	UpdatedAt *time.Time
 
 	UserName string
+	// Only set for registered users
+	PasswordHash string
+}
+
+// Registered users can sign in from other devices with their password
+func (u User) IsRegistered() bool {
+	return u.PasswordHash != ""
+}
+
+// A one-time code used to attach a new session to an existing user
+type LinkCode struct {
+	Code         string
+	CreatedAt    time.Time
+	InvalidAfter time.Time
+	UserID       string
+}
+
+// A snapshot of a game, so that undo can replay the history from here,
+// instead of from the start of the game.
+type GameCheckpoint struct {
+	// The number of moves in effect (not undone) when the snapshot was taken
+	Depth       int
+	CreatedAt   time.Time
+	Score       uint64
+	Cells       []cell.Cell
+	Seed, State uint64
 }
 
 type Dump struct {
@@ -71,6 +187,7 @@ type Dump struct {
 	Sessions      any //[]Session
 	Users         any //[]User
 	Template      any //[]GameTemplate
+	Votes         any //[]Vote
 }
 
 type Game struct {
@@ -134,6 +251,21 @@ type Rules struct {
 	NoReSwipe       bool
 	NoMultiply      bool
 	NoAddition      bool
+	NoSuperPowers   bool
+	// The number of times cells can be swapped. Swaps are not allowed if zero.
+	MaxSwaps uint64
+	// Only allow swapping cells that are neighbours
+	SwapNeighboursOnly bool
+	// The number of super-powers that can be used. Super-powers are not allowed if zero.
+	MaxPowers uint64
+	// Allow subtraction as an evaluation-method
+	WithSubtraction bool
+	// Allow exact division as an evaluation-method
+	WithDivision bool
+	// Allow chains of mixed operators, evaluated from left to right
+	WithMixedOperators bool
+	// Decides which cells are neighbours. Defaults to TopologyOrthogonal if empty.
+	Topology Topology
 }
 
 func (r Rules) Hash() string {
@@ -141,7 +273,31 @@ func (r Rules) Hash() string {
 	h.Write([]byte(r.Description))
 	h.Write([]byte(r.Mode))
 	h.Write([]byte{r.Rows, r.Columns})
-	h.Write(boolsToBytes(r.RecreateOnSwipe, r.NoReSwipe, r.NoMultiply, r.NoAddition))
+	// The flags added later are false by default, and do not change the packed byte
+	h.Write(boolsToBytes(r.RecreateOnSwipe, r.NoReSwipe, r.NoMultiply, r.NoAddition, r.NoSuperPowers, r.SwapNeighboursOnly))
+	// Only written when set, so that rules without targets keep the hash they had
+	// before the targets were included, and match the existing rules.
+	if r.TargetCellValue > 0 || r.TargetScore > 0 || r.MaxMoves > 0 {
+		h.Write([]byte("targets"))
+		h.Write(uint64sToBytes(r.TargetCellValue, r.TargetScore, r.MaxMoves))
+	}
+	// Only written when set, so that rules without swaps or powers keep their hash
+	if r.MaxSwaps > 0 {
+		h.Write(uint64sToBytes(r.MaxSwaps))
+	}
+	if r.MaxPowers > 0 {
+		// Prefixed, so that it does not collide with MaxSwaps
+		h.Write([]byte("powers"))
+		h.Write(uint64sToBytes(r.MaxPowers))
+	}
+	if r.WithSubtraction || r.WithDivision || r.WithMixedOperators {
+		h.Write([]byte("operators"))
+		h.Write(boolsToBytes(r.WithSubtraction, r.WithDivision, r.WithMixedOperators))
+	}
+	if r.Topology != "" && r.Topology != TopologyOrthogonal {
+		h.Write([]byte("topology"))
+		h.Write([]byte(r.Topology))
+	}
 	b := h.Sum(nil)
 	return base64.URLEncoding.EncodeToString(b)
 }
@@ -156,8 +312,17 @@ func boolsToBytes(t ...bool) []byte {
 	return b
 }
 
+func uint64sToBytes(t ...uint64) []byte {
+	b := make([]byte, 8*len(t))
+	for i, x := range t {
+		binary.BigEndian.PutUint64(b[i*8:], x)
+	}
+	return b
+}
+
 type PlayState = string
 type RuleMode = string
+type Topology = string
 
 const (
 	PlayStateWon       PlayState = "won"
@@ -170,12 +335,16 @@ const (
 	RuleModeInfiniteHard   RuleMode = "infinite-hard"
 	RuleModeChallenge      RuleMode = "challenge"
 	RuleModeTutorial       RuleMode = "tutorial"
+
+	TopologyOrthogonal Topology = "orthogonal"
+	TopologyEightWay   Topology = "eight-way"
+	TopologyToroidal   Topology = "toroidal"
 )
 
 type Statistics struct {
 	// Totaly number of users
 	Users int64
-	// Totaly number of sessions
+	// Totaly number of sessions that have not expired
 	Session int64
 	// Totaly number of games
 	Games int64